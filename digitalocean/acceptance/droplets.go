@@ -77,15 +77,15 @@ func TestAccCheckDigitalOceanDropletExists(n string, droplet *godo.Droplet) reso
 	}
 }
 
-func TestAccCheckDigitalOceanDropletConfig_basic(name string) string {
+func TestAccCheckDigitalOceanDropletConfig_basic(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "s-1vcpu-1gb"
   image     = "ubuntu-22-04-x64"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
-}`, name)
+}`, name, region)
 }
 
 // TakeSnapshotsOfDroplet takes three snapshots of the given Droplet. One will have the suffix -1 and two will have -0.
@@ -0,0 +1,326 @@
+package acceptance
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/spaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests pick their region at runtime rather than hard-coding one,
+// so that a region running out of capacity for a size, or not yet offering a
+// feature, does not fail whole suites. The regions are tried in the order of
+// the comma-separated DIGITALOCEAN_TEST_REGIONS, or DefaultTestRegions, then
+// in the order of their slugs.
+//
+// Requirements are tags, each of which must be met by the region:
+//
+//   - "spaces": Spaces buckets can be created in the region.
+//   - "kubernetes": Kubernetes clusters can be created in the region.
+//   - "ha_doks": Kubernetes clusters with a highly available control plane
+//     can be created in the region. The API does not tell which regions offer
+//     them, they are offered in all of the regions of Kubernetes.
+//   - "registry": container registries can be created in the region.
+//   - "database:<engine>": database clusters of the engine, e.g. "pg", can be
+//     created in the region.
+//   - "gpu": at least one GPU Droplet size is available in the region.
+//   - a size slug, e.g. "s-1vcpu-1gb": the size is available in the region.
+//   - any other tag must be a feature of the region, e.g. "backups".
+
+// TestRegionsEnvVar is the environment variable overriding DefaultTestRegions.
+const TestRegionsEnvVar = "DIGITALOCEAN_TEST_REGIONS"
+
+// DefaultTestRegions are the regions tried first by TestAccRegion.
+var DefaultTestRegions = []string{"nyc3", "nyc1"}
+
+// regionOptions are what the API tells of the regions.
+type regionOptions struct {
+	regions    []godo.Region
+	sizes      []godo.Size
+	kubernetes *godo.KubernetesOptions
+	registry   *godo.RegistryOptions
+	databases  *godo.DatabaseOptions
+}
+
+var (
+	testRegionOptionsMu sync.Mutex
+	testRegionOptions   *regionOptions
+)
+
+// TestAccRegion returns the slug of a region meeting all of the requirements,
+// to be passed to the configuration of a test, e.g. with fmt.Sprintf. The
+// test is skipped unless acceptance tests are enabled, and fails if no region
+// meets the requirements.
+func TestAccRegion(t *testing.T, requirements ...string) string {
+	opts := testAccRegionOptions(t)
+
+	region, err := opts.selectRegion(testRegionPreferences(), requirements)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return region
+}
+
+// TestAccSize returns the first of the size slugs available in the region, to
+// be passed to the configuration of a test along with the region.
+func TestAccSize(t *testing.T, region string, candidates ...string) string {
+	opts := testAccRegionOptions(t)
+
+	slug, err := opts.selectSize(region, candidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return slug
+}
+
+// testAccRegionOptions returns the options of the regions, which are fetched
+// once for all of the tests.
+func testAccRegionOptions(t *testing.T) *regionOptions {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	testRegionOptionsMu.Lock()
+	defer testRegionOptionsMu.Unlock()
+
+	if testRegionOptions != nil {
+		return testRegionOptions
+	}
+
+	TestAccPreCheck(t)
+	client := TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	opts, err := fetchRegionOptions(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testRegionOptions = opts
+
+	return opts
+}
+
+func testRegionPreferences() []string {
+	if v := os.Getenv(TestRegionsEnvVar); v != "" {
+		preferences := []string{}
+		for _, slug := range strings.Split(v, ",") {
+			if slug = strings.TrimSpace(slug); slug != "" {
+				preferences = append(preferences, slug)
+			}
+		}
+		return preferences
+	}
+
+	return DefaultTestRegions
+}
+
+func fetchRegionOptions(ctx context.Context, client *godo.Client) (*regionOptions, error) {
+	opts := &regionOptions{}
+
+	listOptions := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+	for {
+		regions, resp, err := client.Regions.List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving regions: %s", err)
+		}
+
+		opts.regions = append(opts.regions, regions...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving regions: %s", err)
+		}
+
+		listOptions.Page = page + 1
+	}
+
+	sizes, err := size.ListSizes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	opts.sizes = sizes
+
+	opts.kubernetes, _, err = client.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Kubernetes options: %s", err)
+	}
+
+	opts.registry, _, err = client.Registry.GetOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving container registry options: %s", err)
+	}
+
+	opts.databases, _, err = client.Databases.ListOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving database options: %s", err)
+	}
+
+	return opts, nil
+}
+
+// selectRegion returns the first of the available regions meeting all of the
+// requirements, trying the preferred regions first.
+func (o *regionOptions) selectRegion(preferences []string, requirements []string) (string, error) {
+	regions := make([]godo.Region, len(o.regions))
+	copy(regions, o.regions)
+
+	rank := func(slug string) int {
+		for i, preference := range preferences {
+			if preference == slug {
+				return i
+			}
+		}
+		return len(preferences)
+	}
+	sort.SliceStable(regions, func(i, j int) bool {
+		ri, rj := rank(regions[i].Slug), rank(regions[j].Slug)
+		if ri != rj {
+			return ri < rj
+		}
+		return regions[i].Slug < regions[j].Slug
+	})
+
+	for _, region := range regions {
+		if !region.Available {
+			continue
+		}
+
+		supported := true
+		for _, requirement := range requirements {
+			if !o.supports(region, requirement) {
+				supported = false
+				break
+			}
+		}
+
+		if supported {
+			return region.Slug, nil
+		}
+	}
+
+	return "", fmt.Errorf("no available region meets the requirements: %s", strings.Join(requirements, ", "))
+}
+
+// selectSize returns the first of the candidate sizes available in the region.
+func (o *regionOptions) selectSize(region string, candidates []string) (string, error) {
+	for _, r := range o.regions {
+		if r.Slug != region {
+			continue
+		}
+
+		for _, candidate := range candidates {
+			if o.sizeAvailable(r, candidate) {
+				return candidate, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("none of the sizes %s is available in region %s", strings.Join(candidates, ", "), region)
+}
+
+func (o *regionOptions) supports(region godo.Region, requirement string) bool {
+	switch {
+	case requirement == "spaces":
+		return contains(spaces.SpacesRegions, region.Slug)
+	case requirement == "kubernetes", requirement == "ha_doks":
+		if o.kubernetes == nil {
+			return false
+		}
+		for _, r := range o.kubernetes.Regions {
+			if r.Slug == region.Slug {
+				return true
+			}
+		}
+		return false
+	case requirement == "registry":
+		return o.registry != nil && contains(o.registry.AvailableRegions, region.Slug)
+	case strings.HasPrefix(requirement, "database:"):
+		engine, ok := o.databaseEngineOptions(strings.TrimPrefix(requirement, "database:"))
+		return ok && contains(engine.Regions, region.Slug)
+	case requirement == "gpu":
+		for _, s := range o.sizes {
+			if s.GPUInfo != nil && o.sizeAvailable(region, s.Slug) {
+				return true
+			}
+		}
+		return false
+	case o.isSize(requirement):
+		return o.sizeAvailable(region, requirement)
+	default:
+		return contains(region.Features, requirement)
+	}
+}
+
+func (o *regionOptions) isSize(slug string) bool {
+	for _, s := range o.sizes {
+		if s.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// sizeAvailable returns whether the size can be used in the region, which is
+// not the case when the region lacks the capacity for it.
+func (o *regionOptions) sizeAvailable(region godo.Region, slug string) bool {
+	if !contains(region.Sizes, slug) {
+		return false
+	}
+
+	for _, s := range o.sizes {
+		if s.Slug == slug {
+			return s.Available && contains(s.Regions, region.Slug)
+		}
+	}
+
+	return false
+}
+
+func (o *regionOptions) databaseEngineOptions(engine string) (godo.DatabaseEngineOptions, bool) {
+	if o.databases == nil {
+		return godo.DatabaseEngineOptions{}, false
+	}
+
+	switch engine {
+	case "pg":
+		return o.databases.PostgresSQLOptions, true
+	case "mysql":
+		return o.databases.MySQLOptions, true
+	case "redis":
+		return o.databases.RedisOptions, true
+	case "mongodb":
+		return o.databases.MongoDBOptions, true
+	case "kafka":
+		return o.databases.KafkaOptions, true
+	case "opensearch":
+		return o.databases.OpensearchOptions, true
+	}
+
+	return godo.DatabaseEngineOptions{}, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
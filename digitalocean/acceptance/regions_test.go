@@ -0,0 +1,144 @@
+package acceptance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// newRegionOptionsTestServer returns a fake API in which nyc3 lacks the
+// capacity for s-1vcpu-1gb, nyc1 does not offer Spaces, sfo3 holds the only
+// GPU size and ams3 is unavailable.
+func newRegionOptionsTestServer(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"/v2/regions": `{"regions":[
+			{"slug":"sfo3","available":true,"features":["backups","metadata"],"sizes":["s-1vcpu-1gb","gpu-h100x1-80gb"]},
+			{"slug":"nyc1","available":true,"features":["backups"],"sizes":["s-1vcpu-1gb","s-1vcpu-2gb"]},
+			{"slug":"nyc3","available":true,"features":["backups","metadata"],"sizes":["s-1vcpu-2gb"]},
+			{"slug":"ams3","available":false,"features":["backups","metadata"],"sizes":["s-1vcpu-1gb"]}
+		],"links":{},"meta":{"total":4}}`,
+		"/v2/sizes": `{"sizes":[
+			{"slug":"s-1vcpu-1gb","available":true,"regions":["nyc1","sfo3","ams3"]},
+			{"slug":"s-1vcpu-2gb","available":true,"regions":["nyc1","nyc3"]},
+			{"slug":"gpu-h100x1-80gb","available":true,"regions":["sfo3"],"gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}}
+		],"links":{},"meta":{"total":3}}`,
+		"/v2/kubernetes/options": `{"options":{
+			"regions":[{"name":"New York 1","slug":"nyc1"},{"name":"San Francisco 3","slug":"sfo3"}],
+			"versions":[],"sizes":[]
+		}}`,
+		"/v2/registry/options": `{"options":{"available_regions":["nyc3","sfo3"],"subscription_tiers":[]}}`,
+		"/v2/databases/options": `{"options":{
+			"pg":{"regions":["nyc1","nyc3","sfo3"],"versions":["16"],"layouts":[]},
+			"kafka":{"regions":["sfo3"],"versions":["3.7"],"layouts":[]}
+		}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newRegionOptionsTest(t *testing.T) *regionOptions {
+	server := newRegionOptionsTestServer(t)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	opts, err := fetchRegionOptions(context.Background(), meta.GodoClient())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return opts
+}
+
+func TestSelectRegion(t *testing.T) {
+	cases := []struct {
+		name             string
+		preferences      []string
+		requirements     []string
+		expected         string
+		expectedErrorMsg string
+	}{
+		{name: "Preferred", preferences: []string{"nyc3", "nyc1"}, expected: "nyc3"},
+		{name: "NoPreferences", expected: "nyc1"},
+		{name: "SizeCapacity", preferences: []string{"nyc3", "nyc1"}, requirements: []string{"s-1vcpu-1gb"}, expected: "nyc1"},
+		{name: "Spaces", preferences: []string{"nyc1"}, requirements: []string{"spaces"}, expected: "nyc3"},
+		{name: "Kubernetes", preferences: []string{"nyc3"}, requirements: []string{"kubernetes"}, expected: "nyc1"},
+		{name: "HAKubernetes", preferences: []string{"sfo3", "nyc1"}, requirements: []string{"ha_doks"}, expected: "sfo3"},
+		{name: "Registry", preferences: []string{"nyc1", "nyc3"}, requirements: []string{"kubernetes", "registry"}, expected: "sfo3"},
+		{name: "Database", preferences: []string{"nyc3"}, requirements: []string{"database:kafka"}, expected: "sfo3"},
+		{name: "GPU", preferences: []string{"nyc3"}, requirements: []string{"gpu"}, expected: "sfo3"},
+		{name: "Feature", preferences: []string{"nyc1", "nyc3"}, requirements: []string{"metadata"}, expected: "nyc3"},
+		{name: "Combined", preferences: []string{"nyc3", "nyc1"}, requirements: []string{"spaces", "s-1vcpu-1gb"}, expected: "sfo3"},
+		{name: "Unavailable", requirements: []string{"spaces", "s-1vcpu-2gb", "kubernetes"}, expectedErrorMsg: "no available region meets the requirements: spaces, s-1vcpu-2gb, kubernetes"},
+		{name: "UnknownEngine", requirements: []string{"database:foo"}, expectedErrorMsg: "no available region"},
+	}
+
+	opts := newRegionOptionsTest(t)
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			region, err := opts.selectRegion(c.preferences, c.requirements)
+			if c.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+					t.Fatalf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if region != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, region)
+			}
+		})
+	}
+}
+
+func TestSelectSize(t *testing.T) {
+	opts := newRegionOptionsTest(t)
+
+	slug, err := opts.selectSize("nyc3", []string{"s-1vcpu-1gb", "s-1vcpu-2gb"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if slug != "s-1vcpu-2gb" {
+		t.Errorf("expected %q, got %q", "s-1vcpu-2gb", slug)
+	}
+
+	if _, err := opts.selectSize("nyc3", []string{"s-1vcpu-1gb"}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestRegionPreferences(t *testing.T) {
+	t.Setenv(TestRegionsEnvVar, "")
+	if preferences := testRegionPreferences(); !reflect.DeepEqual(preferences, DefaultTestRegions) {
+		t.Errorf("expected %v, got %v", DefaultTestRegions, preferences)
+	}
+
+	t.Setenv(TestRegionsEnvVar, "sfo3, ams3,")
+	expected := []string{"sfo3", "ams3"}
+	if preferences := testRegionPreferences(); !reflect.DeepEqual(preferences, expected) {
+		t.Errorf("expected %v, got %v", expected, preferences)
+	}
+}
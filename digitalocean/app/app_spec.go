@@ -248,7 +248,7 @@ func appSpecImageSourceSchema() map[string]*schema.Schema {
 		"registry": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "The registry name. Must be left empty for the DOCR registry type.",
+			Description: "The registry name. Required for the DOCKER_HUB and GHCR registry types. Optional for the DOCR registry type, where it defaults to the registry of the app's team.",
 		},
 		"repository": {
 			Type:        schema.TypeString,
@@ -278,7 +278,7 @@ func appSpecImageSourceSchema() map[string]*schema.Schema {
 		"registry_credentials": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Access credentials for third-party registries",
+			Description: "Access credentials for third-party registries. Not supported for the DOCR registry type.",
 			Sensitive:   true,
 		},
 	}
@@ -952,6 +952,7 @@ func appSpecLogDestinations() *schema.Resource {
 						"api_key": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Datadog API key.",
 						},
 					},
@@ -967,6 +968,7 @@ func appSpecLogDestinations() *schema.Resource {
 						"token": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Logtail token.",
 						},
 					},
@@ -1222,12 +1224,62 @@ func flattenAppSpec(d *schema.ResourceData, spec *godo.AppSpec) []map[string]int
 			r["egress"] = flattenAppEgress((*spec).Egress)
 		}
 
+		preserveAppImageRegistryCredentials(d, r)
+
 		result = append(result, r)
 	}
 
 	return result
 }
 
+// preserveAppImageRegistryCredentials keeps the registry credentials of image
+// components from state. The API returns them encrypted, so the value read
+// back would otherwise never match the configured credentials. Credentials
+// that have been removed outside of Terraform are still detected.
+func preserveAppImageRegistryCredentials(d *schema.ResourceData, spec map[string]interface{}) {
+	for _, componentType := range []string{"service", "worker", "job"} {
+		components, ok := spec[componentType].([]map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		stateCredentials := make(map[string]string)
+		if stateComponents, ok := d.Get("spec.0." + componentType).([]interface{}); ok {
+			for _, raw := range stateComponents {
+				component, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				image, ok := component["image"].([]interface{})
+				if !ok || len(image) == 0 || image[0] == nil {
+					continue
+				}
+
+				if credentials := image[0].(map[string]interface{})["registry_credentials"].(string); credentials != "" {
+					stateCredentials[component["name"].(string)] = credentials
+				}
+			}
+		}
+
+		for _, component := range components {
+			image, ok := component["image"].([]interface{})
+			if !ok || len(image) == 0 {
+				continue
+			}
+
+			flattenedImage := image[0].(map[string]interface{})
+			if flattenedImage["registry_credentials"].(string) == "" {
+				continue
+			}
+
+			if credentials, ok := stateCredentials[component["name"].(string)]; ok {
+				flattenedImage["registry_credentials"] = credentials
+			}
+		}
+	}
+}
+
 func expandAppAlerts(config []interface{}) []*godo.AppAlertSpec {
 	appAlerts := make([]*godo.AppAlertSpec, 0, len(config))
 
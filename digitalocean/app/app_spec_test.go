@@ -0,0 +1,264 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestPreserveAppImageRegistryCredentials(t *testing.T) {
+	image := func(credentials string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"registry_type":        "GHCR",
+				"repository":           "example/app",
+				"registry_credentials": credentials,
+			},
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanApp().Schema, map[string]interface{}{
+		"spec": []interface{}{
+			map[string]interface{}{
+				"name": "example",
+				"service": []interface{}{
+					map[string]interface{}{"name": "api", "image": image("user:token")},
+					map[string]interface{}{"name": "web", "image": image("user:other-token")},
+				},
+				"worker": []interface{}{
+					map[string]interface{}{"name": "queue", "image": image("user:token")},
+				},
+			},
+		},
+	})
+
+	// The API returns the components in a different order, with encrypted
+	// credentials, and the worker's credentials removed.
+	spec := map[string]interface{}{
+		"service": []map[string]interface{}{
+			{"name": "web", "image": image("EV[1:abc]")},
+			{"name": "api", "image": image("EV[1:def]")},
+			{"name": "new", "image": image("EV[1:ghi]")},
+		},
+		"worker": []map[string]interface{}{
+			{"name": "queue", "image": image("")},
+		},
+	}
+
+	preserveAppImageRegistryCredentials(d, spec)
+
+	credentials := func(componentType string, i int) string {
+		component := spec[componentType].([]map[string]interface{})[i]
+		return component["image"].([]interface{})[0].(map[string]interface{})["registry_credentials"].(string)
+	}
+
+	expected := []struct {
+		componentType string
+		index         int
+		credentials   string
+	}{
+		{"service", 0, "user:other-token"},
+		{"service", 1, "user:token"},
+		{"service", 2, "EV[1:ghi]"},
+		{"worker", 0, ""},
+	}
+
+	for _, e := range expected {
+		if got := credentials(e.componentType, e.index); got != e.credentials {
+			t.Errorf("%s %d: expected %q, got %q", e.componentType, e.index, e.credentials, got)
+		}
+	}
+}
+
+func TestAppImageSourceSpecRoundTrip(t *testing.T) {
+	fixture := `{
+		"name": "example",
+		"services": [{
+			"name": "api",
+			"image": {"registry_type": "DOCR", "registry": "other-team", "repository": "api", "tag": "1.0", "deploy_on_push": {"enabled": true}}
+		}],
+		"workers": [{
+			"name": "queue",
+			"image": {"registry_type": "DOCKER_HUB", "registry": "library", "repository": "redis", "tag": "7"}
+		}],
+		"jobs": [{
+			"name": "migrate",
+			"image": {"registry_type": "GHCR", "registry": "example", "repository": "migrate", "tag": "latest", "registry_credentials": "EV[1:abc]"}
+		}]
+	}`
+
+	var spec godo.AppSpec
+	if err := json.Unmarshal([]byte(fixture), &spec); err != nil {
+		t.Fatalf("unable to decode fixture: %s", err)
+	}
+
+	d := ResourceDigitalOceanApp().Data(nil)
+	if err := d.Set("spec", flattenAppSpec(d, &spec)); err != nil {
+		t.Fatalf("unable to set spec: %s", err)
+	}
+
+	expanded := expandAppSpec(d.Get("spec").([]interface{}))
+
+	images := []struct {
+		name     string
+		expected *godo.ImageSourceSpec
+		got      *godo.ImageSourceSpec
+	}{
+		{"DOCR", spec.Services[0].Image, expanded.Services[0].Image},
+		{"DOCKER_HUB", spec.Workers[0].Image, expanded.Workers[0].Image},
+		{"GHCR", spec.Jobs[0].Image, expanded.Jobs[0].Image},
+	}
+
+	for _, i := range images {
+		if !reflect.DeepEqual(i.got, i.expected) {
+			t.Errorf("%s: expected %+v, got %+v", i.name, i.expected, i.got)
+		}
+	}
+}
+
+func TestValidateAppImageSources(t *testing.T) {
+	cases := []struct {
+		name             string
+		image            map[string]interface{}
+		expectedErrorMsg string
+	}{
+		{
+			name:  "DOCR",
+			image: map[string]interface{}{"registry_type": "DOCR", "repository": "api"},
+		},
+		{
+			name:  "DOCR other registry",
+			image: map[string]interface{}{"registry_type": "DOCR", "registry": "other-team", "repository": "api"},
+		},
+		{
+			name:             "DOCR credentials",
+			image:            map[string]interface{}{"registry_type": "DOCR", "repository": "api", "registry_credentials": "user:token"},
+			expectedErrorMsg: "spec.0.service.0.image.0.registry_credentials is not supported for the DOCR registry type",
+		},
+		{
+			name:  "DOCKER_HUB",
+			image: map[string]interface{}{"registry_type": "DOCKER_HUB", "registry": "library", "repository": "redis"},
+		},
+		{
+			name:             "DOCKER_HUB without registry",
+			image:            map[string]interface{}{"registry_type": "DOCKER_HUB", "repository": "redis"},
+			expectedErrorMsg: "spec.0.service.0.image.0.registry is required for the DOCKER_HUB registry type",
+		},
+		{
+			name:  "GHCR credentials",
+			image: map[string]interface{}{"registry_type": "GHCR", "registry": "example", "repository": "api", "registry_credentials": "user:token"},
+		},
+		{
+			name:             "GHCR without registry",
+			image:            map[string]interface{}{"registry_type": "GHCR", "repository": "api"},
+			expectedErrorMsg: "spec.0.service.0.image.0.registry is required for the GHCR registry type",
+		},
+	}
+
+	r := ResourceDigitalOceanApp()
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"spec": []interface{}{
+					map[string]interface{}{
+						"name": "example",
+						"service": []interface{}{
+							map[string]interface{}{"name": "api", "image": []interface{}{c.image}},
+						},
+					},
+				},
+			})
+
+			_, err := r.Diff(context.Background(), nil, cfg, nil)
+			if c.expectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+				t.Fatalf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+}
+
+func TestAppTimeoutsDefaults(t *testing.T) {
+	d := ResourceDigitalOceanApp().Data(nil)
+
+	expected := map[string]time.Duration{
+		schema.TimeoutCreate: 30 * time.Minute,
+		schema.TimeoutUpdate: 30 * time.Minute,
+		schema.TimeoutDelete: 20 * time.Minute,
+	}
+	for key, timeout := range expected {
+		if got := d.Timeout(key); got != timeout {
+			t.Errorf("%s: expected %s, got %s", key, timeout, got)
+		}
+	}
+}
+
+func TestWaitForAppDeploymentTimeout(t *testing.T) {
+	defer func(interval time.Duration) { appDeploymentPollInterval = interval }(appDeploymentPollInterval)
+	appDeploymentPollInterval = 10 * time.Millisecond
+
+	// The deployment never completes.
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/apps/app-id/deployments":
+			fmt.Fprint(w, `{"deployments":[{"id":"deployment-id"}]}`)
+		case "/v2/apps/app-id/deployments/deployment-id":
+			fmt.Fprint(w, `{"deployment":{"id":"deployment-id","phase":"DEPLOYING","progress":{"pending_steps":1,"total_steps":1,"steps":[{"name":"build","status":"PENDING"}]}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	})).GodoClient()
+
+	for _, key := range []string{schema.TimeoutCreate, schema.TimeoutUpdate} {
+		t.Run(key, func(t *testing.T) {
+			r := ResourceDigitalOceanApp()
+			r.Timeouts = &schema.ResourceTimeout{
+				Create: godo.PtrTo(100 * time.Millisecond),
+				Update: godo.PtrTo(100 * time.Millisecond),
+			}
+			d := r.Data(&terraform.InstanceState{ID: "app-id"})
+
+			start := time.Now()
+			err := waitForAppDeployment(context.Background(), client, d.Id(), d.Timeout(key))
+			if err == nil || !strings.Contains(err.Error(), "timeout waiting for app (app-id) deployment") {
+				t.Fatalf("expected a timeout error, got: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the configured timeout to be honored, waited %s", elapsed)
+			}
+		})
+	}
+}
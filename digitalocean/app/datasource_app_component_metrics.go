@@ -0,0 +1,232 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// appMetricsBasePath is the prefix of the App Platform metrics endpoints. They
+// have no godo methods, but return the same format as the Droplet metrics.
+const appMetricsBasePath = "/v2/monitoring/metrics/apps"
+
+var appComponentMetrics = map[string]string{
+	"cpu":    "cpu_percentage",
+	"memory": "memory_percentage",
+}
+
+func DataSourceDigitalOceanAppComponentMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanAppComponentMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"component_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the service, worker, or job component.",
+			},
+			"metric": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cpu", "memory"}, false),
+				Description:  "The utilization metric to retrieve: 'cpu' or 'memory'.",
+			},
+			"window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "720h",
+				ValidateFunc: validateAppMetricsWindow,
+				Description:  "How far back from now to retrieve metrics for, as a duration, e.g. '24h'. Defaults to 30 days.",
+			},
+			"start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the time range the metrics cover.",
+			},
+			"end": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the time range the metrics cover.",
+			},
+			"average": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The mean utilization percentage across all samples.",
+			},
+			"p95": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The 95th percentile utilization percentage across all samples.",
+			},
+			"max": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The maximum utilization percentage across all samples.",
+			},
+			"series": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The raw time series returned by the API, one per component instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timestamp": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanAppComponentMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	appID := d.Get("app_id").(string)
+	component := d.Get("component_name").(string)
+	metric := d.Get("metric").(string)
+
+	window, err := time.ParseDuration(d.Get("window").(string))
+	if err != nil {
+		return diag.Errorf("Error parsing window: %s", err)
+	}
+
+	end := time.Now().UTC().Truncate(time.Minute)
+	start := end.Add(-window)
+
+	path := fmt.Sprintf("%s/%s", appMetricsBasePath, appComponentMetrics[metric])
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	q := req.URL.Query()
+	q.Add("app_id", appID)
+	q.Add("app_component", component)
+	q.Add("start", fmt.Sprintf("%d", start.Unix()))
+	q.Add("end", fmt.Sprintf("%d", end.Unix()))
+	req.URL.RawQuery = q.Encode()
+
+	metricsResp := new(godo.MetricsResponse)
+	_, err = client.Do(ctx, req, metricsResp)
+	if err != nil {
+		return diag.Errorf("Error retrieving %s metrics for app (%s) component (%s): %s", metric, appID, component, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", appID, component, metric))
+	d.Set("start", start.Format(time.RFC3339))
+	d.Set("end", end.Format(time.RFC3339))
+
+	average, p95, max := aggregateAppMetrics(metricsResp.Data.Result)
+	d.Set("average", average)
+	d.Set("p95", p95)
+	d.Set("max", max)
+
+	if err := d.Set("series", flattenAppMetricsSeries(metricsResp.Data.Result)); err != nil {
+		return diag.Errorf("Error setting series: %s", err)
+	}
+
+	return nil
+}
+
+func validateAppMetricsWindow(v interface{}, k string) (ws []string, errors []error) {
+	window, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. \"24h\": %s", k, err))
+		return
+	}
+
+	if window <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got: %s", k, v))
+	}
+
+	return
+}
+
+// aggregateAppMetrics returns the mean, 95th percentile (nearest-rank), and
+// maximum of every sample across all of the given series.
+func aggregateAppMetrics(series []metrics.SampleStream) (float64, float64, float64) {
+	values := make([]float64, 0)
+	for _, s := range series {
+		for _, v := range s.Values {
+			value := float64(v.Value)
+			if math.IsNaN(value) {
+				continue
+			}
+			values = append(values, value)
+		}
+	}
+
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	rank := int(math.Ceil(0.95*float64(len(values)))) - 1
+
+	return sum / float64(len(values)), values[rank], values[len(values)-1]
+}
+
+func flattenAppMetricsSeries(series []metrics.SampleStream) []interface{} {
+	result := make([]interface{}, 0, len(series))
+	for _, s := range series {
+		labels := make(map[string]interface{}, len(s.Metric))
+		for k, v := range s.Metric {
+			labels[string(k)] = string(v)
+		}
+
+		values := make([]interface{}, 0, len(s.Values))
+		for _, v := range s.Values {
+			values = append(values, map[string]interface{}{
+				"timestamp": v.Timestamp.Time().UTC().Format(time.RFC3339),
+				"value":     float64(v.Value),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"labels": labels,
+			"values": values,
+		})
+	}
+
+	return result
+}
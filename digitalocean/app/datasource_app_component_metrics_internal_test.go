@@ -0,0 +1,74 @@
+package app
+
+import (
+	"math"
+	"testing"
+
+	"github.com/digitalocean/godo/metrics"
+)
+
+func appMetricsTestSeries(values ...float64) metrics.SampleStream {
+	s := metrics.SampleStream{}
+	for i, v := range values {
+		s.Values = append(s.Values, metrics.SamplePair{
+			Timestamp: metrics.Time(int64(i) * 60000),
+			Value:     metrics.SampleValue(v),
+		})
+	}
+	return s
+}
+
+func TestAggregateAppMetrics(t *testing.T) {
+	// 1 to 20 split over two series, given out of order.
+	var first, second []float64
+	for i := 20; i > 0; i-- {
+		if i%2 == 0 {
+			first = append(first, float64(i))
+		} else {
+			second = append(second, float64(i))
+		}
+	}
+
+	cases := []struct {
+		name         string
+		series       []metrics.SampleStream
+		expectedMean float64
+		expectedP95  float64
+		expectedMax  float64
+	}{
+		{
+			name: "NoSamples",
+		},
+		{
+			name:         "AcrossSeries",
+			series:       []metrics.SampleStream{appMetricsTestSeries(first...), appMetricsTestSeries(second...)},
+			expectedMean: 10.5,
+			expectedP95:  19,
+			expectedMax:  20,
+		},
+		{
+			// With few samples the nearest rank is the maximum.
+			name:         "FewSamples",
+			series:       []metrics.SampleStream{appMetricsTestSeries(3, 1, 2)},
+			expectedMean: 2,
+			expectedP95:  3,
+			expectedMax:  3,
+		},
+		{
+			name:         "SkipsNaN",
+			series:       []metrics.SampleStream{appMetricsTestSeries(4, math.NaN(), 2)},
+			expectedMean: 3,
+			expectedP95:  4,
+			expectedMax:  4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mean, p95, maximum := aggregateAppMetrics(tc.series)
+			if mean != tc.expectedMean || p95 != tc.expectedP95 || maximum != tc.expectedMax {
+				t.Errorf("expected mean %v, p95 %v and max %v, got %v, %v and %v", tc.expectedMean, tc.expectedP95, tc.expectedMax, mean, p95, maximum)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanAppComponentMetrics_Basic(t *testing.T) {
+	var app godo.App
+	appName := acceptance.RandomTestName()
+	appCreateConfig := fmt.Sprintf(testAccCheckDigitalOceanAppConfig_basic, appName)
+	metricsDataConfig := fmt.Sprintf(testAccCheckDataSourceDigitalOceanAppComponentMetricsConfig, appCreateConfig)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: appCreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanAppExists("digitalocean_app.foobar", &app),
+				),
+			},
+			{
+				Config: metricsDataConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_app_component_metrics.cpu", "component_name", "go-service"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_app_component_metrics.cpu", "window", "1h"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.cpu", "start"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.cpu", "end"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.cpu", "average"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.cpu", "p95"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.cpu", "max"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_app_component_metrics.memory", "window", "720h"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_app_component_metrics.memory", "max"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanAppComponentMetricsConfig = `
+%s
+
+data "digitalocean_app_component_metrics" "cpu" {
+  app_id         = digitalocean_app.foobar.id
+  component_name = "go-service"
+  metric         = "cpu"
+  window         = "1h"
+}
+
+data "digitalocean_app_component_metrics" "memory" {
+  app_id         = digitalocean_app.foobar.id
+  component_name = "go-service"
+  metric         = "memory"
+}`
@@ -9,6 +9,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -83,6 +84,12 @@ func ResourceDigitalOceanApp() *schema.Resource {
 				Computed: true,
 			},
 
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The slug of the region the app is deployed in",
+			},
+
 			"live_domain": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -118,8 +125,81 @@ func ResourceDigitalOceanApp() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+
+		CustomizeDiff: customdiff.All(
+			forceNewOnAppRegionChange,
+			validateAppImageSources,
+		),
+	}
+}
+
+// forceNewOnAppRegionChange replaces the app when the region in its spec is
+// changed, as the API ignores region changes on update. Removing the region
+// from the spec keeps the app where it is, as does setting it to the region
+// the app is already deployed in.
+func forceNewOnAppRegionChange(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("spec.0.region") {
+		return nil
 	}
+
+	region := diff.Get("spec.0.region").(string)
+	if region == "" || region == diff.Get("region").(string) {
+		return nil
+	}
+
+	return diff.ForceNew("spec.0.region")
+}
+
+// validateAppImageSources checks the registry of the image of each component
+// against its registry type: the DOCKER_HUB and GHCR images must name their
+// registry, and registry credentials are only used by them. DOCR images may
+// name the registry of another team, and default to the registry of the app's
+// team otherwise.
+func validateAppImageSources(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, componentType := range []string{"service", "worker", "job"} {
+		components, ok := diff.Get("spec.0." + componentType).([]interface{})
+		if !ok {
+			continue
+		}
+
+		for i, raw := range components {
+			component, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			image, ok := component["image"].([]interface{})
+			if !ok || len(image) == 0 || image[0] == nil {
+				continue
+			}
+
+			key := fmt.Sprintf("spec.0.%s.%d.image.0", componentType, i)
+			if !diff.NewValueKnown(key+".registry_type") ||
+				!diff.NewValueKnown(key+".registry") ||
+				!diff.NewValueKnown(key+".registry_credentials") {
+				continue
+			}
+
+			imageSource := image[0].(map[string]interface{})
+			registryType := imageSource["registry_type"].(string)
+
+			switch godo.ImageSourceSpecRegistryType(registryType) {
+			case godo.ImageSourceSpecRegistryType_DockerHub, godo.ImageSourceSpecRegistryType_Ghcr:
+				if imageSource["registry"].(string) == "" {
+					return fmt.Errorf("%s.registry is required for the %s registry type", key, registryType)
+				}
+			case godo.ImageSourceSpecRegistryType_DOCR:
+				if imageSource["registry_credentials"].(string) != "" {
+					return fmt.Errorf("%s.registry_credentials is not supported for the %s registry type", key, registryType)
+				}
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -132,7 +212,7 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] App create request: %#v", appCreateRequest)
-	app, _, err := client.Apps.Create(context.Background(), appCreateRequest)
+	app, _, err := client.Apps.Create(ctx, appCreateRequest)
 	if err != nil {
 		return diag.Errorf("Error creating App: %s", err)
 	}
@@ -140,7 +220,7 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(app.ID)
 	log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
 	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForAppDeployment(client, app.ID, timeout)
+	err = waitForAppDeployment(ctx, client, app.ID, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -167,6 +247,9 @@ func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("default_ingress", app.DefaultIngress)
 	d.Set("live_url", app.LiveURL)
 	d.Set("live_domain", app.LiveDomain)
+	if app.Region != nil {
+		d.Set("region", app.Region.Slug)
+	}
 	d.Set("updated_at", app.UpdatedAt.UTC().String())
 	d.Set("created_at", app.CreatedAt.UTC().String())
 	d.Set("urn", app.URN())
@@ -214,14 +297,14 @@ func resourceDigitalOceanAppUpdate(ctx context.Context, d *schema.ResourceData,
 		appUpdateRequest := &godo.AppUpdateRequest{}
 		appUpdateRequest.Spec = expandAppSpec(d.Get("spec").([]interface{}))
 
-		app, _, err := client.Apps.Update(context.Background(), d.Id(), appUpdateRequest)
+		app, _, err := client.Apps.Update(ctx, d.Id(), appUpdateRequest)
 		if err != nil {
 			return diag.Errorf("Error updating app (%s): %s", d.Id(), err)
 		}
 
 		log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
-		timeout := d.Timeout(schema.TimeoutCreate)
-		err = waitForAppDeployment(client, app.ID, timeout)
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = waitForAppDeployment(ctx, client, app.ID, timeout)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -236,7 +319,7 @@ func resourceDigitalOceanAppDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting App: %s", d.Id())
-	_, err := client.Apps.Delete(context.Background(), d.Id())
+	_, err := client.Apps.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deletingApp: %s", err)
 	}
@@ -245,15 +328,17 @@ func resourceDigitalOceanAppDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration) error {
-	tickerInterval := 10 //10s
-	timeoutSeconds := int(timeout.Seconds())
+// appDeploymentPollInterval is the interval at which the deployment of an
+// app is polled.
+var appDeploymentPollInterval = 10 * time.Second
+
+func waitForAppDeployment(ctx context.Context, client *godo.Client, id string, timeout time.Duration) error {
 	n := 0
 
 	var deploymentID string
-	ticker := time.NewTicker(time.Duration(tickerInterval) * time.Second)
+	ticker := time.NewTicker(appDeploymentPollInterval)
 	for range ticker.C {
-		if n*tickerInterval > timeoutSeconds {
+		if time.Duration(n)*appDeploymentPollInterval > timeout {
 			ticker.Stop()
 			break
 		}
@@ -267,7 +352,7 @@ func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration)
 			// already completed. So instead we need to list all of the
 			// deployments for the application.
 			opts := &godo.ListOptions{PerPage: 20}
-			deployments, _, err := client.Apps.ListDeployments(context.Background(), id, opts)
+			deployments, _, err := client.Apps.ListDeployments(ctx, id, opts)
 			if err != nil {
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
 			}
@@ -281,7 +366,7 @@ func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration)
 				deploymentID = deployments[0].ID
 			}
 		} else {
-			deployment, _, err := client.Apps.GetDeployment(context.Background(), id, deploymentID)
+			deployment, _, err := client.Apps.GetDeployment(ctx, id, deploymentID)
 			if err != nil {
 				ticker.Stop()
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
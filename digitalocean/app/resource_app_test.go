@@ -3,6 +3,7 @@ package app_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -49,6 +50,82 @@ func TestAccDigitalOceanApp_Image(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanApp_RegionChange(t *testing.T) {
+	var app godo.App
+	var movedApp godo.App
+	appName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: testAccCheckDigitalOceanAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanAppConfig_region, appName, "ams"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanAppExists("digitalocean_app.foobar", &app),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.region", "ams"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "region", "ams"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanAppConfig_region, appName, "nyc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanAppExists("digitalocean_app.foobar", &movedApp),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.region", "nyc"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "region", "nyc"),
+					func(s *terraform.State) error {
+						if app.ID == movedApp.ID {
+							return fmt.Errorf("expected app to be replaced when its region changed, ID is unchanged: %s", app.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanApp_ImageGHCRCredentials(t *testing.T) {
+	repository := os.Getenv("DO_TEST_GHCR_REPOSITORY")
+	credentials := os.Getenv("DO_TEST_GHCR_CREDENTIALS")
+	if repository == "" || credentials == "" {
+		t.Skip("Test requires a private GHCR image. Set DO_TEST_GHCR_REPOSITORY to <owner>/<image> and DO_TEST_GHCR_CREDENTIALS to <username>:<token>")
+	}
+
+	var app godo.App
+	appName := acceptance.RandomTestName()
+	appConfig := fmt.Sprintf(testAccCheckDigitalOceanAppConfig_ghcrImage, appName, repository, credentials)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: appConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanAppExists("digitalocean_app.foobar", &app),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.image.0.registry_type", "GHCR"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.image.0.registry_credentials", credentials),
+				),
+			},
+			{
+				// The encrypted credentials returned by the API do not
+				// produce a diff.
+				Config:   appConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanApp_Basic(t *testing.T) {
 	var app godo.App
 	appName := acceptance.RandomTestName()
@@ -1184,6 +1261,52 @@ resource "digitalocean_app" "foobar" {
   }
 }`
 
+var testAccCheckDigitalOceanAppConfig_ghcrImage = `
+resource "digitalocean_app" "foobar" {
+  spec {
+    name   = "%s"
+    region = "ams"
+
+    service {
+      name               = "image-service"
+      instance_count     = 1
+      instance_size_slug = "basic-xxs"
+
+      image {
+        registry_type        = "GHCR"
+        repository           = "%s"
+        tag                  = "latest"
+        registry_credentials = "%s"
+      }
+
+      http_port = 80
+    }
+  }
+}`
+
+var testAccCheckDigitalOceanAppConfig_region = `
+resource "digitalocean_app" "foobar" {
+  spec {
+    name   = "%s"
+    region = "%s"
+
+    service {
+      name               = "image-service"
+      instance_count     = 1
+      instance_size_slug = "basic-xxs"
+
+      image {
+        registry_type = "DOCKER_HUB"
+        registry      = "caddy"
+        repository    = "caddy"
+        tag           = "2.2.1-alpine"
+      }
+
+      http_port = 80
+    }
+  }
+}`
+
 var testAccCheckDigitalOceanAppConfig_addInternalPort = `
 resource "digitalocean_app" "foobar" {
   spec {
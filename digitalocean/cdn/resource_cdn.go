@@ -2,8 +2,10 @@ package cdn
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +21,9 @@ import (
 
 var (
 	needsCloudflareCert = "needs-cloudflare-cert"
+
+	// cdnTTLs are the TTLs, in seconds, accepted by the API.
+	cdnTTLs = []int{60, 600, 3600, 86400, 604800}
 )
 
 func ResourceDigitalOceanCDN() *schema.Resource {
@@ -77,7 +82,7 @@ func resourceDigitalOceanCDNv0() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "The amount of time the content is cached in the CDN",
-				ValidateFunc: validation.IntAtLeast(0),
+				ValidateFunc: validateCDNTTL,
 			},
 			"certificate_id": {
 				Type:        schema.TypeString,
@@ -131,6 +136,7 @@ func migrateCDNStateV0toV1(ctx context.Context, rawState map[string]interface{},
 func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	var diags diag.Diagnostics
 	cdnRequest := &godo.CDNCreateRequest{
 		Origin: d.Get("origin").(string),
 	}
@@ -143,43 +149,12 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 		cdnRequest.CustomDomain = v.(string)
 	}
 
-	if name, nameOk := d.GetOk("certificate_name"); nameOk {
-		certName := name.(string)
-		if certName != "" {
-			if certName == needsCloudflareCert {
-				cdnRequest.CertificateID = needsCloudflareCert
-			} else {
-				cert, err := certificate.FindCertificateByName(client, certName)
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				cdnRequest.CertificateID = cert.ID
-			}
-		}
-	}
-
-	if id, idOk := d.GetOk("certificate_id"); idOk && cdnRequest.CertificateID == "" {
-		// When the certificate type is lets_encrypt, the certificate
-		// ID will change when it's renewed, so we have to rely on the
-		// certificate name as the primary identifier instead.
-		certName := id.(string)
-		if certName != "" {
-			cert, err := certificate.FindCertificateByName(client, certName)
-			if err != nil {
-				if strings.Contains(err.Error(), "not found") {
-					log.Println("[DEBUG] Certificate not found looking up by name. Falling back to lookup by ID.")
-					cert, _, err = client.Certificates.Get(context.Background(), certName)
-					if err != nil {
-						return diag.FromErr(err)
-					}
-				} else {
-					return diag.FromErr(err)
-				}
-			}
-
-			cdnRequest.CertificateID = cert.ID
-		}
+	certID, certDiags, err := expandCDNCertificateID(client, d, cdnRequest.CustomDomain)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	cdnRequest.CertificateID = certID
+	diags = append(diags, certDiags...)
 
 	log.Printf("[DEBUG] CDN create request: %#v", cdnRequest)
 	cdn, _, err := client.CDNs.Create(context.Background(), cdnRequest)
@@ -190,7 +165,7 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(cdn.ID)
 	log.Printf("[INFO] CDN created, ID: %s", d.Id())
 
-	return resourceDigitalOceanCDNRead(ctx, d, meta)
+	return append(diags, resourceDigitalOceanCDNRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanCDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -268,6 +243,7 @@ func getCDNWithRetryBackoff(ctx context.Context, client *godo.Client, id string)
 func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	var diags diag.Diagnostics
 	d.Partial(true)
 
 	if d.HasChange("ttl") {
@@ -287,30 +263,95 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 			CustomDomain: d.Get("custom_domain").(string),
 		}
 
-		certName := d.Get("certificate_name").(string)
-		if certName != "" {
-			if certName == needsCloudflareCert {
-				cdnUpdateRequest.CertificateID = needsCloudflareCert
-			} else {
-				cert, err := certificate.FindCertificateByName(client, certName)
-				if err != nil {
-					return diag.FromErr(err)
-				}
-
-				cdnUpdateRequest.CertificateID = cert.ID
+		certID, certDiags, err := expandCDNCertificateID(client, d, cdnUpdateRequest.CustomDomain)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		cdnUpdateRequest.CertificateID = certID
+		diags = append(diags, certDiags...)
+
+		// Updating the custom domain validates it again, so it is only done
+		// when the domain or certificate differ from the CDN's, rather than
+		// when only how the certificate is referenced has changed.
+		cdn, _, err := getCDNWithRetryBackoff(ctx, client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error reading CDN: %s", err)
+		}
+
+		if cdn.CustomDomain != cdnUpdateRequest.CustomDomain || cdn.CertificateID != cdnUpdateRequest.CertificateID {
+			_, _, err = client.CDNs.UpdateCustomDomain(context.Background(), d.Id(), cdnUpdateRequest)
+			if err != nil {
+				return diag.Errorf("Error updating CDN custom domain: %s", err)
 			}
+			log.Printf("[INFO] Updated custom domain/certificate on CDN")
 		}
+	}
 
-		_, _, err := client.CDNs.UpdateCustomDomain(context.Background(), d.Id(), cdnUpdateRequest)
+	d.Partial(false)
+	return append(diags, resourceDigitalOceanCDNRead(ctx, d, meta)...)
+}
 
+// expandCDNCertificateID returns the ID of the certificate configured by
+// certificate_name, or by the deprecated certificate_id, which may hold the
+// name or the ID of the certificate. As both are computed from the other,
+// certificate_id is used if it is the one that was changed.
+func expandCDNCertificateID(client *godo.Client, d *schema.ResourceData, customDomain string) (string, diag.Diagnostics, error) {
+	useID := d.Get("certificate_id").(string) != "" && d.HasChange("certificate_id") && !d.HasChange("certificate_name")
+	if certName := d.Get("certificate_name").(string); certName != "" && !useID {
+		if certName == needsCloudflareCert {
+			return needsCloudflareCert, nil, nil
+		}
+
+		cert, err := certificate.FindCertificateByName(client, certName)
 		if err != nil {
-			return diag.Errorf("Error updating CDN custom domain: %s", err)
+			return "", nil, err
 		}
-		log.Printf("[INFO] Updated custom domain/certificate on CDN")
+		return cert.ID, certificate.CertificateDomainWarnings(cert, customDomain), nil
 	}
 
-	d.Partial(false)
-	return resourceDigitalOceanCDNRead(ctx, d, meta)
+	// When the certificate type is lets_encrypt, the certificate
+	// ID will change when it's renewed, so we have to rely on the
+	// certificate name as the primary identifier instead.
+	certName := d.Get("certificate_id").(string)
+	if certName == "" {
+		return "", nil, nil
+	}
+
+	cert, err := certificate.FindCertificateByName(client, certName)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not found") {
+			return "", nil, err
+		}
+
+		log.Println("[DEBUG] Certificate not found looking up by name. Falling back to lookup by ID.")
+		cert, _, err = client.Certificates.Get(context.Background(), certName)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return cert.ID, certificate.CertificateDomainWarnings(cert, customDomain), nil
+}
+
+func validateCDNTTL(v interface{}, k string) (ws []string, errors []error) {
+	ttl, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be integer", k))
+		return
+	}
+
+	for _, allowed := range cdnTTLs {
+		if ttl == allowed {
+			return
+		}
+	}
+
+	values := make([]string, 0, len(cdnTTLs))
+	for _, allowed := range cdnTTLs {
+		values = append(values, strconv.Itoa(allowed))
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of %s seconds, got %d; caching can not be disabled, %d is the shortest TTL", k, strings.Join(values, ", "), ttl, cdnTTLs[0]))
+	return
 }
 
 func resourceDigitalOceanCDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
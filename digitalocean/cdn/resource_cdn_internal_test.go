@@ -0,0 +1,122 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestDigitalOceanCDNValidateTTL(t *testing.T) {
+	validate := ResourceDigitalOceanCDN().Schema["ttl"].ValidateFunc
+
+	for _, ttl := range []int{60, 600, 3600, 86400, 604800} {
+		if _, errs := validate(ttl, "ttl"); len(errs) > 0 {
+			t.Errorf("expected ttl %d to be valid, got: %v", ttl, errs)
+		}
+	}
+
+	for _, ttl := range []int{0, 30, 1800} {
+		_, errs := validate(ttl, "ttl")
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), "60, 600, 3600, 86400, 604800") {
+			t.Errorf("expected ttl %d to be rejected with the allowed values, got: %v", ttl, errs)
+		}
+	}
+}
+
+func TestDigitalOceanCDNUpdateTTLOnly(t *testing.T) {
+	certID := "892071a0-bb95-49bc-8021-3afd67a210bf"
+	var ttlUpdates, domainUpdates int
+
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/v2/cdn/endpoints/cdn-id":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("unable to decode request body: %s", err)
+			}
+			if _, ok := body["custom_domain"]; ok {
+				domainUpdates++
+			} else {
+				ttlUpdates++
+			}
+			fmt.Fprint(w, `{"endpoint":{"id":"cdn-id"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/cdn/endpoints/cdn-id":
+			ttl := 3600
+			if ttlUpdates > 0 {
+				ttl = 600
+			}
+			fmt.Fprintf(w, `{"endpoint":{"id":"cdn-id","origin":"bucket.ams3.digitaloceanspaces.com","ttl":%d,"custom_domain":"static.example.com","certificate_id":%q,"created_at":"2024-06-01T10:00:00Z"}}`, ttl, certID)
+		case r.URL.Path == "/v2/certificates" && r.URL.Query().Get("name") == certID:
+			fmt.Fprint(w, `{"certificates":[],"links":{}}`)
+		case r.URL.Path == "/v2/certificates/"+certID:
+			fmt.Fprintf(w, `{"certificate":{"id":%q,"name":"static-cert","dns_names":["static.example.com"]}}`, certID)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	state := &terraform.InstanceState{
+		ID: "cdn-id",
+		Attributes: map[string]string{
+			"id":               "cdn-id",
+			"origin":           "bucket.ams3.digitaloceanspaces.com",
+			"ttl":              "3600",
+			"custom_domain":    "static.example.com",
+			"certificate_id":   "static-cert",
+			"certificate_name": "static-cert",
+		},
+	}
+
+	// The deprecated certificate_id configured with the ID of the
+	// certificate differs from the name it is stored as.
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"origin":         "bucket.ams3.digitaloceanspaces.com",
+		"ttl":            600,
+		"custom_domain":  "static.example.com",
+		"certificate_id": certID,
+	})
+
+	r := ResourceDigitalOceanCDN()
+	diff, err := r.Diff(context.Background(), state, cfg, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if ttlUpdates != 1 {
+		t.Errorf("expected the TTL to be updated once, got %d updates", ttlUpdates)
+	}
+	if domainUpdates != 0 {
+		t.Errorf("expected the custom domain not to be updated, got %d updates", domainUpdates)
+	}
+	if newState.Attributes["ttl"] != "600" {
+		t.Errorf("expected ttl 600, got %s", newState.Attributes["ttl"])
+	}
+}
@@ -0,0 +1,170 @@
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// parsePEMCertificates decodes every CERTIFICATE block found in data.
+func parsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate %d: %s", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificates found")
+	}
+
+	return certs, nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+// chainLinkWarnings walks the certificate chain and describes every place
+// where a certificate is not issued by the one that follows it. Chains that
+// stop at an intermediate are supported, as the root is expected to be in
+// the client's trust store.
+func chainLinkWarnings(chain []*x509.Certificate) []string {
+	var warnings []string
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"certificate %d in the chain (%q) is not issued by certificate %d (%q)",
+				i+1, chain[i].Subject.CommonName, i+2, chain[i+1].Subject.CommonName))
+		}
+	}
+
+	return warnings
+}
+
+// leafCertificateWarnings checks that the leaf certificate links to the first
+// certificate in the chain. The chain itself is checked when the
+// configuration is validated.
+func leafCertificateWarnings(leafPEM string, chainPEM string) []string {
+	leafCerts, err := parsePEMCertificates(leafPEM)
+	if err != nil {
+		return []string{fmt.Sprintf("unable to parse leaf_certificate: %s", err)}
+	}
+	leaf := leafCerts[0]
+
+	if chainPEM == "" {
+		if !isSelfSigned(leaf) {
+			return []string{fmt.Sprintf(
+				"no certificate_chain was provided; clients which do not already have the issuer of the leaf certificate (%q) will fail to verify it",
+				leaf.Issuer.CommonName)}
+		}
+		return nil
+	}
+
+	chain, err := parsePEMCertificates(chainPEM)
+	if err != nil {
+		return []string{fmt.Sprintf("unable to parse certificate_chain: %s", err)}
+	}
+
+	if err := leaf.CheckSignatureFrom(chain[0]); err != nil {
+		return []string{fmt.Sprintf(
+			"the leaf certificate is not issued by the first certificate in certificate_chain (%q); an intermediate certificate may be missing or out of order, expected the issuer %q",
+			chain[0].Subject.CommonName, leaf.Issuer.CommonName)}
+	}
+
+	return nil
+}
+
+func validateCertificateChain(v interface{}, path cty.Path) diag.Diagnostics {
+	diags := validation.ToDiagFunc(validation.NoZeroValues)(v, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	chain, err := parsePEMCertificates(v.(string))
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unable to parse certificate chain",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	for _, w := range chainLinkWarnings(chain) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Incomplete certificate chain",
+			Detail:        w,
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// certificateCoversDomain reports whether any of the names in sans match the
+// domain, taking single label wildcards into account.
+func certificateCoversDomain(sans []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	for _, san := range sans {
+		san = strings.ToLower(strings.TrimSuffix(san, "."))
+		if san == domain {
+			return true
+		}
+
+		if strings.HasPrefix(san, "*.") {
+			i := strings.Index(domain, ".")
+			if i > 0 && domain[i+1:] == san[2:] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CertificateDomainWarnings returns a warning when the certificate's subject
+// alternative names do not cover the given domain. It is used by resources
+// which attach a certificate to a custom domain, such as load balancers and
+// CDN endpoints.
+func CertificateDomainWarnings(cert *godo.Certificate, domain string) diag.Diagnostics {
+	if cert == nil || domain == "" || len(cert.DNSNames) == 0 {
+		return nil
+	}
+
+	if certificateCoversDomain(cert.DNSNames, domain) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Certificate does not cover domain",
+			Detail: fmt.Sprintf("The certificate %q is valid for %s, which does not include %q. TLS connections to this domain will fail.",
+				cert.Name, strings.Join(cert.DNSNames, ", "), domain),
+		},
+	}
+}
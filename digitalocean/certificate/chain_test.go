@@ -0,0 +1,198 @@
+package certificate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func readCertFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("unable to read fixture %s: %s", name, err)
+	}
+
+	return string(b)
+}
+
+func TestLeafCertificateWarnings(t *testing.T) {
+	leaf := readCertFixture(t, "leaf.pem")
+	intermediate := readCertFixture(t, "intermediate.pem")
+	other := readCertFixture(t, "other_intermediate.pem")
+	root := readCertFixture(t, "root.pem")
+
+	cases := []struct {
+		name     string
+		leaf     string
+		chain    string
+		warnings int
+		contains string
+	}{
+		{
+			name:     "full chain",
+			leaf:     leaf,
+			chain:    intermediate + root,
+			warnings: 0,
+		},
+		{
+			name:     "intermediate only chain",
+			leaf:     leaf,
+			chain:    intermediate,
+			warnings: 0,
+		},
+		{
+			name:     "missing intermediate",
+			leaf:     leaf,
+			chain:    root,
+			warnings: 1,
+			contains: "Test Intermediate CA",
+		},
+		{
+			name:     "wrong intermediate",
+			leaf:     leaf,
+			chain:    other + root,
+			warnings: 1,
+			contains: "not issued by the first certificate",
+		},
+		{
+			name:     "no chain",
+			leaf:     leaf,
+			warnings: 1,
+			contains: "no certificate_chain was provided",
+		},
+		{
+			name:     "self-signed without chain",
+			leaf:     root,
+			warnings: 0,
+		},
+		{
+			name:     "invalid leaf",
+			leaf:     "not a certificate",
+			chain:    intermediate,
+			warnings: 1,
+			contains: "unable to parse leaf_certificate",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings := leafCertificateWarnings(tc.leaf, tc.chain)
+			if len(warnings) != tc.warnings {
+				t.Fatalf("expected %d warnings, got %d: %v", tc.warnings, len(warnings), warnings)
+			}
+
+			if tc.contains != "" && !strings.Contains(warnings[0], tc.contains) {
+				t.Errorf("expected warning to contain %q, got %q", tc.contains, warnings[0])
+			}
+		})
+	}
+}
+
+func TestValidateCertificateChain(t *testing.T) {
+	intermediate := readCertFixture(t, "intermediate.pem")
+	other := readCertFixture(t, "other_intermediate.pem")
+	root := readCertFixture(t, "root.pem")
+	leaf := readCertFixture(t, "leaf.pem")
+
+	cases := []struct {
+		name     string
+		chain    string
+		warnings int
+	}{
+		{
+			name:     "ordered chain",
+			chain:    intermediate + root,
+			warnings: 0,
+		},
+		{
+			name:     "single intermediate",
+			chain:    intermediate,
+			warnings: 0,
+		},
+		{
+			name:     "out of order",
+			chain:    root + intermediate,
+			warnings: 1,
+		},
+		{
+			name:     "gap in chain",
+			chain:    leaf + root,
+			warnings: 1,
+		},
+		{
+			name:     "unrelated certificates",
+			chain:    intermediate + other,
+			warnings: 1,
+		},
+		{
+			name:     "not PEM",
+			chain:    "garbage",
+			warnings: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateCertificateChain(tc.chain, cty.GetAttrPath("certificate_chain"))
+			if diags.HasError() {
+				t.Fatalf("expected only warnings, got %v", diags)
+			}
+
+			if len(diags) != tc.warnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.warnings, len(diags), diags)
+			}
+		})
+	}
+
+	if diags := validateCertificateChain("", cty.GetAttrPath("certificate_chain")); !diags.HasError() {
+		t.Error("expected an error for an empty chain")
+	}
+}
+
+func TestCertificateDomainWarnings(t *testing.T) {
+	leafCerts, err := parsePEMCertificates(readCertFixture(t, "leaf.pem"))
+	if err != nil {
+		t.Fatalf("unable to parse fixture: %s", err)
+	}
+
+	cert := &godo.Certificate{
+		Name:     "example",
+		DNSNames: leafCerts[0].DNSNames,
+	}
+
+	cases := []struct {
+		domain string
+		warn   bool
+	}{
+		{domain: "www.example.com", warn: false},
+		{domain: "WWW.Example.com.", warn: false},
+		{domain: "assets.cdn.example.com", warn: false},
+		{domain: "cdn.example.com", warn: true},
+		{domain: "a.b.cdn.example.com", warn: true},
+		{domain: "example.com", warn: true},
+		{domain: "", warn: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.domain, func(t *testing.T) {
+			diags := CertificateDomainWarnings(cert, tc.domain)
+			if diags.HasError() {
+				t.Fatalf("expected only warnings, got %v", diags)
+			}
+
+			if (len(diags) > 0) != tc.warn {
+				t.Errorf("expected warning %t for %q, got %v", tc.warn, tc.domain, diags)
+			}
+		})
+	}
+
+	if diags := CertificateDomainWarnings(&godo.Certificate{Name: "pending"}, "www.example.com"); len(diags) != 0 {
+		t.Errorf("expected no warnings when the SANs are unknown, got %v", diags)
+	}
+}
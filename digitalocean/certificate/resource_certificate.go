@@ -92,11 +92,11 @@ func resourceDigitalOceanCertificateV0() *schema.Resource {
 			},
 
 			"certificate_chain": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
-				StateFunc:    util.HashStringStateFunc(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateCertificateChain,
+				StateFunc:        util.HashStringStateFunc(),
 				// In order to support older statefiles with fully saved certificate_chain
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return new != "" && old == d.Get("certificate_chain")
@@ -241,7 +241,18 @@ func resourceDigitalOceanCertificateCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Error waiting for certificate (%s) to become active: %s", d.Get("name"), err)
 	}
 
-	return resourceDigitalOceanCertificateRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if certificateType == "custom" {
+		for _, w := range leafCertificateWarnings(certReq.LeafCertificate, certReq.CertificateChain) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Incomplete certificate chain",
+				Detail:   w,
+			})
+		}
+	}
+
+	return append(diags, resourceDigitalOceanCertificateRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
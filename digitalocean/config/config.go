@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -18,16 +19,18 @@ import (
 )
 
 type Config struct {
-	Token             string
-	APIEndpoint       string
-	SpacesAPIEndpoint string
-	AccessID          string
-	SecretKey         string
-	RequestsPerSecond float64
-	TerraformVersion  string
-	HTTPRetryMax      int
-	HTTPRetryWaitMax  float64
-	HTTPRetryWaitMin  float64
+	Token                string
+	APIEndpoint          string
+	SpacesAPIEndpoint    string
+	AccessID             string
+	SecretKey            string
+	RequestsPerSecond    float64
+	TerraformVersion     string
+	HTTPRetryMax         int
+	HTTPRetryWaitMax     float64
+	HTTPRetryWaitMin     float64
+	DropletCreateRetries int
+	EmitCostSummary      bool
 }
 
 type CombinedConfig struct {
@@ -35,10 +38,23 @@ type CombinedConfig struct {
 	spacesEndpointTemplate *template.Template
 	accessID               string
 	secretKey              string
+	dropletCreateRetries   int
+	vpcIDs                 sync.Map
+	costSummary            *CostSummary
 }
 
 func (c *CombinedConfig) GodoClient() *godo.Client { return c.client }
 
+func (c *CombinedConfig) DropletCreateRetries() int { return c.dropletCreateRetries }
+
+// CostSummary returns the summary of the cost changes of the apply, or nil if
+// emit_cost_summary is not enabled.
+func (c *CombinedConfig) CostSummary() *CostSummary { return c.costSummary }
+
+// VPCIDs caches the IDs of the VPCs resolved by name for the lifetime of the
+// provider, so that they are only looked up once per plan or apply.
+func (c *CombinedConfig) VPCIDs() *sync.Map { return &c.vpcIDs }
+
 func (c *CombinedConfig) SpacesClient(region string) (*session.Session, error) {
 	if c.accessID == "" || c.secretKey == "" {
 		err := fmt.Errorf("Spaces credentials not configured")
@@ -117,10 +133,17 @@ func (c *Config) Client() (*CombinedConfig, error) {
 
 	log.Printf("[INFO] DigitalOcean Client configured for URL: %s", godoClient.BaseURL.String())
 
+	var costSummary *CostSummary
+	if c.EmitCostSummary {
+		costSummary = &CostSummary{}
+	}
+
 	return &CombinedConfig{
 		client:                 godoClient,
 		spacesEndpointTemplate: spacesEndpointTemplate,
 		accessID:               c.AccessID,
 		secretKey:              c.SecretKey,
+		dropletCreateRetries:   c.DropletCreateRetries,
+		costSummary:            costSummary,
 	}, nil
 }
@@ -0,0 +1,38 @@
+package config
+
+import "sync"
+
+// CostChange is the estimated change of the monthly cost caused by creating or
+// destroying a resource.
+type CostChange struct {
+	ResourceType string
+	ID           string
+	Destroyed    bool
+
+	// Monthly is the estimated monthly cost of the resource in US dollars. It
+	// is only set if Known is true.
+	Monthly float64
+	Known   bool
+}
+
+// CostSummary aggregates the cost changes of the resources created and
+// destroyed by the provider, i.e. during a single plan or apply, for the
+// emit_cost_summary argument.
+type CostSummary struct {
+	mu      sync.Mutex
+	changes []CostChange
+}
+
+// Record adds a cost change to the summary and returns all of the changes
+// recorded so far.
+func (s *CostSummary) Record(change CostChange) []CostChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.changes = append(s.changes, change)
+
+	changes := make([]CostChange, len(s.changes))
+	copy(changes, s.changes)
+
+	return changes
+}
@@ -0,0 +1,237 @@
+// Package costs estimates the monthly cost of the resources created and
+// destroyed by the provider for the emit_cost_summary argument.
+package costs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// estimator returns the estimated monthly cost of a resource in US dollars,
+// and whether the cost is known at all. Only the prices of the Droplet sizes
+// are published through the API, so the costs of the resources priced
+// otherwise are unknown.
+type estimator func(ctx context.Context, client *godo.Client, d *schema.ResourceData) (float64, bool, error)
+
+// estimators is the cost model of the resource types whose creation and
+// destruction is included in the cost summary.
+var estimators = map[string]estimator{
+	"digitalocean_droplet":              estimateDroplet,
+	"digitalocean_kubernetes_cluster":   estimateKubernetesCluster,
+	"digitalocean_kubernetes_node_pool": estimateKubernetesNodePool,
+	"digitalocean_volume":               estimateUnknown,
+	"digitalocean_loadbalancer":         estimateUnknown,
+	"digitalocean_database_cluster":     estimateUnknown,
+	"digitalocean_reserved_ip":          estimateUnknown,
+	"digitalocean_floating_ip":          estimateUnknown,
+}
+
+// WrapResources records the cost changes of creating and destroying the
+// resources of the types in the cost model. When emit_cost_summary is enabled,
+// each create and delete returns a warning summarizing the cost changes of the
+// apply so far.
+func WrapResources(resources map[string]*schema.Resource) {
+	for resourceType, estimate := range estimators {
+		if r, ok := resources[resourceType]; ok {
+			wrapResource(resourceType, r, estimate)
+		}
+	}
+}
+
+func wrapResource(resourceType string, r *schema.Resource, estimate estimator) {
+	create := r.CreateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		return recordCostChange(ctx, resourceType, d.Id(), d, meta, estimate, false, diags)
+	}
+
+	del := r.DeleteContext
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		id := d.Id()
+		diags := del(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		// The ID is taken before the delete, which may clear it.
+		return recordCostChange(ctx, resourceType, id, d, meta, estimate, true, diags)
+	}
+}
+
+func recordCostChange(ctx context.Context, resourceType, id string, d *schema.ResourceData, meta interface{}, estimate estimator, destroyed bool, diags diag.Diagnostics) diag.Diagnostics {
+	combined, ok := meta.(*config.CombinedConfig)
+	if !ok || combined.CostSummary() == nil {
+		return diags
+	}
+
+	monthly, known, err := estimate(ctx, combined.GodoClient(), d)
+	if err != nil {
+		log.Printf("[WARN] Error estimating the monthly cost of %s (%s): %s", resourceType, id, err)
+		known = false
+	}
+
+	changes := combined.CostSummary().Record(config.CostChange{
+		ResourceType: resourceType,
+		ID:           id,
+		Destroyed:    destroyed,
+		Monthly:      monthly,
+		Known:        known,
+	})
+
+	return append(diags, summaryDiagnostic(changes))
+}
+
+// summaryDiagnostic returns the warning summarizing the cost changes of the
+// apply so far. As each resource is applied separately, the warning of the
+// last resource applied summarizes the whole apply.
+func summaryDiagnostic(changes []config.CostChange) diag.Diagnostic {
+	var detail strings.Builder
+	detail.WriteString("Estimated from the published DigitalOcean prices of the resources created and destroyed so far in this apply:\n\n")
+
+	var total float64
+	var unknown int
+	for _, change := range changes {
+		action, sign := "created", "+"
+		if change.Destroyed {
+			action, sign = "destroyed", "-"
+		}
+
+		cost := "unknown"
+		if change.Known {
+			cost = fmt.Sprintf("%s$%.2f/month", sign, change.Monthly)
+			if change.Destroyed {
+				total -= change.Monthly
+			} else {
+				total += change.Monthly
+			}
+		} else {
+			unknown++
+		}
+
+		fmt.Fprintf(&detail, "  %s %s %s: %s\n", change.ResourceType, change.ID, action, cost)
+	}
+
+	sign := "+"
+	if total < 0 {
+		sign = "-"
+		total = -total
+	}
+	fmt.Fprintf(&detail, "\nTotal: %s$%.2f/month", sign, total)
+	if unknown > 0 {
+		fmt.Fprintf(&detail, ", excluding %d resource(s) of unknown cost", unknown)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Estimated monthly cost change",
+		Detail:   detail.String(),
+	}
+}
+
+func estimateUnknown(ctx context.Context, client *godo.Client, d *schema.ResourceData) (float64, bool, error) {
+	return 0, false, nil
+}
+
+func estimateDroplet(ctx context.Context, client *godo.Client, d *schema.ResourceData) (float64, bool, error) {
+	return dropletSizePrice(ctx, client, d.Get("size").(string))
+}
+
+func estimateKubernetesNodePool(ctx context.Context, client *godo.Client, d *schema.ResourceData) (float64, bool, error) {
+	return nodePoolPrice(ctx, client, d.Get("size").(string), d.Get("node_count").(int), d.Get("actual_node_count").(int))
+}
+
+// estimateKubernetesCluster estimates the cost of the default node pool of a
+// cluster. The price of the highly available control plane is not published
+// through the API.
+func estimateKubernetesCluster(ctx context.Context, client *godo.Client, d *schema.ResourceData) (float64, bool, error) {
+	if d.Get("ha").(bool) {
+		return 0, false, nil
+	}
+
+	return nodePoolPrice(ctx, client,
+		d.Get("node_pool.0.size").(string),
+		d.Get("node_pool.0.node_count").(int),
+		d.Get("node_pool.0.actual_node_count").(int),
+	)
+}
+
+// nodePoolPrice returns the price of the nodes of a node pool. The actual
+// number of nodes is used if known, as the node count of an autoscaled pool is
+// not set.
+func nodePoolPrice(ctx context.Context, client *godo.Client, size string, nodeCount, actualNodeCount int) (float64, bool, error) {
+	if actualNodeCount > 0 {
+		nodeCount = actualNodeCount
+	}
+
+	price, known, err := dropletSizePrice(ctx, client, size)
+	if err != nil || !known {
+		return 0, false, err
+	}
+
+	return price * float64(nodeCount), true, nil
+}
+
+// sizePrices caches the monthly prices of the Droplet sizes by client, so that
+// they are only listed once per apply.
+var sizePrices sync.Map
+
+func dropletSizePrice(ctx context.Context, client *godo.Client, slug string) (float64, bool, error) {
+	var prices map[string]float64
+	if v, ok := sizePrices.Load(client); ok {
+		prices = v.(map[string]float64)
+	} else {
+		var err error
+		prices, err = listDropletSizePrices(ctx, client)
+		if err != nil {
+			return 0, false, err
+		}
+		sizePrices.Store(client, prices)
+	}
+
+	price, ok := prices[slug]
+	return price, ok, nil
+}
+
+func listDropletSizePrices(ctx context.Context, client *godo.Client) (map[string]float64, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	prices := make(map[string]float64)
+	for {
+		sizes, resp, err := client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		for _, size := range sizes {
+			prices[size.Slug] = size.PriceMonthly
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return prices, nil
+}
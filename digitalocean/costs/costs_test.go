@@ -0,0 +1,111 @@
+package costs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestSummaryDiagnostic(t *testing.T) {
+	d := summaryDiagnostic([]config.CostChange{
+		{ResourceType: "digitalocean_droplet", ID: "1", Monthly: 24, Known: true},
+		{ResourceType: "digitalocean_droplet", ID: "2", Monthly: 6, Known: true, Destroyed: true},
+		{ResourceType: "digitalocean_volume", ID: "v1"},
+	})
+
+	if d.Severity != diag.Warning {
+		t.Errorf("expected a warning, got severity %v", d.Severity)
+	}
+
+	for _, expected := range []string{
+		"digitalocean_droplet 1 created: +$24.00/month",
+		"digitalocean_droplet 2 destroyed: -$6.00/month",
+		"digitalocean_volume v1 created: unknown",
+		"Total: +$18.00/month, excluding 1 resource(s) of unknown cost",
+	} {
+		if !strings.Contains(d.Detail, expected) {
+			t.Errorf("expected the summary to contain %q, got:\n%s", expected, d.Detail)
+		}
+	}
+
+	d = summaryDiagnostic([]config.CostChange{
+		{ResourceType: "digitalocean_droplet", ID: "2", Monthly: 6, Known: true, Destroyed: true},
+	})
+	if !strings.HasSuffix(d.Detail, "Total: -$6.00/month") {
+		t.Errorf("expected a negative total, got:\n%s", d.Detail)
+	}
+}
+
+func TestEstimateKubernetesNodePool(t *testing.T) {
+	requests := 0
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/sizes" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"sizes":[{"slug":"s-1vcpu-2gb","price_monthly":12},{"slug":"s-2vcpu-4gb","price_monthly":24}],"links":{}}`)
+	})).GodoClient()
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected float64
+		known    bool
+	}{
+		{
+			name:     "NodeCount",
+			raw:      map[string]interface{}{"size": "s-2vcpu-4gb", "node_count": 3},
+			expected: 72,
+			known:    true,
+		},
+		{
+			name:     "NotPublished",
+			raw:      map[string]interface{}{"size": "gpu-h100x1-80gb", "node_count": 1},
+			expected: 0,
+			known:    false,
+		},
+	}
+
+	r := kubernetes.ResourceDigitalOceanKubernetesNodePool()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.raw)
+
+			monthly, known, err := estimateKubernetesNodePool(context.Background(), client, d)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if known != tc.known || monthly != tc.expected {
+				t.Errorf("expected %v (known %t), got %v (known %t)", tc.expected, tc.known, monthly, known)
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the sizes to be listed once, got %d requests", requests)
+	}
+}
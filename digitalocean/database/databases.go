@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the database cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the database cluster",
+		},
+		"engine": {
+			Type:        schema.TypeString,
+			Description: "the database engine used by the cluster",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "the engine version used by the cluster",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the database cluster is deployed in",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "the size slug of the database cluster's nodes",
+		},
+		"num_nodes": {
+			Type:        schema.TypeInt,
+			Description: "the number of nodes in the database cluster",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the database cluster",
+		},
+		"private_network_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the VPC in which the database cluster is located",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the project that the database cluster is assigned to",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getDigitalOceanDatabases(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var databaseList []interface{}
+
+	for {
+		databases, resp, err := client.Databases.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database clusters: %s", err)
+		}
+
+		for _, db := range databases {
+			databaseList = append(databaseList, db)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database clusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return databaseList, nil
+}
+
+// flattenDigitalOceanDatabase intentionally omits the cluster's connection
+// details so that credentials are not spread across state.
+func flattenDigitalOceanDatabase(rawDatabase, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	db := rawDatabase.(godo.Database)
+
+	flattenedDatabase := map[string]interface{}{
+		"id":                   db.ID,
+		"name":                 db.Name,
+		"engine":               db.EngineSlug,
+		"version":              db.VersionSlug,
+		"region":               db.RegionSlug,
+		"size":                 db.SizeSlug,
+		"num_nodes":            db.NumNodes,
+		"urn":                  db.URN(),
+		"private_network_uuid": db.PrivateNetworkUUID,
+		"project_id":           db.ProjectID,
+		"tags":                 tag.FlattenTags(db.Tags),
+	}
+
+	return flattenedDatabase, nil
+}
+
+func databaseDBSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseDBs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var dbList []interface{}
+
+	for {
+		dbs, resp, err := client.Databases.ListDBs(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases for cluster %s: %s", clusterID, err)
+		}
+
+		for _, db := range dbs {
+			dbList = append(dbList, db)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases for cluster %s: %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return dbList, nil
+}
+
+func flattenDigitalOceanDatabaseDB(rawDB, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	db := rawDB.(godo.DatabaseDB)
+
+	flattenedDB := map[string]interface{}{
+		"name": db.Name,
+	}
+
+	return flattenedDB, nil
+}
@@ -0,0 +1,23 @@
+package database
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
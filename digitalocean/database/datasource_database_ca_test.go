@@ -16,7 +16,8 @@ import (
 func TestAccDataSourceDigitalOceanDatabaseCA(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+	region := acceptance.TestAccRegion(t, "database:pg")
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -161,6 +161,17 @@ func DataSourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"replica_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -234,6 +245,14 @@ func dataSourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Re
 			d.Set("private_network_uuid", db.PrivateNetworkUUID)
 			d.Set("project_id", db.ProjectID)
 
+			replicaNames, err := listDatabaseReplicaNames(ctx, client, &db)
+			if err != nil {
+				return diag.Errorf("Error retrieving replicas for database cluster: %s", err)
+			}
+
+			d.Set("replica_names", replicaNames)
+			d.Set("replica_count", len(replicaNames))
+
 			break
 		}
 	}
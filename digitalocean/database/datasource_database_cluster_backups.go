@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseClusterBackups() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseBackupSchema(),
+		ResultAttributeName: "backups",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		GetRecords:    getDigitalOceanDatabaseBackups,
+		FlattenRecord: flattenDigitalOceanDatabaseBackup,
+	}
+
+	dataSource := datalist.NewResource(dataListConfig)
+
+	dataSource.Schema["latest_backup_created_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The creation time of the most recent of the backups, or an empty string if there are none",
+	}
+
+	readBackups := dataSource.ReadContext
+	dataSource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if diags := readBackups(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+
+		latest, err := latestDatabaseBackupCreatedAt(d.Get("backups").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("latest_backup_created_at", latest)
+
+		return nil
+	}
+
+	return dataSource
+}
+
+func databaseBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The time the backup was created, in RFC 3339 format",
+		},
+		"size_gigabytes": {
+			Type:        schema.TypeFloat,
+			Description: "The size of the backup in gigabytes",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseBackups(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var backupList []interface{}
+
+	for {
+		backups, resp, err := client.Databases.ListBackups(context.Background(), clusterID, opts)
+		if err != nil {
+			// The API responds with 412 until the first backup of a new
+			// cluster has been created.
+			if resp != nil && resp.StatusCode == http.StatusPreconditionFailed {
+				return backupList, nil
+			}
+			return nil, fmt.Errorf("Error retrieving backups for cluster %s: %s", clusterID, err)
+		}
+
+		for _, backup := range backups {
+			backupList = append(backupList, backup)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for cluster %s: %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return backupList, nil
+}
+
+func flattenDigitalOceanDatabaseBackup(rawBackup, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	backup := rawBackup.(godo.DatabaseBackup)
+
+	flattenedBackup := map[string]interface{}{
+		"created_at":     backup.CreatedAt.UTC().Format(time.RFC3339),
+		"size_gigabytes": backup.SizeGigabytes,
+	}
+
+	return flattenedBackup, nil
+}
+
+// latestDatabaseBackupCreatedAt returns the creation time of the most recent
+// of the flattened backups, so that it can be used as the backup_created_at
+// of a backup_restore without sorting the backups.
+func latestDatabaseBackupCreatedAt(backups []interface{}) (string, error) {
+	var latest string
+	var latestTime time.Time
+
+	for _, rawBackup := range backups {
+		createdAt := rawBackup.(map[string]interface{})["created_at"].(string)
+
+		t, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing backup creation time %s: %s", createdAt, err)
+		}
+
+		if latest == "" || t.After(latestTime) {
+			latest = createdAt
+			latestTime = t
+		}
+	}
+
+	return latest, nil
+}
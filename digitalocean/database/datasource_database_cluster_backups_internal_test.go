@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanDatabaseClusterBackupsRead(t *testing.T) {
+	cases := []struct {
+		name            string
+		status          int
+		body            string
+		sort            []interface{}
+		expectedCount   int
+		expectedFirst   string
+		expectedLatest  string
+		expectedSizeGiB float64
+	}{
+		{
+			name: "Backups",
+			body: `{"backups":[
+				{"created_at":"2024-06-02T00:00:00Z","size_gigabytes":0.04},
+				{"created_at":"2024-06-03T00:00:00Z","size_gigabytes":0.05},
+				{"created_at":"2024-06-01T00:00:00Z","size_gigabytes":0.03}]}`,
+			sort: []interface{}{
+				map[string]interface{}{"key": "created_at", "direction": "asc"},
+			},
+			expectedCount:   3,
+			expectedFirst:   "2024-06-01T00:00:00Z",
+			expectedLatest:  "2024-06-03T00:00:00Z",
+			expectedSizeGiB: 0.03,
+		},
+		{
+			// A new cluster has no backups until the first one is created.
+			name:   "NoBackupsYet",
+			status: http.StatusPreconditionFailed,
+			body:   `{"id":"precondition_failed","message":"backups are not yet available"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/databases/c1/backups" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+				}
+				fmt.Fprint(w, tc.body)
+			}))
+
+			raw := map[string]interface{}{"cluster_id": "c1"}
+			if tc.sort != nil {
+				raw["sort"] = tc.sort
+			}
+
+			r := DataSourceDigitalOceanDatabaseClusterBackups()
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if count := d.Get("backups.#").(int); count != tc.expectedCount {
+				t.Fatalf("expected %d backups, got %d", tc.expectedCount, count)
+			}
+			if latest := d.Get("latest_backup_created_at").(string); latest != tc.expectedLatest {
+				t.Errorf("expected latest_backup_created_at %q, got %q", tc.expectedLatest, latest)
+			}
+			if tc.expectedCount == 0 {
+				return
+			}
+			if first := d.Get("backups.0.created_at").(string); first != tc.expectedFirst {
+				t.Errorf("expected the first backup to be created at %s, got %s", tc.expectedFirst, first)
+			}
+			if size := d.Get("backups.0.size_gigabytes").(float64); size != tc.expectedSizeGiB {
+				t.Errorf("expected the first backup to be %v GiB, got %v", tc.expectedSizeGiB, size)
+			}
+		})
+	}
+}
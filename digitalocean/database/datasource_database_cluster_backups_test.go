@@ -0,0 +1,55 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseClusterBackups_Basic(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
+	datasourceConfig := `
+data "digitalocean_database_cluster_backups" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					func(s *terraform.State) error {
+						return waitForDatabaseBackups(databaseName)
+					},
+				),
+			},
+			{
+				Config: databaseConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_cluster_backups.foobar", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_cluster_backups.foobar", "backups.0.size_gigabytes"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_database_cluster_backups.foobar", "latest_backup_created_at",
+						"data.digitalocean_database_cluster_backups.foobar", "backups.0.created_at"),
+				),
+			},
+		},
+	})
+}
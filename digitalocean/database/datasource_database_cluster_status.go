@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultDatabaseClusterStatusTimeout = 30 * time.Minute
+
+func DataSourceDigitalOceanDatabaseClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDatabaseClusterStatusRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of the database cluster",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"cluster_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the database cluster",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"cluster_id", "name"},
+			},
+			"wait_for_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The status to wait for the database cluster to reach, such as online",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultDatabaseClusterStatusTimeout.String(),
+				Description:  "How long to wait for the database cluster to reach wait_for_status",
+				ValidateFunc: validateDatabaseFirewallDuration,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the database cluster",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the database cluster was created",
+			},
+			"checked_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the status of the database cluster was last read",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDatabaseClusterStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	id := d.Get("cluster_id").(string)
+	if id == "" {
+		var err error
+		id, err = findDatabaseClusterIDByName(ctx, client, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var cluster *godo.Database
+	refresh := func(ctx context.Context) (string, error) {
+		db, _, err := client.Databases.Get(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving database cluster (%s): %s", id, err)
+		}
+		cluster = db
+		return db.Status, nil
+	}
+
+	// The data source only blocks when a status to wait for is configured.
+	if target, ok := d.GetOk("wait_for_status"); ok {
+		timeout, err := time.ParseDuration(d.Get("timeout").(string))
+		if err != nil {
+			return diag.Errorf("Invalid timeout: %s", err)
+		}
+
+		if _, err := util.WaitForStatus(ctx, refresh, target.(string), nil, timeout); err != nil {
+			return diag.Errorf("Error waiting for database cluster (%s) to be %s: %s", id, target, err)
+		}
+	} else if _, err := refresh(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("cluster_id", cluster.ID)
+	d.Set("name", cluster.Name)
+	d.Set("status", cluster.Status)
+	d.Set("created_at", cluster.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("checked_at", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func findDatabaseClusterIDByName(ctx context.Context, client *godo.Client, name string) (string, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		databases, resp, err := client.Databases.List(ctx, opts)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving DatabaseClusters: %s", err)
+		}
+
+		for _, db := range databases {
+			if db.Name == name {
+				return db.ID, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving DatabaseClusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return "", fmt.Errorf("database cluster %s not found", name)
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanDatabaseClusterStatusRead(t *testing.T) {
+	cases := []struct {
+		name           string
+		raw            map[string]interface{}
+		status         string
+		expectedGets   int
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:           "NoWait",
+			raw:            map[string]interface{}{"cluster_id": "c1"},
+			status:         "creating",
+			expectedGets:   1,
+			expectedStatus: "creating",
+		},
+		{
+			name:           "ByNameReached",
+			raw:            map[string]interface{}{"name": "example", "wait_for_status": "online"},
+			status:         "online",
+			expectedGets:   1,
+			expectedStatus: "online",
+		},
+		{
+			// The cluster never converges, so the read fails once the
+			// timeout expires.
+			name:          "Timeout",
+			raw:           map[string]interface{}{"cluster_id": "c1", "wait_for_status": "online", "timeout": "200ms"},
+			status:        "creating",
+			expectedGets:  1,
+			expectedError: `timeout after 200ms waiting for status "online", last status was "creating"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := fmt.Sprintf(`{"id":"c1","name":"example","status":%q,"created_at":"2024-06-01T10:00:00Z"}`, tc.status)
+
+			gets := 0
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/v2/databases":
+					fmt.Fprintf(w, `{"databases":[{"id":"c2","name":"other"},%s],"links":{}}`, cluster)
+				case "/v2/databases/c1":
+					gets++
+					fmt.Fprintf(w, `{"database":%s}`, cluster)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			r := DataSourceDigitalOceanDatabaseClusterStatus()
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.raw)
+
+			diags := r.ReadContext(context.Background(), d, meta)
+			if gets != tc.expectedGets {
+				t.Errorf("expected %d requests for the cluster, got %d", tc.expectedGets, gets)
+			}
+			if tc.expectedError != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.expectedError) {
+					t.Fatalf("expected error %q, got: %#v", tc.expectedError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if d.Id() != "c1" || d.Get("cluster_id").(string) != "c1" || d.Get("name").(string) != "example" {
+				t.Errorf("expected cluster c1 named example, got ID %q, cluster_id %q and name %q", d.Id(), d.Get("cluster_id"), d.Get("name"))
+			}
+			if v := d.Get("status").(string); v != tc.expectedStatus {
+				t.Errorf("expected status %q, got %q", tc.expectedStatus, v)
+			}
+			if v := d.Get("created_at").(string); v != "2024-06-01T10:00:00Z" {
+				t.Errorf("expected created_at 2024-06-01T10:00:00Z, got %q", v)
+			}
+			if d.Get("checked_at").(string) == "" {
+				t.Error("expected checked_at to be set")
+			}
+		})
+	}
+}
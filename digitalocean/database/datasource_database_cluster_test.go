@@ -15,16 +15,17 @@ import (
 func TestAccDataSourceDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanDatabaseClusterConfigWithDatasource, databaseName),
+				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanDatabaseClusterConfigWithDatasource, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceDigitalOceanDatabaseClusterExists("data.digitalocean_database_cluster.foobar", &database),
 					resource.TestCheckResourceAttr(
@@ -92,7 +93,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine           = "pg"
   version          = "15"
   size             = "db-s-1vcpu-1gb"
-  region           = "nyc1"
+  region           = "%s"
   node_count       = 1
   tags             = ["production"]
   storage_size_mib = 10240
@@ -105,7 +106,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine           = "pg"
   version          = "15"
   size             = "db-s-1vcpu-1gb"
-  region           = "nyc1"
+  region           = "%s"
   node_count       = 1
   tags             = ["production"]
   storage_size_mib = 10240
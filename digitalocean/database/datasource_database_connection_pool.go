@@ -61,6 +61,11 @@ func DataSourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"private_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"uri": {
 				Type:      schema.TypeString,
 				Computed:  true,
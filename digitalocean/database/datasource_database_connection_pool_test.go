@@ -11,11 +11,12 @@ import (
 
 func TestAccDataSourceDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 	var pool godo.DatabasePool
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	databaseName := acceptance.RandomTestName()
 	poolName := acceptance.RandomTestName()
 
-	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, poolName)
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, region, poolName)
 	datasourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatasourceDatabaseConnectionPoolConfigBasic, poolName)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -55,6 +56,20 @@ func TestAccDataSourceDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 						"data.digitalocean_database_connection_pool.pool-01", "db_name"),
 					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "user",
 						"data.digitalocean_database_connection_pool.pool-01", "user"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "host",
+						"data.digitalocean_database_connection_pool.pool-01", "host"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "private_host",
+						"data.digitalocean_database_connection_pool.pool-01", "private_host"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "port",
+						"data.digitalocean_database_connection_pool.pool-01", "port"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "private_port",
+						"data.digitalocean_database_connection_pool.pool-01", "private_port"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "uri",
+						"data.digitalocean_database_connection_pool.pool-01", "uri"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "private_uri",
+						"data.digitalocean_database_connection_pool.pool-01", "private_uri"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "password",
+						"data.digitalocean_database_connection_pool.pool-01", "password"),
 				),
 			},
 		},
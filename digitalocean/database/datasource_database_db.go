@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseDB() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDatabaseDBRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDatabaseDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	db, resp, err := client.Databases.GetDB(context.Background(), clusterID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return diag.Errorf("Database %s not found in cluster %s: %s", name, clusterID, err)
+		}
+		return diag.Errorf("Error retrieving database: %s", err)
+	}
+
+	d.SetId(makeDatabaseDBID(clusterID, db.Name))
+	d.Set("name", db.Name)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseDB_Basic(t *testing.T) {
+	var databaseDB godo.DatabaseDB
+	databaseClusterName := acceptance.RandomTestName()
+	databaseDBName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, region, databaseDBName)
+	datasourceConfig := `
+data "digitalocean_database_db" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = digitalocean_database_db.foobar_db.name
+}
+
+data "digitalocean_database_dbs" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+  depends_on = [digitalocean_database_db.foobar_db]
+}
+
+data "digitalocean_database_dbs" "filtered" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  filter {
+    key    = "name"
+    values = [digitalocean_database_db.foobar_db.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseDBExists("digitalocean_database_db.foobar_db", &databaseDB),
+				),
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("digitalocean_database_db.foobar_db", "id",
+						"data.digitalocean_database_db.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_db.foobar", "name", databaseDBName),
+					// The cluster's default database is listed alongside the one created by the test.
+					resource.TestCheckResourceAttr("data.digitalocean_database_dbs.foobar", "databases.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_database_dbs.foobar", "databases.*", map[string]string{
+						"name": "defaultdb",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_database_dbs.foobar", "databases.*", map[string]string{
+						"name": databaseDBName,
+					}),
+					resource.TestCheckResourceAttr("data.digitalocean_database_dbs.filtered", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_dbs.filtered", "databases.0.name", databaseDBName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDatabaseDB_NotFound(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	databaseDBName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, region, databaseDBName)
+	datasourceConfig := `
+data "digitalocean_database_db" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "does-not-exist"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config:      resourceConfig + datasourceConfig,
+				ExpectError: regexp.MustCompile("Database does-not-exist not found"),
+			},
+		},
+	})
+}
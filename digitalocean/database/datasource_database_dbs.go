@@ -0,0 +1,25 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseDBs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseDBSchema(),
+		ResultAttributeName: "databases",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		GetRecords:    getDigitalOceanDatabaseDBs,
+		FlattenRecord: flattenDigitalOceanDatabaseDB,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
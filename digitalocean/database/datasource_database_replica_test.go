@@ -14,12 +14,13 @@ import (
 func TestAccDataSourceDigitalOceanDatabaseReplica_Basic(t *testing.T) {
 	var databaseReplica godo.DatabaseReplica
 	var database godo.Database
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	databaseName := acceptance.RandomTestName()
 	databaseReplicaName := acceptance.RandomTestName()
 
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
-	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName, region)
 	datasourceReplicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatasourceDatabaseReplicaConfigBasic, databaseReplicaName)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +57,7 @@ func TestAccDataSourceDigitalOceanDatabaseReplica_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("digitalocean_database_replica.read-01", "uuid",
 						"data.digitalocean_database_replica.my_db_replica", "uuid"),
 					resource.TestCheckResourceAttr(
-						"data.digitalocean_database_replica.my_db_replica", "region", "nyc3"),
+						"data.digitalocean_database_replica.my_db_replica", "region", region),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_database_replica.my_db_replica", "name", databaseReplicaName),
 					resource.TestCheckResourceAttrSet(
@@ -56,6 +56,11 @@ func DataSourceDigitalOceanDatabaseUser() *schema.Resource {
 							Optional: true,
 							Elem:     userACLSchema(),
 						},
+						"schema_registry_acl": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     userSchemaRegistryACLSchema(),
+						},
 					},
 				},
 			},
@@ -69,7 +74,7 @@ func dataSourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resou
 	clusterID := d.Get("cluster_id").(string)
 	name := d.Get("name").(string)
 
-	user, resp, err := client.Databases.GetUser(context.Background(), clusterID, name)
+	user, resp, err := getDatabaseUser(client, clusterID, name)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return diag.Errorf("Database user not found: %s", err)
@@ -11,11 +11,12 @@ import (
 
 func TestAccDataSourceDigitalOceanDatabaseUser_Basic(t *testing.T) {
 	var user godo.DatabaseUser
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	databaseName := acceptance.RandomTestName()
 	userName := acceptance.RandomTestName()
 
-	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseName, userName)
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseName, region, userName)
 	datasourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatasourceDatabaseUserConfigBasic, userName)
 
 	resource.ParallelTest(t, resource.TestCase{
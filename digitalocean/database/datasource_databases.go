@@ -0,0 +1,17 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanDatabases() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseSchema(),
+		ResultAttributeName: "databases",
+		GetRecords:          getDigitalOceanDatabases,
+		FlattenRecord:       flattenDigitalOceanDatabase,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,76 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabases_Basic(t *testing.T) {
+	name1 := acceptance.RandomTestName("01")
+	name2 := acceptance.RandomTestName("02")
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_database_cluster" "foo" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+  tags       = ["production"]
+}
+
+resource "digitalocean_database_cluster" "bar" {
+  name       = "%s"
+  engine     = "redis"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+`, name1, name2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_databases" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+
+  filter {
+    key    = "engine"
+    values = ["pg"]
+  }
+}
+`, name1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.0.name", name1),
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.0.engine", "pg"),
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.0.region", "nyc1"),
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.0.num_nodes", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_databases.result", "databases.0.tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_databases.result", "databases.0.id", "digitalocean_database_cluster.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_databases.result", "databases.0.private_network_uuid", "digitalocean_database_cluster.foo", "private_network_uuid"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_databases.result", "databases.0.project_id", "digitalocean_database_cluster.foo", "project_id"),
+					resource.TestCheckNoResourceAttr("data.digitalocean_databases.result", "databases.0.uri"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,372 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The users and databases blocks of a database cluster manage its users and
+// databases along with the cluster, instead of through a
+// digitalocean_database_user or digitalocean_database_db resource each. They
+// are created once the cluster is online and reconciled by name on update.
+// Only the users and databases declared in the blocks are managed, so the
+// default ones of the cluster and those of the standalone resources are left
+// alone, but a user or database must not be declared both ways.
+
+func embeddedUserSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"mysql_auth_plugin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					godo.SQLAuthPluginNative,
+					godo.SQLAuthPluginCachingSHA2,
+				}, false),
+			},
+		},
+	}
+}
+
+func embeddedDatabaseSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+// expandEmbeddedNames returns the blocks of a users or databases set keyed by
+// name.
+func expandEmbeddedNames(set *schema.Set) map[string]map[string]interface{} {
+	blocks := map[string]map[string]interface{}{}
+	for _, raw := range set.List() {
+		block := raw.(map[string]interface{})
+		blocks[block["name"].(string)] = block
+	}
+	return blocks
+}
+
+func sortedEmbeddedNames(blocks map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(blocks))
+	for name := range blocks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// customizeDiffEmbeddedUsersAndDatabases validates the users and databases
+// blocks, and marks the passwords as changing along with the users.
+func customizeDiffEmbeddedUsersAndDatabases() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		validateEmbeddedNamesUnique("users"),
+		validateEmbeddedNamesUnique("databases"),
+		customdiff.ComputedIf("user_passwords", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("users")
+		}),
+	)
+}
+
+// validateEmbeddedNamesUnique rejects users or databases declared twice with
+// different arguments, which the set does not deduplicate.
+func validateEmbeddedNamesUnique(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		seen := map[string]bool{}
+		for _, raw := range diff.Get(key).(*schema.Set).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if name == "" {
+				continue
+			}
+			if seen[name] {
+				return fmt.Errorf("%q is declared more than once in %s", name, key)
+			}
+			seen[name] = true
+		}
+		return nil
+	}
+}
+
+// applyEmbeddedDatabases creates the databases added to the databases block
+// and deletes those removed from it.
+func applyEmbeddedDatabases(ctx context.Context, client *godo.Client, d *schema.ResourceData, timeout time.Duration) error {
+	clusterID := d.Id()
+	o, n := d.GetChange("databases")
+	oldDBs := expandEmbeddedNames(o.(*schema.Set))
+	newDBs := expandEmbeddedNames(n.(*schema.Set))
+
+	for _, name := range sortedEmbeddedNames(oldDBs) {
+		if _, ok := newDBs[name]; ok {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting database %s of database cluster: %s", name, clusterID)
+		err := retryOnDatabaseMaintenance(ctx, timeout, clusterID, func() (*godo.Response, error) {
+			resp, err := client.Databases.DeleteDB(ctx, clusterID, name)
+			if resp != nil && resp.StatusCode == 404 {
+				return resp, nil
+			}
+			return resp, err
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting database %s: %s", name, err)
+		}
+	}
+
+	for _, name := range sortedEmbeddedNames(newDBs) {
+		if _, ok := oldDBs[name]; ok {
+			continue
+		}
+
+		_, resp, err := client.Databases.GetDB(ctx, clusterID, name)
+		if err == nil {
+			return fmt.Errorf("database %s already exists in database cluster %s, it can not be declared in the databases block if it is the default database or is managed by a digitalocean_database_db resource", name, clusterID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error retrieving database %s: %s", name, err)
+		}
+
+		log.Printf("[INFO] Creating database %s of database cluster: %s", name, clusterID)
+		err = retryOnDatabaseMaintenance(ctx, timeout, clusterID, func() (*godo.Response, error) {
+			_, resp, err := client.Databases.CreateDB(ctx, clusterID, &godo.DatabaseCreateDBRequest{Name: name})
+			return resp, err
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating database %s: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// applyEmbeddedUsers creates the users added to the users block, deletes those
+// removed from it, and resets the authentication of those whose
+// mysql_auth_plugin changed. The passwords of the users are recorded in
+// user_passwords as changes are made, including when one of them fails.
+func applyEmbeddedUsers(ctx context.Context, client *godo.Client, d *schema.ResourceData, timeout time.Duration) error {
+	clusterID := d.Id()
+	o, n := d.GetChange("users")
+	oldUsers := expandEmbeddedNames(o.(*schema.Set))
+	newUsers := expandEmbeddedNames(n.(*schema.Set))
+
+	passwords := map[string]interface{}{}
+	for name, password := range d.Get("user_passwords").(map[string]interface{}) {
+		passwords[name] = password
+	}
+	defer func() { d.Set("user_passwords", passwords) }()
+
+	// Users of a cluster are not changed in parallel, as for the
+	// digitalocean_database_user resource.
+	key := fmt.Sprintf("digitalocean_database_cluster/%s/users", clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	for _, name := range sortedEmbeddedNames(oldUsers) {
+		if _, ok := newUsers[name]; ok {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting user %s of database cluster: %s", name, clusterID)
+		err := retryOnDatabaseMaintenance(ctx, timeout, clusterID, func() (*godo.Response, error) {
+			resp, err := client.Databases.DeleteUser(ctx, clusterID, name)
+			if resp != nil && resp.StatusCode == 404 {
+				return resp, nil
+			}
+			return resp, err
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting user %s: %s", name, err)
+		}
+		delete(passwords, name)
+	}
+
+	for _, name := range sortedEmbeddedNames(newUsers) {
+		plugin := newUsers[name]["mysql_auth_plugin"].(string)
+
+		if old, ok := oldUsers[name]; ok {
+			if old["mysql_auth_plugin"].(string) == plugin {
+				continue
+			}
+
+			authReq := &godo.DatabaseResetUserAuthRequest{
+				MySQLSettings: &godo.DatabaseMySQLUserSettings{AuthPlugin: plugin},
+			}
+			if plugin == "" {
+				authReq.MySQLSettings.AuthPlugin = godo.SQLAuthPluginCachingSHA2
+			}
+
+			var user *godo.DatabaseUser
+			err := retryOnDatabaseMaintenance(ctx, timeout, clusterID, func() (resp *godo.Response, err error) {
+				user, resp, err = client.Databases.ResetUserAuth(ctx, clusterID, name, authReq)
+				return resp, err
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating mysql_auth_plugin of user %s: %s", name, err)
+			}
+			if user.Password != "" {
+				passwords[name] = user.Password
+			}
+			continue
+		}
+
+		_, resp, err := client.Databases.GetUser(ctx, clusterID, name)
+		if err == nil {
+			return fmt.Errorf("user %s already exists in database cluster %s, it can not be declared in the users block if it is the default user or is managed by a digitalocean_database_user resource", name, clusterID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error retrieving user %s: %s", name, err)
+		}
+
+		opts := &databaseCreateUserRequest{Name: name}
+		if plugin != "" {
+			opts.MySQLSettings = &godo.DatabaseMySQLUserSettings{AuthPlugin: plugin}
+		}
+
+		log.Printf("[INFO] Creating user %s of database cluster: %s", name, clusterID)
+		var user *databaseUser
+		err = retryOnDatabaseMaintenance(ctx, timeout, clusterID, func() (resp *godo.Response, err error) {
+			user, resp, err = createDatabaseUser(client, clusterID, opts)
+			return resp, err
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating user %s: %s", name, err)
+		}
+		passwords[name] = user.Password
+	}
+
+	return nil
+}
+
+// readEmbeddedUsersAndDatabases drops the users and databases which no longer
+// exist from the blocks, so that they are created again, and refreshes the
+// passwords of the users.
+func readEmbeddedUsersAndDatabases(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
+	if users := d.Get("users").(*schema.Set); users.Len() > 0 {
+		existing, err := listDatabaseUsers(ctx, client, d.Id())
+		if err != nil {
+			return err
+		}
+
+		kept := []interface{}{}
+		passwords := map[string]interface{}{}
+		for _, raw := range users.List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			user, ok := existing[name]
+			if !ok {
+				log.Printf("[WARN] User %s of database cluster (%s) not found", name, d.Id())
+				continue
+			}
+			kept = append(kept, raw)
+
+			// The password is not returned for all engines, e.g. MongoDB.
+			password := user.Password
+			if password == "" {
+				password, _ = d.Get("user_passwords").(map[string]interface{})[name].(string)
+			}
+			passwords[name] = password
+		}
+
+		if err := d.Set("users", kept); err != nil {
+			return err
+		}
+		if err := d.Set("user_passwords", passwords); err != nil {
+			return err
+		}
+	}
+
+	if dbs := d.Get("databases").(*schema.Set); dbs.Len() > 0 {
+		existing, err := listDatabaseDBNames(ctx, client, d.Id())
+		if err != nil {
+			return err
+		}
+
+		kept := []interface{}{}
+		for _, raw := range dbs.List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if !existing[name] {
+				log.Printf("[WARN] Database %s of database cluster (%s) not found", name, d.Id())
+				continue
+			}
+			kept = append(kept, raw)
+		}
+
+		if err := d.Set("databases", kept); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listDatabaseUsers(ctx context.Context, client *godo.Client, clusterID string) (map[string]godo.DatabaseUser, error) {
+	users := map[string]godo.DatabaseUser{}
+	opts := &godo.ListOptions{Page: 1, PerPage: 200}
+	for {
+		list, resp, err := client.Databases.ListUsers(ctx, clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving users for cluster %s: %s", clusterID, err)
+		}
+
+		for _, user := range list {
+			users[user.Name] = user
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving users for cluster %s: %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return users, nil
+}
+
+func listDatabaseDBNames(ctx context.Context, client *godo.Client, clusterID string) (map[string]bool, error) {
+	names := map[string]bool{}
+	opts := &godo.ListOptions{Page: 1, PerPage: 200}
+	for {
+		list, resp, err := client.Databases.ListDBs(ctx, clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases for cluster %s: %s", clusterID, err)
+		}
+
+		for _, db := range list {
+			names[db.Name] = true
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases for cluster %s: %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return names, nil
+}
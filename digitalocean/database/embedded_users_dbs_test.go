@@ -0,0 +1,275 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeUsersDBsAPI serves the user and database endpoints of cluster c1,
+// recording the changes it receives.
+type fakeUsersDBsAPI struct {
+	mu       sync.Mutex
+	users    map[string]bool
+	dbs      map[string]bool
+	requests []string
+}
+
+func (f *fakeUsersDBsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method != http.MethodGet {
+		f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	}
+
+	user := strings.TrimPrefix(r.URL.Path, "/v2/databases/c1/users/")
+	db := strings.TrimPrefix(r.URL.Path, "/v2/databases/c1/dbs/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/databases/c1/users":
+		users := []godo.DatabaseUser{}
+		for name := range f.users {
+			users = append(users, godo.DatabaseUser{Name: name, Password: "listed-" + name})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"users": users})
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/databases/c1/users":
+		var req databaseCreateUserRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.users[req.Name] = true
+		json.NewEncoder(w).Encode(map[string]interface{}{"user": godo.DatabaseUser{Name: req.Name, Password: "created-" + req.Name}})
+	case r.Method == http.MethodPost && strings.HasSuffix(user, "/reset_auth"):
+		name := strings.TrimSuffix(user, "/reset_auth")
+		json.NewEncoder(w).Encode(map[string]interface{}{"user": godo.DatabaseUser{Name: name, Password: "reset-" + name}})
+	case r.Method == http.MethodGet && f.users[user]:
+		json.NewEncoder(w).Encode(map[string]interface{}{"user": godo.DatabaseUser{Name: user}})
+	case r.Method == http.MethodDelete && f.users[user]:
+		delete(f.users, user)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/databases/c1/dbs":
+		dbs := []godo.DatabaseDB{}
+		for name := range f.dbs {
+			dbs = append(dbs, godo.DatabaseDB{Name: name})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"dbs": dbs})
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/databases/c1/dbs":
+		var req godo.DatabaseCreateDBRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.dbs[req.Name] = true
+		json.NewEncoder(w).Encode(map[string]interface{}{"db": godo.DatabaseDB{Name: req.Name}})
+	case r.Method == http.MethodGet && f.dbs[db]:
+		json.NewEncoder(w).Encode(map[string]interface{}{"db": godo.DatabaseDB{Name: db}})
+	case r.Method == http.MethodDelete && f.dbs[db]:
+		delete(f.dbs, db)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"id":"not_found","message":"not found"}`)
+	}
+}
+
+// embeddedUsersDBsTestResource is a resource with only the users and
+// databases blocks of a cluster, applying them like the cluster does.
+func embeddedUsersDBsTestResource() *schema.Resource {
+	cluster := ResourceDigitalOceanDatabaseCluster()
+	apply := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*config.CombinedConfig).GodoClient()
+		if err := applyEmbeddedDatabases(ctx, client, d, time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyEmbeddedUsers(ctx, client, d, time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"users":          cluster.Schema["users"],
+			"databases":      cluster.Schema["databases"],
+			"user_passwords": cluster.Schema["user_passwords"],
+		},
+		CustomizeDiff: customizeDiffEmbeddedUsersAndDatabases(),
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("c1")
+			return apply(ctx, d, meta)
+		},
+		UpdateContext: apply,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+}
+
+func TestApplyEmbeddedUsersAndDatabases(t *testing.T) {
+	api := &fakeUsersDBsAPI{
+		users: map[string]bool{"doadmin": true},
+		dbs:   map[string]bool{"defaultdb": true},
+	}
+	meta := newTestMeta(t, api)
+
+	r := embeddedUsersDBsTestResource()
+	steps := []struct {
+		config            map[string]interface{}
+		expectedRequests  []string
+		expectedPasswords map[string]string
+	}{
+		{
+			config: map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "alice"},
+					map[string]interface{}{"name": "bob"},
+				},
+				"databases": []interface{}{
+					map[string]interface{}{"name": "orders"},
+				},
+			},
+			expectedRequests: []string{
+				"POST /v2/databases/c1/dbs",
+				"POST /v2/databases/c1/users",
+				"POST /v2/databases/c1/users",
+			},
+			expectedPasswords: map[string]string{
+				"user_passwords.%":     "2",
+				"user_passwords.alice": "created-alice",
+				"user_passwords.bob":   "created-bob",
+			},
+		},
+		{
+			config: map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "alice", "mysql_auth_plugin": godo.SQLAuthPluginNative},
+					map[string]interface{}{"name": "carol"},
+				},
+				"databases": []interface{}{
+					map[string]interface{}{"name": "invoices"},
+				},
+			},
+			expectedRequests: []string{
+				"DELETE /v2/databases/c1/dbs/orders",
+				"POST /v2/databases/c1/dbs",
+				"DELETE /v2/databases/c1/users/bob",
+				"POST /v2/databases/c1/users/alice/reset_auth",
+				"POST /v2/databases/c1/users",
+			},
+			expectedPasswords: map[string]string{
+				"user_passwords.%":     "2",
+				"user_passwords.alice": "reset-alice",
+				"user_passwords.carol": "created-carol",
+			},
+		},
+	}
+
+	var state *terraform.InstanceState
+	for i, step := range steps {
+		api.requests = nil
+
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(step.config), meta)
+		if err != nil {
+			t.Fatalf("step %d: unexpected error: %s", i, err)
+		}
+
+		var diags diag.Diagnostics
+		state, diags = r.Apply(context.Background(), state, diff, meta)
+		if diags.HasError() {
+			t.Fatalf("step %d: unexpected error: %#v", i, diags)
+		}
+
+		if !reflect.DeepEqual(api.requests, step.expectedRequests) {
+			t.Errorf("step %d: expected requests %v, got %v", i, step.expectedRequests, api.requests)
+		}
+		for k, v := range step.expectedPasswords {
+			if state.Attributes[k] != v {
+				t.Errorf("step %d: expected %s to be %q, got %q", i, k, v, state.Attributes[k])
+			}
+		}
+	}
+}
+
+func TestApplyEmbeddedUsersAlreadyExists(t *testing.T) {
+	api := &fakeUsersDBsAPI{users: map[string]bool{"doadmin": true}, dbs: map[string]bool{}}
+	meta := newTestMeta(t, api)
+
+	d := schema.TestResourceDataRaw(t, embeddedUsersDBsTestResource().Schema, map[string]interface{}{
+		"users": []interface{}{map[string]interface{}{"name": "doadmin"}},
+	})
+	d.SetId("c1")
+
+	err := applyEmbeddedUsers(context.Background(), meta.GodoClient(), d, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "user doadmin already exists") {
+		t.Fatalf("expected an error about the existing user, got: %v", err)
+	}
+	if len(api.requests) != 0 {
+		t.Errorf("expected no changes, got requests: %v", api.requests)
+	}
+}
+
+func TestValidateEmbeddedNamesUnique(t *testing.T) {
+	r := embeddedUsersDBsTestResource()
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"name": "alice"},
+			map[string]interface{}{"name": "alice", "mysql_auth_plugin": godo.SQLAuthPluginNative},
+		},
+	})
+
+	_, err := r.Diff(context.Background(), nil, cfg, nil)
+	if err == nil || !strings.Contains(err.Error(), `"alice" is declared more than once in users`) {
+		t.Fatalf("expected a duplicate error, got: %v", err)
+	}
+}
+
+func TestReadEmbeddedUsersAndDatabases(t *testing.T) {
+	// bob and invoices were deleted outside of Terraform.
+	api := &fakeUsersDBsAPI{
+		users: map[string]bool{"doadmin": true, "alice": true},
+		dbs:   map[string]bool{"defaultdb": true, "orders": true},
+	}
+	meta := newTestMeta(t, api)
+
+	d := schema.TestResourceDataRaw(t, embeddedUsersDBsTestResource().Schema, map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"name": "alice"},
+			map[string]interface{}{"name": "bob"},
+		},
+		"databases": []interface{}{
+			map[string]interface{}{"name": "orders"},
+			map[string]interface{}{"name": "invoices"},
+		},
+	})
+	d.SetId("c1")
+
+	if err := readEmbeddedUsersAndDatabases(context.Background(), meta.GodoClient(), d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	users := expandEmbeddedNames(d.Get("users").(*schema.Set))
+	if !reflect.DeepEqual(sortedEmbeddedNames(users), []string{"alice"}) {
+		t.Errorf("expected only alice to be kept, got %v", sortedEmbeddedNames(users))
+	}
+	dbs := expandEmbeddedNames(d.Get("databases").(*schema.Set))
+	if !reflect.DeepEqual(sortedEmbeddedNames(dbs), []string{"orders"}) {
+		t.Errorf("expected only orders to be kept, got %v", sortedEmbeddedNames(dbs))
+	}
+	expectedPasswords := map[string]interface{}{"alice": "listed-alice"}
+	if passwords := d.Get("user_passwords").(map[string]interface{}); !reflect.DeepEqual(passwords, expectedPasswords) {
+		t.Errorf("expected passwords %v, got %v", expectedPasswords, passwords)
+	}
+}
+
+// fakeDatabaseFirewallDialer fails to connect until it has been called
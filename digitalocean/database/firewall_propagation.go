@@ -0,0 +1,193 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// databaseFirewallPollInterval is the interval at which the firewall rules
+// and the connection to the cluster are checked while waiting for changes to
+// the rules to take effect.
+var databaseFirewallPollInterval = 5 * time.Second
+
+// databaseFirewallDialer opens connections to the cluster. It is satisfied by
+// net.Dialer.
+type databaseFirewallDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// databaseFirewallRuleKeys returns the type and value of each rule, sorted, so
+// that rule sets can be compared regardless of their order and UUIDs.
+func databaseFirewallRuleKeys(rules []godo.DatabaseFirewallRule) []string {
+	keys := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		keys = append(keys, rule.Type+"/"+rule.Value)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func databaseFirewallRulesMatch(desired, current []godo.DatabaseFirewallRule) bool {
+	desiredKeys := databaseFirewallRuleKeys(desired)
+	currentKeys := databaseFirewallRuleKeys(current)
+	if len(desiredKeys) != len(currentKeys) {
+		return false
+	}
+
+	for i := range desiredKeys {
+		if desiredKeys[i] != currentKeys[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// waitForDatabaseFirewallRules polls the firewall rules of the cluster until
+// they match the desired rules, then waits for the settle period, as the
+// rules are not enforced as soon as the API returns them.
+func waitForDatabaseFirewallRules(ctx context.Context, client *godo.Client, clusterID string, desired []godo.DatabaseFirewallRule, settlePeriod, pollInterval time.Duration) error {
+	for {
+		rules, _, err := client.Databases.GetFirewallRules(ctx, clusterID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving DatabaseFirewall: %s", err)
+		}
+
+		if databaseFirewallRulesMatch(desired, rules) {
+			break
+		}
+
+		log.Printf("[DEBUG] Firewall rules of database cluster (%s) have not been updated yet", clusterID)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for the firewall rules of database cluster (%s) to be updated", clusterID)
+		case <-time.After(pollInterval):
+		}
+	}
+
+	log.Printf("[INFO] Waiting %s for the firewall rules of database cluster (%s) to take effect", settlePeriod, clusterID)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("timeout waiting for the firewall rules of database cluster (%s) to take effect", clusterID)
+	case <-time.After(settlePeriod):
+	}
+
+	return nil
+}
+
+// waitForDatabaseConnection attempts to open a TCP connection to the address
+// until it succeeds or the timeout is reached.
+func waitForDatabaseConnection(ctx context.Context, dialer databaseFirewallDialer, address string, timeout, pollInterval time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+
+		log.Printf("[DEBUG] Unable to connect to database cluster at %s: %s", address, err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout after %s waiting to connect to database cluster at %s (last error: %s)", timeout, address, err)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// databaseConnectionAddress returns the address of the public or private
+// connection of the cluster.
+func databaseConnectionAddress(cluster *godo.Database, private bool) (string, error) {
+	connection := cluster.Connection
+	if private {
+		connection = cluster.PrivateConnection
+	}
+
+	if connection == nil || connection.Host == "" {
+		return "", fmt.Errorf("database cluster (%s) does not have a connection to verify", cluster.ID)
+	}
+
+	return net.JoinHostPort(connection.Host, strconv.Itoa(connection.Port)), nil
+}
+
+// waitForDatabaseFirewall waits for the rules to take effect, if
+// wait_for_propagation is set, and then for the cluster to accept
+// connections, if verify_connection is set.
+func waitForDatabaseFirewall(ctx context.Context, d *schema.ResourceData, client *godo.Client, dialer databaseFirewallDialer, rules []*godo.DatabaseFirewallRule, timeout time.Duration) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if d.Get("wait_for_propagation").(bool) {
+		settlePeriod, err := time.ParseDuration(d.Get("propagation_settle_period").(string))
+		if err != nil {
+			return fmt.Errorf("invalid propagation_settle_period: %s", err)
+		}
+
+		desired := make([]godo.DatabaseFirewallRule, 0, len(rules))
+		for _, rule := range rules {
+			desired = append(desired, *rule)
+		}
+
+		if err := waitForDatabaseFirewallRules(ctx, client, clusterID, desired, settlePeriod, databaseFirewallPollInterval); err != nil {
+			return err
+		}
+	}
+
+	verify := d.Get("verify_connection").([]interface{})
+	if len(verify) == 0 || verify[0] == nil {
+		return nil
+	}
+	verifyConfig := verify[0].(map[string]interface{})
+
+	connectTimeout, err := time.ParseDuration(verifyConfig["timeout"].(string))
+	if err != nil {
+		return fmt.Errorf("invalid verify_connection timeout: %s", err)
+	}
+
+	cluster, _, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DatabaseCluster: %s", err)
+	}
+
+	address, err := databaseConnectionAddress(cluster, verifyConfig["private"].(bool))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Verifying the connection to database cluster (%s) at %s", clusterID, address)
+	return waitForDatabaseConnection(ctx, dialer, address, connectTimeout, databaseFirewallPollInterval)
+}
+
+func validateDatabaseFirewallDuration(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as \"30s\" or \"2m\", got %q", k, value))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative, got %q", k, value))
+	}
+	return
+}
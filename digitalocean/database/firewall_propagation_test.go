@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// failures times.
+type fakeDatabaseFirewallDialer struct {
+	failures  int
+	calls     int
+	addresses []string
+}
+
+func (f *fakeDatabaseFirewallDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	f.calls++
+	f.addresses = append(f.addresses, address)
+	if f.calls <= f.failures {
+		return nil, fmt.Errorf("connection refused")
+	}
+
+	client, server := net.Pipe()
+	server.Close()
+	return client, nil
+}
+
+func TestDatabaseFirewallRulesMatch(t *testing.T) {
+	desired := []godo.DatabaseFirewallRule{
+		{Type: "ip_addr", Value: "192.0.2.1"},
+		{Type: "tag", Value: "web"},
+	}
+
+	cases := []struct {
+		name     string
+		current  []godo.DatabaseFirewallRule
+		expected bool
+	}{
+		{"Reordered", []godo.DatabaseFirewallRule{{UUID: "b", Type: "tag", Value: "web"}, {UUID: "a", Type: "ip_addr", Value: "192.0.2.1"}}, true},
+		{"Missing", []godo.DatabaseFirewallRule{{Type: "tag", Value: "web"}}, false},
+		{"Stale", []godo.DatabaseFirewallRule{{Type: "tag", Value: "web"}, {Type: "ip_addr", Value: "192.0.2.2"}}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if match := databaseFirewallRulesMatch(desired, c.current); match != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, match)
+			}
+		})
+	}
+}
+
+func TestWaitForDatabaseFirewall(t *testing.T) {
+	defer func(interval time.Duration) { databaseFirewallPollInterval = interval }(databaseFirewallPollInterval)
+	databaseFirewallPollInterval = 10 * time.Millisecond
+
+	// The new rule is only returned from the third request.
+	var firewallRequests int
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/databases/c1/firewall":
+			firewallRequests++
+			if firewallRequests < 3 {
+				fmt.Fprint(w, `{"rules":[{"uuid":"a","cluster_uuid":"c1","type":"ip_addr","value":"192.0.2.1"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"rules":[{"uuid":"a","cluster_uuid":"c1","type":"ip_addr","value":"192.0.2.1"},{"uuid":"b","cluster_uuid":"c1","type":"ip_addr","value":"192.0.2.2"}]}`)
+		case "/v2/databases/c1":
+			fmt.Fprint(w, `{"database":{"id":"c1","connection":{"host":"db.example.com","port":25060},"private_connection":{"host":"private-db.example.com","port":25060}}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})).GodoClient()
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseFirewall().Schema, map[string]interface{}{
+		"cluster_id":                "c1",
+		"wait_for_propagation":      true,
+		"propagation_settle_period": "0s",
+		"verify_connection": []interface{}{
+			map[string]interface{}{"private": true, "timeout": "1s"},
+		},
+	})
+
+	rules := []*godo.DatabaseFirewallRule{
+		{Type: "ip_addr", Value: "192.0.2.1"},
+		{Type: "ip_addr", Value: "192.0.2.2"},
+	}
+	dialer := &fakeDatabaseFirewallDialer{failures: 2}
+
+	if err := waitForDatabaseFirewall(context.Background(), d, client, dialer, rules, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if firewallRequests != 3 {
+		t.Errorf("expected the rules to be polled until they match, got %d requests", firewallRequests)
+	}
+	if dialer.calls != 3 {
+		t.Errorf("expected the connection to be retried until it succeeds, got %d attempts", dialer.calls)
+	}
+	if dialer.addresses[0] != "private-db.example.com:25060" {
+		t.Errorf("expected to connect to the private host, got %s", dialer.addresses[0])
+	}
+}
+
+func TestWaitForDatabaseFirewallTimeout(t *testing.T) {
+	defer func(interval time.Duration) { databaseFirewallPollInterval = interval }(databaseFirewallPollInterval)
+	databaseFirewallPollInterval = 10 * time.Millisecond
+
+	// The rules are never updated.
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"rules":[]}`)
+	})).GodoClient()
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseFirewall().Schema, map[string]interface{}{
+		"cluster_id":           "c1",
+		"wait_for_propagation": true,
+	})
+
+	rules := []*godo.DatabaseFirewallRule{{Type: "tag", Value: "web"}}
+
+	err := waitForDatabaseFirewall(context.Background(), d, client, &fakeDatabaseFirewallDialer{}, rules, 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timeout waiting for the firewall rules of database cluster (c1) to be updated") {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+}
+
+func TestWaitForDatabaseConnectionTimeout(t *testing.T) {
+	dialer := &fakeDatabaseFirewallDialer{failures: 1000}
+
+	err := waitForDatabaseConnection(context.Background(), dialer, "db.example.com:25060", 50*time.Millisecond, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "waiting to connect to database cluster at db.example.com:25060 (last error: connection refused)") {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if dialer.calls < 2 {
+		t.Errorf("expected the connection to be retried, got %d attempts", dialer.calls)
+	}
+}
@@ -14,6 +14,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_importBasic(t *testing.T) {
 	resourceName := "digitalocean_database_connection_pool.pool-01"
 	databaseName := acceptance.RandomTestName()
 	databaseConnectionPoolName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -21,7 +22,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_importBasic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, databaseConnectionPoolName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, region, databaseConnectionPoolName),
 			},
 
 			{
@@ -13,6 +13,7 @@ import (
 
 func TestAccDigitalOceanDatabaseDB_importBasic(t *testing.T) {
 	resourceName := "digitalocean_database_db.foobar_db"
+	region := acceptance.TestAccRegion(t, "database:pg")
 	databaseClusterName := fmt.Sprintf("foobar-test-terraform-%s", acctest.RandString(10))
 	databaseDBName := fmt.Sprintf("foobar-test-db-terraform-%s", acctest.RandString(10))
 
@@ -22,7 +23,7 @@ func TestAccDigitalOceanDatabaseDB_importBasic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, databaseDBName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, region, databaseDBName),
 			},
 			{
 				ResourceName:      resourceName,
@@ -31,13 +32,19 @@ func TestAccDigitalOceanDatabaseDB_importBasic(t *testing.T) {
 				// Requires passing both the cluster ID and DB name
 				ImportStateIdFunc: testAccDatabaseDBImportID(resourceName),
 			},
+			// The full resource ID, e.g. from an old state export, is also accepted.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Test importing non-existent resource provides expected error.
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: false,
 				ImportStateId:     fmt.Sprintf("%s,%s", "this-cluster-id-does-not-exist", databaseDBName),
-				ExpectError:       regexp.MustCompile(`(Please verify the ID is correct|Cannot import non-existent remote object)`),
+				ExpectError:       regexp.MustCompile(`(Error retrieving Database DB|Please verify the ID is correct|Cannot import non-existent remote object)`),
 			},
 			{
 				ResourceName:      resourceName,
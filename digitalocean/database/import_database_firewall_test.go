@@ -13,6 +13,7 @@ import (
 func TestAccDigitalOceanDatabaseFirewall_importBasic(t *testing.T) {
 	resourceName := "digitalocean_database_firewall.example"
 	databaseClusterName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -20,7 +21,7 @@ func TestAccDigitalOceanDatabaseFirewall_importBasic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseFirewallDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName, region),
 			},
 			{
 				ResourceName: resourceName,
@@ -16,9 +16,10 @@ func TestAccDigitalOceanDatabaseReplica_importBasic(t *testing.T) {
 	resourceName := "digitalocean_database_replica.read-01"
 	databaseName := acceptance.RandomTestName()
 	databaseReplicaName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
-	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName, region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -14,6 +14,7 @@ func TestAccDigitalOceanDatabaseUser_importBasic(t *testing.T) {
 	resourceName := "digitalocean_database_user.foobar_user"
 	databaseClusterName := acceptance.RandomTestName()
 	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -21,7 +22,7 @@ func TestAccDigitalOceanDatabaseUser_importBasic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, region, databaseUserName),
 			},
 			{
 				ResourceName:      resourceName,
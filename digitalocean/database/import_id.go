@@ -0,0 +1,25 @@
+package database
+
+import (
+	"strings"
+)
+
+// splitDatabaseSubresourceImportID parses an import ID for a resource nested
+// under a database cluster. It accepts both `cluster_id,name` and the form
+// stored as the resource ID, `cluster_id/<kind>/name`, or `cluster_id/name`
+// for resources without a kind in their ID, like connection pools.
+func splitDatabaseSubresourceImportID(id string, kind string) (string, string, bool) {
+	if s := strings.SplitN(id, ",", 2); len(s) == 2 {
+		return s[0], s[1], s[0] != "" && s[1] != ""
+	}
+
+	sep := "/"
+	if kind != "" {
+		sep = "/" + kind + "/"
+	}
+	if s := strings.SplitN(id, sep, 2); len(s) == 2 {
+		return s[0], s[1], s[0] != "" && s[1] != ""
+	}
+
+	return "", "", false
+}
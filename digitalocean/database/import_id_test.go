@@ -0,0 +1,37 @@
+package database
+
+import (
+	"testing"
+)
+
+func TestSplitDatabaseSubresourceImportID(t *testing.T) {
+	cases := []struct {
+		id        string
+		kind      string
+		clusterID string
+		name      string
+		ok        bool
+	}{
+		{id: "245bcfd0,foobar", kind: "database", clusterID: "245bcfd0", name: "foobar", ok: true},
+		{id: "245bcfd0/database/foobar", kind: "database", clusterID: "245bcfd0", name: "foobar", ok: true},
+		{id: "245bcfd0/user/foobar", kind: "database", ok: false},
+		{id: "245bcfd0/user/foobar", kind: "user", clusterID: "245bcfd0", name: "foobar", ok: true},
+		{id: "245bcfd0,pool-01", kind: "", clusterID: "245bcfd0", name: "pool-01", ok: true},
+		{id: "245bcfd0/pool-01", kind: "", clusterID: "245bcfd0", name: "pool-01", ok: true},
+		{id: "245bcfd0,", kind: "database", ok: false},
+		{id: ",foobar", kind: "database", ok: false},
+		{id: "foobar", kind: "database", ok: false},
+		{id: "foobar", kind: "", ok: false},
+	}
+
+	for _, c := range cases {
+		clusterID, name, ok := splitDatabaseSubresourceImportID(c.id, c.kind)
+		if ok != c.ok {
+			t.Errorf("%q: expected ok=%t, got %t", c.id, c.ok, ok)
+			continue
+		}
+		if ok && (clusterID != c.clusterID || name != c.name) {
+			t.Errorf("%q: expected (%q, %q), got (%q, %q)", c.id, c.clusterID, c.name, clusterID, name)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// retryOnDatabaseMaintenance calls f until it succeeds, fails with an error
+// other than one caused by the cluster undergoing maintenance, or the timeout
+// is reached. It is used to wrap changes to a cluster's child resources, such
+// as its users and databases, which are rejected while maintenance is running.
+func retryOnDatabaseMaintenance(ctx context.Context, timeout time.Duration, clusterID string, f func() (*godo.Response, error)) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		resp, err := f()
+		if err == nil {
+			return nil
+		}
+
+		if isDatabaseMaintenanceError(resp, err) {
+			log.Printf("[INFO] Database cluster (%s) is undergoing maintenance, waiting for it to finish: %s", clusterID, err)
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+}
+
+// isDatabaseMaintenanceError reports whether a request failed because the
+// cluster is busy. The API responds with a 412 while a cluster is not ready to
+// accept changes, and with a 409 mentioning maintenance while a maintenance
+// window is in progress. Other conflicts, like a user that already exists, are
+// not retried.
+func isDatabaseMaintenanceError(resp *godo.Response, err error) bool {
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	message := err.Error()
+	var errResp *godo.ErrorResponse
+	if errors.As(err, &errResp) {
+		message = errResp.Message
+		if status == 0 && errResp.Response != nil {
+			status = errResp.Response.StatusCode
+		}
+	}
+
+	switch status {
+	case http.StatusPreconditionFailed:
+		return true
+	case http.StatusConflict:
+		return strings.Contains(strings.ToLower(message), "maintenance")
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// cannedDatabaseErrorResponse builds an error the way godo does from an API
+// response with the given status and body.
+func cannedDatabaseErrorResponse(status int, body string) (*godo.Response, error) {
+	r := &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v2/databases/c1/users"}},
+	}
+
+	return &godo.Response{Response: r}, godo.CheckResponse(r)
+}
+
+func TestIsDatabaseMaintenanceError(t *testing.T) {
+	cases := []struct {
+		status   int
+		body     string
+		expected bool
+	}{
+		{status: http.StatusConflict, body: `{"id":"conflict","message":"cluster is undergoing maintenance"}`, expected: true},
+		{status: http.StatusConflict, body: `{"id":"conflict","message":"Cluster is currently under Maintenance, try again later"}`, expected: true},
+		{status: http.StatusPreconditionFailed, body: `{"id":"precondition_failed","message":"cluster is undergoing maintenance"}`, expected: true},
+		{status: http.StatusPreconditionFailed, body: `{"id":"precondition_failed","message":"cluster is not ready"}`, expected: true},
+		{status: http.StatusConflict, body: `{"id":"conflict","message":"user already exists"}`, expected: false},
+		{status: http.StatusUnprocessableEntity, body: `{"id":"unprocessable_entity","message":"invalid name"}`, expected: false},
+	}
+
+	for _, c := range cases {
+		resp, err := cannedDatabaseErrorResponse(c.status, c.body)
+		if got := isDatabaseMaintenanceError(resp, err); got != c.expected {
+			t.Errorf("%d %s: expected %t, got %t", c.status, c.body, c.expected, got)
+		}
+
+		// The status is also taken from the error when no response is returned.
+		if got := isDatabaseMaintenanceError(nil, err); got != c.expected {
+			t.Errorf("%d %s without response: expected %t, got %t", c.status, c.body, c.expected, got)
+		}
+	}
+}
+
+func TestRetryOnDatabaseMaintenance(t *testing.T) {
+	maintenance := `{"id":"conflict","message":"cluster is undergoing maintenance"}`
+
+	t.Run("succeeds after maintenance", func(t *testing.T) {
+		attempts := 0
+		err := retryOnDatabaseMaintenance(context.Background(), time.Minute, "c1", func() (*godo.Response, error) {
+			attempts++
+			if attempts < 3 {
+				return cannedDatabaseErrorResponse(http.StatusConflict, maintenance)
+			}
+			return &godo.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		attempts := 0
+		err := retryOnDatabaseMaintenance(context.Background(), time.Minute, "c1", func() (*godo.Response, error) {
+			attempts++
+			return cannedDatabaseErrorResponse(http.StatusConflict, `{"id":"conflict","message":"user already exists"}`)
+		})
+		if err == nil || !strings.Contains(err.Error(), "user already exists") {
+			t.Fatalf("expected conflict error, got: %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("gives up at the timeout", func(t *testing.T) {
+		err := retryOnDatabaseMaintenance(context.Background(), time.Second, "c1", func() (*godo.Response, error) {
+			return cannedDatabaseErrorResponse(http.StatusConflict, maintenance)
+		})
+		if err == nil || !strings.Contains(err.Error(), "undergoing maintenance") {
+			t.Fatalf("expected maintenance error, got: %v", err)
+		}
+	})
+}
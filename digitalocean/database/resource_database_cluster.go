@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,15 +14,20 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpc"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	mysqlDBEngineSlug = "mysql"
-	redisDBEngineSlug = "redis"
+	mysqlDBEngineSlug    = "mysql"
+	postgresDBEngineSlug = "pg"
+	redisDBEngineSlug    = "redis"
+	valkeyDBEngineSlug   = "valkey"
 )
 
 func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
@@ -96,21 +103,33 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 						"day": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"monday",
+								"tuesday",
+								"wednesday",
+								"thursday",
+								"friday",
+								"saturday",
+								"sunday",
+							}, true),
+							// The API returns the day in lower case, e.g: "Friday" -> "friday"
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(v.(string))
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
 						},
 						"hour": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMaintWindowHour,
+							StateFunc: func(v interface{}) string {
+								return normalizeMaintWindowHour(v.(string))
+							},
 							// Prevent a diff when seconds in response, e.g: "13:00" -> "13:00:00"
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								newSplit := strings.Split(new, ":")
-								oldSplit := strings.Split(old, ":")
-								if len(newSplit) == 3 {
-									new = strings.Join(newSplit[:2], ":")
-								}
-								if len(oldSplit) == 3 {
-									old = strings.Join(oldSplit[:2], ":")
-								}
-								return old == new
+								return normalizeMaintWindowHour(old) == normalizeMaintWindowHour(new)
 							},
 						},
 					},
@@ -136,10 +155,11 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"vpc_name": vpc.VPCNameSchema("private_network_uuid"),
+
 			"project_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
@@ -224,6 +244,12 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the database cluster, e.g. online or upgrading.",
+			},
+
 			"tags": tag.TagsSchema(),
 
 			"backup_restore": {
@@ -249,15 +275,62 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"replica_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the cluster's read-only replicas.",
+			},
+
+			"replica_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of read-only replicas of the cluster.",
+			},
+
+			"cascade_delete_replicas": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the cluster's read-only replicas when it is destroyed. Otherwise, destroying a cluster with replicas fails.",
+			},
+
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        embeddedUserSchema(),
+				Description: "Users created along with the cluster, as an alternative to digitalocean_database_user resources.",
+			},
+
+			"databases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        embeddedDatabaseSchema(),
+				Description: "Databases created along with the cluster, as an alternative to digitalocean_database_db resources.",
+			},
+
+			"user_passwords": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The passwords of the users declared in users, keyed by name.",
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			transitionVersionToRequired(),
 			validateExclusiveAttributes(),
+			customdiff.ValidateChange("version", validateDatabaseVersionUpgrade),
+			vpc.CustomizeDiffVPCName("private_network_uuid"),
+			customizeDiffEmbeddedUsersAndDatabases(),
 		),
 	}
 }
@@ -280,6 +353,31 @@ func transitionVersionToRequired() schema.CustomizeDiffFunc {
 	})
 }
 
+// validateDatabaseVersionUpgrade rejects changes to an older version, as the
+// API only supports upgrading a cluster in place. Versions that can not be
+// parsed are left for the API to validate.
+func validateDatabaseVersionUpgrade(ctx context.Context, old, new, meta interface{}) error {
+	if old.(string) == "" || new.(string) == "" {
+		return nil
+	}
+
+	oldVer, err := version.NewVersion(old.(string))
+	if err != nil {
+		return nil
+	}
+
+	newVer, err := version.NewVersion(new.(string))
+	if err != nil {
+		return nil
+	}
+
+	if newVer.LessThan(oldVer) {
+		return fmt.Errorf("database clusters can not be downgraded: version %s is older than the current version %s", new, old)
+	}
+
+	return nil
+}
+
 func validateExclusiveAttributes() schema.CustomizeDiffFunc {
 	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 		engine := diff.Get("engine")
@@ -290,8 +388,8 @@ func validateExclusiveAttributes() schema.CustomizeDiffFunc {
 			return fmt.Errorf("sql_mode is only supported for MySQL Database Clusters")
 		}
 
-		if hasEvictionPolicy && engine != redisDBEngineSlug {
-			return fmt.Errorf("eviction_policy is only supported for Redis Database Clusters")
+		if hasEvictionPolicy && engine != redisDBEngineSlug && engine != valkeyDBEngineSlug {
+			return fmt.Errorf("eviction_policy is only supported for Redis and Valkey Database Clusters")
 		}
 
 		return nil
@@ -311,9 +409,11 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 		Tags:       tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
 	}
 
-	if v, ok := d.GetOk("private_network_uuid"); ok {
-		opts.PrivateNetworkUUID = v.(string)
+	vpcID, err := vpc.ExpandVPCID(ctx, d, meta, "private_network_uuid")
+	if err != nil {
+		return diag.Errorf("Error resolving vpc_name: %s", err)
 	}
+	opts.PrivateNetworkUUID = vpcID
 
 	if v, ok := d.GetOk("project_id"); ok {
 		opts.ProjectID = v.(string)
@@ -380,12 +480,24 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if err := applyEmbeddedDatabases(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error creating databases of database cluster: %s", err)
+	}
+
+	if err := applyEmbeddedUsers(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error creating users of database cluster: %s", err)
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if err := vpc.CheckVPCNameChange(ctx, d, meta, "private_network_uuid"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChanges("size", "node_count", "storage_size_mib") {
 		opts := &godo.DatabaseResizeRequest{
 			SizeSlug: d.Get("size").(string),
@@ -486,6 +598,11 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 		if err != nil {
 			return diag.Errorf("Error upgrading version for database cluster: %s", err)
 		}
+
+		_, err = waitForDatabaseClusterVersion(ctx, client, d, upgradeVersionReq.Version)
+		if err != nil {
+			return diag.Errorf("Error upgrading version for database cluster: %s", err)
+		}
 	}
 
 	if d.HasChange("tags") {
@@ -495,6 +612,29 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	// As project_id is computed, this only has a change when it is set in the
+	// configuration. Otherwise the assignment is left to be managed elsewhere,
+	// e.g. by digitalocean_project_resources.
+	if d.HasChange("project_id") {
+		projectID := d.Get("project_id").(string)
+		_, _, err := client.Projects.AssignResources(ctx, projectID, d.Get("urn").(string))
+		if err != nil {
+			return diag.Errorf("Error moving database cluster to project (%s): %s", projectID, err)
+		}
+	}
+
+	if d.HasChange("databases") {
+		if err := applyEmbeddedDatabases(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error updating databases of database cluster: %s", err)
+		}
+	}
+
+	if d.HasChange("users") {
+		if err := applyEmbeddedUsers(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error updating users of database cluster: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
@@ -558,17 +698,51 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	}
 
 	d.Set("urn", database.URN())
+	d.Set("status", database.Status)
 	d.Set("private_network_uuid", database.PrivateNetworkUUID)
 	d.Set("project_id", database.ProjectID)
 
+	replicaNames, err := listDatabaseReplicaNames(ctx, client, database)
+	if err != nil {
+		return diag.Errorf("Error retrieving replicas for database cluster: %s", err)
+	}
+
+	d.Set("replica_names", replicaNames)
+	d.Set("replica_count", len(replicaNames))
+
+	if err := readEmbeddedUsersAndDatabases(ctx, client, d); err != nil {
+		return diag.Errorf("Error retrieving users and databases of database cluster: %s", err)
+	}
+
 	return nil
 }
 
 func resourceDigitalOceanDatabaseClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	// Check for replicas that are not managed as part of the configuration,
+	// as deleting the cluster would fail or orphan them.
+	database := &godo.Database{ID: d.Id(), EngineSlug: d.Get("engine").(string)}
+	replicaNames, err := listDatabaseReplicaNames(ctx, client, database)
+	if err != nil {
+		return diag.Errorf("Error retrieving replicas for database cluster: %s", err)
+	}
+
+	if len(replicaNames) > 0 {
+		if !d.Get("cascade_delete_replicas").(bool) {
+			return diag.Errorf("Error deleting database cluster: it has read-only replicas (%s). Delete them first, or set `cascade_delete_replicas = true` to delete them along with the cluster", strings.Join(replicaNames, ", "))
+		}
+
+		for _, name := range replicaNames {
+			log.Printf("[INFO] Deleting replica %s of database cluster: %s", name, d.Id())
+			if err := deleteDatabaseReplicaAndWait(ctx, client, d.Id(), name, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return diag.Errorf("Error deleting replica %s of database cluster: %s", name, err)
+			}
+		}
+	}
+
 	log.Printf("[INFO] Deleting database cluster: %s", d.Id())
-	_, err := client.Databases.Delete(context.Background(), d.Id())
+	_, err = client.Databases.Delete(context.Background(), d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting database cluster: %s", err)
 	}
@@ -577,6 +751,60 @@ func resourceDigitalOceanDatabaseClusterDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
+// listDatabaseReplicaNames returns the sorted names of a cluster's read-only
+// replicas. Only MySQL and PostgreSQL clusters support replicas, so the API is
+// not queried for other engines.
+func listDatabaseReplicaNames(ctx context.Context, client *godo.Client, database *godo.Database) ([]string, error) {
+	names := []string{}
+
+	if database.EngineSlug != mysqlDBEngineSlug && database.EngineSlug != postgresDBEngineSlug {
+		return names, nil
+	}
+
+	replicas, _, err := client.Databases.ListReplicas(ctx, database.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replica := range replicas {
+		names = append(names, replica.Name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func deleteDatabaseReplicaAndWait(ctx context.Context, client *godo.Client, clusterID, name string, timeout time.Duration) error {
+	resp, err := client.Databases.DeleteReplica(ctx, clusterID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			replica, resp, err := client.Databases.GetReplica(ctx, clusterID, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return name, "deleted", nil
+				}
+				return nil, "", err
+			}
+
+			return replica, "deleting", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
 func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status string) (*godo.Database, error) {
 	var (
 		tickerInterval = 15 * time.Second
@@ -613,6 +841,39 @@ func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status
 	return nil, fmt.Errorf("Timeout waiting to database cluster to become %s", status)
 }
 
+// waitForDatabaseClusterVersion waits for an upgraded cluster to report the
+// new version and to be online again. The status alone is not enough, as the
+// cluster may still report online immediately after the upgrade is requested.
+func waitForDatabaseClusterVersion(ctx context.Context, client *godo.Client, d *schema.ResourceData, targetVersion string) (*godo.Database, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"upgrading"},
+		Target:  []string{"online"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Databases.Get(ctx, d.Id())
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			if database.Status != "online" || database.VersionSlug != targetVersion {
+				log.Printf("[DEBUG] Database cluster (%s) is %s at version %s, waiting for version %s", d.Id(), database.Status, database.VersionSlug, targetVersion)
+				return database, "upgrading", nil
+			}
+
+			return database, database.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 15 * time.Second,
+	}
+
+	database, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for database cluster (%s) to be upgraded to version %s: %s", d.Id(), targetVersion, err)
+	}
+
+	return database.(*godo.Database), nil
+}
+
 func expandMaintWindowOpts(config []interface{}) *godo.DatabaseUpdateMaintenanceRequest {
 	maintWindowOpts := &godo.DatabaseUpdateMaintenanceRequest{}
 	configMap := config[0].(map[string]interface{})
@@ -628,12 +889,37 @@ func expandMaintWindowOpts(config []interface{}) *godo.DatabaseUpdateMaintenance
 	return maintWindowOpts
 }
 
+// normalizeMaintWindowHour returns the hour in HH:mm:ss form, as the API
+// usually, but not always, returns it. Values that can not be parsed are
+// returned unchanged.
+func normalizeMaintWindowHour(hour string) string {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, hour); err == nil {
+			return t.Format("15:04:05")
+		}
+	}
+
+	return hour
+}
+
+func validateMaintWindowHour(v interface{}, k string) (ws []string, errors []error) {
+	hour := v.(string)
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if _, err := time.Parse(layout, hour); err == nil {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be in the HH:mm or HH:mm:ss format, got: %q", k, hour))
+	return
+}
+
 func flattenMaintWindowOpts(opts godo.DatabaseMaintenanceWindow) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	item := make(map[string]interface{})
 
-	item["day"] = opts.Day
-	item["hour"] = opts.Hour
+	item["day"] = strings.ToLower(opts.Day)
+	item["hour"] = normalizeMaintWindowHour(opts.Hour)
 	result = append(result, item)
 
 	return result
@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeMaintWindowHour(t *testing.T) {
+	cases := map[string]string{
+		"13:00":    "13:00:00",
+		"13:00:00": "13:00:00",
+		"09:30:15": "09:30:15",
+		"9:30":     "09:30:00",
+		"00:00":    "00:00:00",
+		"noon":     "noon",
+	}
+
+	for hour, expected := range cases {
+		if got := normalizeMaintWindowHour(hour); got != expected {
+			t.Errorf("%q: expected %q, got %q", hour, expected, got)
+		}
+	}
+}
+
+func TestValidateMaintWindowHour(t *testing.T) {
+	for _, hour := range []string{"13:00", "13:00:00", "00:00"} {
+		if _, errs := validateMaintWindowHour(hour, "hour"); len(errs) != 0 {
+			t.Errorf("%q: unexpected errors: %v", hour, errs)
+		}
+	}
+
+	for _, hour := range []string{"", "13", "25:00", "13:60", "1pm"} {
+		if _, errs := validateMaintWindowHour(hour, "hour"); len(errs) == 0 {
+			t.Errorf("%q: expected an error", hour)
+		}
+	}
+}
+
+func TestFlattenMaintWindowOpts(t *testing.T) {
+	cases := []struct {
+		day          string
+		hour         string
+		expectedDay  string
+		expectedHour string
+	}{
+		{day: "friday", hour: "13:00:00", expectedDay: "friday", expectedHour: "13:00:00"},
+		{day: "Friday", hour: "13:00", expectedDay: "friday", expectedHour: "13:00:00"},
+		{day: "SUNDAY", hour: "4:05", expectedDay: "sunday", expectedHour: "04:05:00"},
+	}
+
+	for _, c := range cases {
+		window := flattenMaintWindowOpts(godo.DatabaseMaintenanceWindow{Day: c.day, Hour: c.hour})[0]
+		if window["day"] != c.expectedDay || window["hour"] != c.expectedHour {
+			t.Errorf("(%q, %q): expected (%q, %q), got (%q, %q)", c.day, c.hour, c.expectedDay, c.expectedHour, window["day"], window["hour"])
+		}
+	}
+}
+
+func TestValidateDatabaseVersionUpgrade(t *testing.T) {
+	cases := []struct {
+		old     string
+		new     string
+		wantErr bool
+	}{
+		{old: "14", new: "15"},
+		{old: "15", new: "15"},
+		{old: "", new: "15"},
+		{old: "3.5", new: "3.7"},
+		{old: "6.0", new: "7.0"},
+		{old: "9", new: "10"},
+		{old: "15", new: "14", wantErr: true},
+		{old: "10", new: "9", wantErr: true},
+		{old: "3.7", new: "3.5", wantErr: true},
+		{old: "15", new: "latest"},
+	}
+
+	for _, c := range cases {
+		err := validateDatabaseVersionUpgrade(context.Background(), c.old, c.new, nil)
+		if c.wantErr && err == nil {
+			t.Errorf("expected an error changing version %q to %q", c.old, c.new)
+		}
+		if !c.wantErr && err != nil {
+			t.Errorf("unexpected error changing version %q to %q: %s", c.old, c.new, err)
+		}
+	}
+}
+
+// fakeReplicaAPI serves the replica and cluster endpoints used when deleting
+// a cluster, recording the requests it receives.
+type fakeReplicaAPI struct {
+	mu       sync.Mutex
+	replicas map[string]bool
+	requests []string
+}
+
+func (f *fakeReplicaAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+
+	name := strings.TrimPrefix(r.URL.Path, "/v2/databases/c1/replicas/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/databases/c1/replicas":
+		replicas := []godo.DatabaseReplica{}
+		for name := range f.replicas {
+			replicas = append(replicas, godo.DatabaseReplica{Name: name})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"replicas": replicas})
+	case r.Method == http.MethodGet && f.replicas[name]:
+		json.NewEncoder(w).Encode(map[string]interface{}{"replica": godo.DatabaseReplica{Name: name}})
+	case r.Method == http.MethodDelete && f.replicas[name]:
+		delete(f.replicas, name)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Path == "/v2/databases/c1":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"id":"not_found","message":"not found"}`)
+	}
+}
+
+func TestResourceDigitalOceanDatabaseClusterDeleteWithReplicas(t *testing.T) {
+	for _, cascade := range []bool{false, true} {
+		t.Run(fmt.Sprintf("cascade_delete_replicas=%t", cascade), func(t *testing.T) {
+			api := &fakeReplicaAPI{replicas: map[string]bool{"read-2": true, "read-1": true}}
+			meta := newTestMeta(t, api)
+
+			r := ResourceDigitalOceanDatabaseCluster()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"name":                    "foo",
+				"engine":                  "pg",
+				"cascade_delete_replicas": cascade,
+			})
+			d.SetId("c1")
+
+			diags := r.DeleteContext(context.Background(), d, meta)
+
+			if !cascade {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, "read-only replicas (read-1, read-2)") {
+					t.Fatalf("expected the delete to be blocked, got: %#v", diags)
+				}
+				if len(api.requests) != 1 || api.requests[0] != "GET /v2/databases/c1/replicas" {
+					t.Errorf("expected nothing to be deleted, got requests: %v", api.requests)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			expected := []string{
+				"GET /v2/databases/c1/replicas",
+				"DELETE /v2/databases/c1/replicas/read-1",
+				"GET /v2/databases/c1/replicas/read-1",
+				"DELETE /v2/databases/c1/replicas/read-2",
+				"GET /v2/databases/c1/replicas/read-2",
+				"DELETE /v2/databases/c1",
+			}
+			if !reflect.DeepEqual(api.requests, expected) {
+				t.Errorf("expected requests %v, got %v", expected, api.requests)
+			}
+			if d.Id() != "" {
+				t.Errorf("expected the ID to be cleared, got %q", d.Id())
+			}
+		})
+	}
+}
+
+func TestListDatabaseReplicaNamesUnsupportedEngine(t *testing.T) {
+	// No requests are made for engines without replicas, so no client is
+	// needed.
+	names, err := listDatabaseReplicaNames(context.Background(), nil, &godo.Database{ID: "c1", EngineSlug: redisDBEngineSlug})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected no replicas, got %v", names)
+	}
+}
@@ -18,6 +18,7 @@ import (
 func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -25,7 +26,7 @@ func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -70,6 +71,7 @@ func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -77,7 +79,7 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -86,7 +88,7 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.TestCheckFunc(
 					func(s *terraform.State) error {
 						time.Sleep(30 * time.Second)
@@ -95,7 +97,7 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithUpdate, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithUpdate, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -110,6 +112,7 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -117,7 +120,7 @@ func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -126,7 +129,7 @@ func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.TestCheckFunc(
 					func(s *terraform.State) error {
 						time.Sleep(30 * time.Second)
@@ -135,7 +138,7 @@ func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithAdditionalStorage, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithAdditionalStorage, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -150,6 +153,7 @@ func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_WithMigration(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -157,12 +161,12 @@ func TestAccDigitalOceanDatabaseCluster_WithMigration(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
 					resource.TestCheckResourceAttr(
-						"digitalocean_database_cluster.foobar", "region", "nyc1"),
+						"digitalocean_database_cluster.foobar", "region", region),
 				),
 			},
 			{
@@ -181,6 +185,7 @@ func TestAccDigitalOceanDatabaseCluster_WithMigration(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_WithMaintWindow(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -188,16 +193,21 @@ func TestAccDigitalOceanDatabaseCluster_WithMaintWindow(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithMaintWindow, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithMaintWindow, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
-					resource.TestCheckResourceAttrSet(
-						"digitalocean_database_cluster.foobar", "maintenance_window.0.day"),
-					resource.TestCheckResourceAttrSet(
-						"digitalocean_database_cluster.foobar", "maintenance_window.0.hour"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "maintenance_window.0.day", "friday"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "maintenance_window.0.hour", "13:00:00"),
 				),
 			},
+			// The API returns "friday" and "13:00:00", which must not produce a diff.
+			{
+				Config:   fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithMaintWindow, databaseName, region),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -237,6 +247,7 @@ func TestAccDigitalOceanDatabaseCluster_WithSQLMode(t *testing.T) {
 
 func TestAccDigitalOceanDatabaseCluster_CheckSQLModeSupport(t *testing.T) {
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:redis")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -244,7 +255,7 @@ func TestAccDigitalOceanDatabaseCluster_CheckSQLModeSupport(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithRedisSQLModeError, databaseName),
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithRedisSQLModeError, databaseName, region),
 				ExpectError: regexp.MustCompile(`sql_mode is only supported for MySQL`),
 			},
 		},
@@ -254,6 +265,7 @@ func TestAccDigitalOceanDatabaseCluster_CheckSQLModeSupport(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_RedisNoVersion(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:redis")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -261,7 +273,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisNoVersion(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedisNoVersion, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedisNoVersion, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -287,6 +299,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisNoVersion(t *testing.T) {
 // The provider suppresses diffs when the config version is <= to the latest
 // version. New clusters is always created with the latest version .
 func TestAccDigitalOceanDatabaseCluster_oldRedisVersion(t *testing.T) {
+	region := acceptance.TestAccRegion(t, "database:redis")
 	var (
 		database godo.Database
 	)
@@ -299,7 +312,7 @@ func TestAccDigitalOceanDatabaseCluster_oldRedisVersion(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, databaseName, "5"),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, databaseName, "5", region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -318,6 +331,7 @@ func TestAccDigitalOceanDatabaseCluster_oldRedisVersion(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_RedisWithEvictionPolicy(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:redis")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -326,7 +340,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisWithEvictionPolicy(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with an eviction policy
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicy, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicy, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -336,7 +350,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisWithEvictionPolicy(t *testing.T) {
 			},
 			// Update eviction policy
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicyUpdate, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicyUpdate, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -346,7 +360,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisWithEvictionPolicy(t *testing.T) {
 			},
 			// Remove eviction policy
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, databaseName, "6"),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, databaseName, "6", region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -358,6 +372,7 @@ func TestAccDigitalOceanDatabaseCluster_RedisWithEvictionPolicy(t *testing.T) {
 
 func TestAccDigitalOceanDatabaseCluster_CheckEvictionPolicySupport(t *testing.T) {
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -365,7 +380,7 @@ func TestAccDigitalOceanDatabaseCluster_CheckEvictionPolicySupport(t *testing.T)
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicyError, databaseName),
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithEvictionPolicyError, databaseName, region),
 				ExpectError: regexp.MustCompile(`eviction_policy is only supported for Redis`),
 			},
 		},
@@ -375,6 +390,7 @@ func TestAccDigitalOceanDatabaseCluster_CheckEvictionPolicySupport(t *testing.T)
 func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -382,7 +398,7 @@ func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -391,7 +407,7 @@ func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigTagUpdate, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigTagUpdate, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -407,6 +423,7 @@ func TestAccDigitalOceanDatabaseCluster_WithVPC(t *testing.T) {
 	var database godo.Database
 	vpcName := acceptance.RandomTestName()
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -414,7 +431,7 @@ func TestAccDigitalOceanDatabaseCluster_WithVPC(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVPC, vpcName, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVPC, vpcName, region, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -429,12 +446,13 @@ func TestAccDigitalOceanDatabaseCluster_WithVPC(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_WithBackupRestore(t *testing.T) {
 	var originalDatabase godo.Database
 	var backupDatabase godo.Database
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	originalDatabaseName := acceptance.RandomTestName()
 	backupDatabasename := acceptance.RandomTestName()
 
-	originalDatabaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, originalDatabaseName)
-	backUpRestoreConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithBackupRestore, backupDatabasename, originalDatabaseName)
+	originalDatabaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, originalDatabaseName, region)
+	backUpRestoreConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithBackupRestore, backupDatabasename, region, originalDatabaseName)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -447,7 +465,7 @@ func TestAccDigitalOceanDatabaseCluster_WithBackupRestore(t *testing.T) {
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &originalDatabase),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&originalDatabase, originalDatabaseName),
 					resource.TestCheckResourceAttr(
-						"digitalocean_database_cluster.foobar", "region", "nyc1"),
+						"digitalocean_database_cluster.foobar", "region", region),
 					func(s *terraform.State) error {
 						err := waitForDatabaseBackups(originalDatabaseName)
 						return err
@@ -460,7 +478,7 @@ func TestAccDigitalOceanDatabaseCluster_WithBackupRestore(t *testing.T) {
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar_backup", &backupDatabase),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&backupDatabase, backupDatabasename),
 					resource.TestCheckResourceAttr(
-						"digitalocean_database_cluster.foobar_backup", "region", "nyc1"),
+						"digitalocean_database_cluster.foobar_backup", "region", region),
 				),
 			},
 		},
@@ -470,6 +488,7 @@ func TestAccDigitalOceanDatabaseCluster_WithBackupRestore(t *testing.T) {
 func TestAccDigitalOceanDatabaseCluster_MongoDBPassword(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:mongodb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -477,7 +496,7 @@ func TestAccDigitalOceanDatabaseCluster_MongoDBPassword(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigMongoDB, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigMongoDB, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists(
 						"digitalocean_database_cluster.foobar", &database),
@@ -508,6 +527,7 @@ func TestAccDigitalOceanDatabaseCluster_Upgrade(t *testing.T) {
 	databaseName := acceptance.RandomTestName()
 	previousPGVersion := "14"
 	latestPGVersion := "15"
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -517,7 +537,7 @@ func TestAccDigitalOceanDatabaseCluster_Upgrade(t *testing.T) {
 			{
 				// TODO: Hardcoding the versions here is not ideal.
 				// We will need to determine a better way to fetch the last and latest versions dynamically.
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", previousPGVersion),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", previousPGVersion, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists(
 						"digitalocean_database_cluster.foobar", &database),
@@ -530,12 +550,19 @@ func TestAccDigitalOceanDatabaseCluster_Upgrade(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", latestPGVersion),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", latestPGVersion, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_cluster.foobar", "version", latestPGVersion),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "status", "online"),
 				),
 			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", previousPGVersion, region),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("database clusters can not be downgraded"),
+			},
 		},
 	})
 }
@@ -544,6 +571,7 @@ func TestAccDigitalOceanDatabaseCluster_nonDefaultProject(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
 	projectName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -551,7 +579,7 @@ func TestAccDigitalOceanDatabaseCluster_nonDefaultProject(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigNonDefaultProject, projectName, databaseName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigNonDefaultProject, projectName, databaseName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
@@ -565,6 +593,45 @@ func TestAccDigitalOceanDatabaseCluster_nonDefaultProject(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_moveProject(t *testing.T) {
+	var database godo.Database
+	var movedDatabase godo.Database
+	databaseName := acceptance.RandomTestName()
+	projectName := acceptance.RandomTestName()
+	otherProjectName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigMoveProject, projectName, otherProjectName, databaseName, region, "foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_project.foobar", "id", "digitalocean_database_cluster.foobar", "project_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigMoveProject, projectName, otherProjectName, databaseName, region, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &movedDatabase),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_project.other", "id", "digitalocean_database_cluster.foobar", "project_id"),
+					func(s *terraform.State) error {
+						if database.ID != movedDatabase.ID {
+							return fmt.Errorf("Expected database cluster to be moved in place, but it was recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseClusterDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -715,7 +782,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -726,7 +793,7 @@ resource "digitalocean_database_cluster" "foobar_backup" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 
@@ -741,7 +808,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-2vcpu-4gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -752,7 +819,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine           = "pg"
   version          = "15"
   size             = "db-s-1vcpu-2gb"
-  region           = "nyc1"
+  region           = "%s"
   node_count       = 1
   tags             = ["production"]
   storage_size_mib = 61440
@@ -775,12 +842,12 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 
   maintenance_window {
-    day  = "friday"
+    day  = "Friday"
     hour = "13:00"
   }
 }`
@@ -812,7 +879,7 @@ resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
   engine     = "redis"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   sql_mode   = "ANSI"
 }`
@@ -822,7 +889,7 @@ resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
   engine     = "redis"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -833,7 +900,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "redis"
   version    = "%s"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -844,7 +911,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "kafka"
   version    = "%s"
   size       = "db-s-2vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 3
   tags       = ["production"]
 }`
@@ -855,7 +922,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mysql"
   version    = "%s"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -866,7 +933,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "%s"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production"]
 }`
@@ -877,7 +944,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine          = "redis"
   version         = "5"
   size            = "db-s-1vcpu-1gb"
-  region          = "nyc1"
+  region          = "%s"
   node_count      = 1
   tags            = ["production"]
   eviction_policy = "volatile_random"
@@ -890,7 +957,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine          = "redis"
   version         = "5"
   size            = "db-s-1vcpu-1gb"
-  region          = "nyc1"
+  region          = "%s"
   node_count      = 1
   tags            = ["production"]
   eviction_policy = "allkeys_lru"
@@ -903,7 +970,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine          = "pg"
   version         = "15"
   size            = "db-s-1vcpu-1gb"
-  region          = "nyc1"
+  region          = "%s"
   node_count      = 1
   eviction_policy = "allkeys_lru"
 }
@@ -915,7 +982,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   tags       = ["production", "foo"]
 }`
@@ -923,7 +990,7 @@ resource "digitalocean_database_cluster" "foobar" {
 const testAccCheckDigitalOceanDatabaseClusterConfigWithVPC = `
 resource "digitalocean_vpc" "foobar" {
   name   = "%s"
-  region = "nyc1"
+  region = "%s"
 }
 
 resource "digitalocean_database_cluster" "foobar" {
@@ -931,7 +998,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine               = "pg"
   version              = "15"
   size                 = "db-s-1vcpu-2gb"
-  region               = "nyc1"
+  region               = "%s"
   node_count           = 1
   tags                 = ["production"]
   private_network_uuid = digitalocean_vpc.foobar.id
@@ -943,7 +1010,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mongodb"
   version    = "6"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc3"
+  region     = "%s"
   node_count = 1
 }`
 
@@ -953,7 +1020,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "%s"
   version    = "%s"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc3"
+  region     = "%s"
   node_count = 1
 }`
 
@@ -967,7 +1034,26 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
   project_id = digitalocean_project.foobar.id
 }`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigMoveProject = `
+resource "digitalocean_project" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_project" "other" {
+  name = "%s"
+}
+
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-2gb"
+  region     = "%s"
+  node_count = 1
+  project_id = digitalocean_project.%s.id
+}`
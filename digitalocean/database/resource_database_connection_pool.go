@@ -84,6 +84,11 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"private_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"uri": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -118,7 +123,11 @@ func resourceDigitalOceanDatabaseConnectionPoolCreate(ctx context.Context, d *sc
 	}
 
 	log.Printf("[DEBUG] DatabaseConnectionPool create configuration: %#v", opts)
-	pool, _, err := client.Databases.CreatePool(context.Background(), clusterID, opts)
+	var pool *godo.DatabasePool
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutCreate), clusterID, func() (resp *godo.Response, err error) {
+		pool, resp, err = client.Databases.CreatePool(context.Background(), clusterID, opts)
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseConnectionPool: %s", err)
 	}
@@ -185,6 +194,7 @@ func setConnectionPoolInfo(pool *godo.DatabasePool, d *schema.ResourceData) erro
 
 	if pool.PrivateConnection != nil {
 		d.Set("private_host", pool.PrivateConnection.Host)
+		d.Set("private_port", pool.PrivateConnection.Port)
 
 		privateURI, err := buildDBConnectionURI(pool.PrivateConnection, d)
 		if err != nil {
@@ -198,15 +208,15 @@ func setConnectionPoolInfo(pool *godo.DatabasePool, d *schema.ResourceData) erro
 }
 
 func resourceDigitalOceanDatabaseConnectionPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if strings.Contains(d.Id(), ",") {
-		s := strings.Split(d.Id(), ",")
-		d.SetId(createConnectionPoolID(s[0], s[1]))
-		d.Set("cluster_id", s[0])
-		d.Set("name", s[1])
-	} else {
+	clusterID, name, ok := splitDatabaseSubresourceImportID(d.Id(), "")
+	if !ok {
 		return nil, errors.New("must use the ID of the source database cluster and the name of the connection pool joined with a comma (e.g. `id,name`)")
 	}
 
+	d.SetId(createConnectionPoolID(clusterID, name))
+	d.Set("cluster_id", clusterID)
+	d.Set("name", name)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -215,7 +225,9 @@ func resourceDigitalOceanDatabaseConnectionPoolDelete(ctx context.Context, d *sc
 	clusterID, poolName := splitConnectionPoolID(d.Id())
 
 	log.Printf("[INFO] Deleting DatabaseConnectionPool: %s", poolName)
-	_, err := client.Databases.DeletePool(context.Background(), clusterID, poolName)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutDelete), clusterID, func() (*godo.Response, error) {
+		return client.Databases.DeletePool(context.Background(), clusterID, poolName)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting DatabaseConnectionPool: %s", err)
 	}
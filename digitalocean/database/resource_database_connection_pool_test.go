@@ -17,6 +17,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 	var databaseConnectionPool godo.DatabasePool
 	databaseName := acceptance.RandomTestName()
 	databaseConnectionPoolName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -24,7 +25,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, databaseConnectionPoolName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, region, databaseConnectionPoolName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
 					testAccCheckDigitalOceanDatabaseConnectionPoolAttributes(&databaseConnectionPool, databaseConnectionPoolName),
@@ -44,6 +45,8 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 						"digitalocean_database_connection_pool.pool-01", "private_host"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_connection_pool.pool-01", "port"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_connection_pool.pool-01", "private_port"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_connection_pool.pool-01", "uri"),
 					resource.TestCheckResourceAttrSet(
@@ -53,7 +56,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigUpdated, databaseName, databaseConnectionPoolName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigUpdated, databaseName, region, databaseConnectionPoolName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
 					testAccCheckDigitalOceanDatabaseConnectionPoolAttributes(&databaseConnectionPool, databaseConnectionPoolName),
@@ -68,6 +71,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 }
 
 func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	var databaseConnectionPool godo.DatabasePool
 	databaseName := acceptance.RandomTestName()
@@ -79,7 +83,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigInboundUser, databaseName, databaseConnectionPoolName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigInboundUser, databaseName, region, databaseConnectionPoolName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
 					testAccCheckDigitalOceanDatabaseConnectionPoolAttributes(&databaseConnectionPool, databaseConnectionPoolName),
@@ -99,6 +103,8 @@ func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
 						"digitalocean_database_connection_pool.pool-01", "private_host"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_connection_pool.pool-01", "port"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_connection_pool.pool-01", "private_port"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_connection_pool.pool-01", "uri"),
 					resource.TestCheckResourceAttrSet(
@@ -112,6 +118,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
 func TestAccDigitalOceanDatabaseConnectionPool_BadModeName(t *testing.T) {
 	databaseName := acceptance.RandomTestName()
 	databaseConnectionPoolName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -119,7 +126,7 @@ func TestAccDigitalOceanDatabaseConnectionPool_BadModeName(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBad, databaseName, databaseConnectionPoolName),
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBad, databaseName, region, databaseConnectionPoolName),
 				ExpectError: regexp.MustCompile(`expected mode to be one of`),
 			},
 		},
@@ -195,7 +202,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -214,7 +221,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -232,7 +239,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -251,7 +258,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
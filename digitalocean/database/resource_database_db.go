@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -20,7 +19,7 @@ func ResourceDigitalOceanDatabaseDB() *schema.Resource {
 		ReadContext:   resourceDigitalOceanDatabaseDBRead,
 		DeleteContext: resourceDigitalOceanDatabaseDBDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDigitalOceanDatabaseDBImport,
+			StateContext: resourceDigitalOceanDatabaseDBImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,7 +48,11 @@ func resourceDigitalOceanDatabaseDBCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Database DB create configuration: %#v", opts)
-	db, _, err := client.Databases.CreateDB(context.Background(), clusterID, opts)
+	var db *godo.DatabaseDB
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutCreate), clusterID, func() (resp *godo.Response, err error) {
+		db, resp, err = client.Databases.CreateDB(context.Background(), clusterID, opts)
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("Error creating Database DB: %s", err)
 	}
@@ -87,7 +90,9 @@ func resourceDigitalOceanDatabaseDBDelete(ctx context.Context, d *schema.Resourc
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting Database DB: %s", d.Id())
-	_, err := client.Databases.DeleteDB(context.Background(), clusterID, name)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutDelete), clusterID, func() (*godo.Response, error) {
+		return client.Databases.DeleteDB(context.Background(), clusterID, name)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting Database DB: %s", err)
 	}
@@ -96,16 +101,22 @@ func resourceDigitalOceanDatabaseDBDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
-func resourceDigitalOceanDatabaseDBImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if strings.Contains(d.Id(), ",") {
-		s := strings.Split(d.Id(), ",")
-		d.SetId(makeDatabaseDBID(s[0], s[1]))
-		d.Set("cluster_id", s[0])
-		d.Set("name", s[1])
-	} else {
+func resourceDigitalOceanDatabaseDBImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterID, name, ok := splitDatabaseSubresourceImportID(d.Id(), "database")
+	if !ok {
 		return nil, errors.New("must use the ID of the source database cluster and the name of the database joined with a comma (e.g. `id,name`)")
 	}
 
+	client := meta.(*config.CombinedConfig).GodoClient()
+	_, _, err := client.Databases.GetDB(context.Background(), clusterID, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Database DB %q in cluster %s: %s", name, clusterID, err)
+	}
+
+	d.SetId(makeDatabaseDBID(clusterID, name))
+	d.Set("cluster_id", clusterID)
+	d.Set("name", name)
+
 	return []*schema.ResourceData{d}, nil
 }
 
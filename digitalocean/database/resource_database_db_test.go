@@ -16,6 +16,7 @@ import (
 
 func TestAccDigitalOceanDatabaseDB_Basic(t *testing.T) {
 	var databaseDB godo.DatabaseDB
+	region := acceptance.TestAccRegion(t, "database:pg")
 	databaseClusterName := fmt.Sprintf("foobar-test-terraform-%s", acctest.RandString(10))
 	databaseDBName := fmt.Sprintf("foobar-test-db-terraform-%s", acctest.RandString(10))
 	databaseDBNameUpdated := databaseDBName + "-up"
@@ -26,7 +27,7 @@ func TestAccDigitalOceanDatabaseDB_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, databaseDBName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, region, databaseDBName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseDBExists("digitalocean_database_db.foobar_db", &databaseDB),
 					testAccCheckDigitalOceanDatabaseDBAttributes(&databaseDB, databaseDBName),
@@ -35,7 +36,7 @@ func TestAccDigitalOceanDatabaseDB_Basic(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, databaseDBNameUpdated),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, region, databaseDBNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseDBExists("digitalocean_database_db.foobar_db", &databaseDB),
 					testAccCheckDigitalOceanDatabaseDBNotExists("digitalocean_database_db.foobar_db", databaseDBName),
@@ -118,7 +119,7 @@ func testAccCheckDigitalOceanDatabaseDBNotExists(n string, databaseDBName string
 
 		_, resp, err := client.Databases.GetDB(context.Background(), clusterID, databaseDBName)
 
-		if err != nil && resp.StatusCode != http.StatusNotFound {
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
 			return err
 		}
 
@@ -147,7 +148,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 
   maintenance_window {
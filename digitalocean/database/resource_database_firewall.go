@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"log"
+	"net"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -66,6 +67,50 @@ func ResourceDigitalOceanDatabaseFirewall() *schema.Resource {
 					},
 				},
 			},
+
+			"wait_for_propagation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to wait for the rules to be returned by the API, and then for the settle period, after they are changed.",
+			},
+
+			"propagation_settle_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "30s",
+				ValidateFunc: validateDatabaseFirewallDuration,
+				Description:  "How long to wait for the rules to take effect once they are returned by the API, e.g. \"30s\".",
+			},
+
+			"verify_connection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Attempt to connect to the cluster from where Terraform runs after the rules are changed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to connect to the private host of the cluster rather than the public one.",
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "2m",
+							ValidateFunc: validateDatabaseFirewallDuration,
+							Description:  "How long to attempt to connect to the cluster, e.g. \"2m\".",
+						},
+					},
+				},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -83,6 +128,10 @@ func resourceDigitalOceanDatabaseFirewallCreate(ctx context.Context, d *schema.R
 
 	d.SetId(resource.PrefixedUniqueId(clusterID + "-"))
 
+	if err := waitForDatabaseFirewall(ctx, d, client, &net.Dialer{Timeout: 10 * time.Second}, rules.Rules, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error creating DatabaseFirewall: %s", err)
+	}
+
 	return resourceDigitalOceanDatabaseFirewallRead(ctx, d, meta)
 }
 
@@ -111,6 +160,11 @@ func resourceDigitalOceanDatabaseFirewallUpdate(ctx context.Context, d *schema.R
 	client := meta.(*config.CombinedConfig).GodoClient()
 	clusterID := d.Get("cluster_id").(string)
 
+	// The options only apply when the rules are changed.
+	if !d.HasChange("rule") {
+		return resourceDigitalOceanDatabaseFirewallRead(ctx, d, meta)
+	}
+
 	rules := buildDatabaseFirewallRequest(d.Get("rule").(*schema.Set).List())
 
 	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &rules)
@@ -118,6 +172,10 @@ func resourceDigitalOceanDatabaseFirewallUpdate(ctx context.Context, d *schema.R
 		return diag.Errorf("Error updating DatabaseFirewall: %s", err)
 	}
 
+	if err := waitForDatabaseFirewall(ctx, d, client, &net.Dialer{Timeout: 10 * time.Second}, rules.Rules, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("Error updating DatabaseFirewall: %s", err)
+	}
+
 	return resourceDigitalOceanDatabaseFirewallRead(ctx, d, meta)
 }
 
@@ -142,6 +200,8 @@ func resourceDigitalOceanDatabaseFirewallDelete(ctx context.Context, d *schema.R
 func resourceDigitalOceanDatabaseFirewallImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	clusterID := d.Id()
 	d.Set("cluster_id", clusterID)
+	d.Set("wait_for_propagation", false)
+	d.Set("propagation_settle_period", "30s")
 	d.SetId(resource.PrefixedUniqueId(clusterID + "-"))
 
 	return []*schema.ResourceData{d}, nil
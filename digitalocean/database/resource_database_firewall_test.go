@@ -13,6 +13,7 @@ import (
 
 func TestAccDigitalOceanDatabaseFirewall_Basic(t *testing.T) {
 	databaseClusterName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -20,7 +21,7 @@ func TestAccDigitalOceanDatabaseFirewall_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseFirewallDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_firewall.example", "rule.#", "1"),
@@ -28,7 +29,7 @@ func TestAccDigitalOceanDatabaseFirewall_Basic(t *testing.T) {
 			},
 			// Add a new rule
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigAddRule, databaseClusterName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigAddRule, databaseClusterName, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_firewall.example", "rule.#", "2"),
@@ -36,7 +37,7 @@ func TestAccDigitalOceanDatabaseFirewall_Basic(t *testing.T) {
 			},
 			// Remove an existing rule
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigBasic, databaseClusterName, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_firewall.example", "rule.#", "1"),
@@ -51,6 +52,7 @@ func TestAccDigitalOceanDatabaseFirewall_MultipleResourceTypes(t *testing.T) {
 	dropletName := acceptance.RandomTestName()
 	tagName := acceptance.RandomTestName()
 	appName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg", "s-1vcpu-1gb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -59,7 +61,7 @@ func TestAccDigitalOceanDatabaseFirewall_MultipleResourceTypes(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigMultipleResourceTypes,
-					dbName, dropletName, tagName, appName),
+					dbName, region, dropletName, region, tagName, appName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_firewall.example", "rule.#", "4"),
@@ -69,6 +71,30 @@ func TestAccDigitalOceanDatabaseFirewall_MultipleResourceTypes(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseFirewall_WaitForPropagation(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallConfigWaitForPropagation, databaseClusterName, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall.example", "rule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall.example", "wait_for_propagation", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall.example", "propagation_settle_period", "10s"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseFirewallDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -94,7 +120,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -114,7 +140,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -139,7 +165,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -147,7 +173,7 @@ resource "digitalocean_droplet" "foobar" {
   name   = "%s"
   size   = "s-1vcpu-1gb"
   image  = "ubuntu-22-04-x64"
-  region = "nyc3"
+  region = "%s"
 }
 
 resource "digitalocean_tag" "foobar" {
@@ -197,3 +223,26 @@ resource "digitalocean_database_firewall" "example" {
   }
 }
 `
+
+const testAccCheckDigitalOceanDatabaseFirewallConfigWaitForPropagation = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "%s"
+  node_count = 1
+}
+
+resource "digitalocean_database_firewall" "example" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  rule {
+    type  = "ip_addr"
+    value = "192.168.1.1"
+  }
+
+  wait_for_propagation      = true
+  propagation_settle_period = "10s"
+}
+`
@@ -309,7 +309,11 @@ func resourceDigitalOceanDatabaseKafkaTopicCreate(ctx context.Context, d *schema
 	}
 
 	log.Printf("[DEBUG] Database kafka topic create configuration: %#v", opts)
-	topic, _, err := client.Databases.CreateTopic(context.Background(), clusterID, opts)
+	var topic *godo.DatabaseTopic
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutCreate), clusterID, func() (resp *godo.Response, err error) {
+		topic, resp, err = client.Databases.CreateTopic(context.Background(), clusterID, opts)
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("Error creating database kafka topic: %s", err)
 	}
@@ -338,7 +342,9 @@ func resourceDigitalOceanDatabaseKafkaTopicUpdate(ctx context.Context, d *schema
 	}
 
 	log.Printf("[DEBUG] Database kafka topic update configuration: %#v", opts)
-	_, err := client.Databases.UpdateTopic(context.Background(), clusterID, topicName, opts)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutUpdate), clusterID, func() (*godo.Response, error) {
+		return client.Databases.UpdateTopic(context.Background(), clusterID, topicName, opts)
+	})
 	if err != nil {
 		return diag.Errorf("Error updating database kafka topic: %s", err)
 	}
@@ -380,7 +386,9 @@ func resourceDigitalOceanDatabaseKafkaTopicDelete(ctx context.Context, d *schema
 	topicName := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting kafka topic: %s", d.Id())
-	_, err := client.Databases.DeleteTopic(ctx, clusterID, topicName)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutDelete), clusterID, func() (*godo.Response, error) {
+		return client.Databases.DeleteTopic(ctx, clusterID, topicName)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting kafka topic: %s", err)
 	}
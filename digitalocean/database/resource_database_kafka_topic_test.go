@@ -13,7 +13,8 @@ import (
 
 func TestAccDigitalOceanDatabaseKafkaTopic(t *testing.T) {
 	name := acceptance.RandomTestName()
-	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterKafka, name, "3.5")
+	region := acceptance.TestAccRegion(t, "database:kafka")
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterKafka, name, "3.5", region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -10,7 +10,8 @@ import (
 
 func TestAccDigitalOceanDatabaseMySQLConfig_Basic(t *testing.T) {
 	name := acceptance.RandomTestName()
-	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterMySQL, name, "8")
+	region := acceptance.TestAccRegion(t, "database:mysql")
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterMySQL, name, "8", region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
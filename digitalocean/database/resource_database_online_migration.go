@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const databaseOnlineMigrationPath = "/v2/databases/%s/online-migration"
+
+// The version of godo used by the provider does not yet support the online
+// migration endpoints, so the requests are built directly using the client.
+type databaseOnlineMigrationSource struct {
+	Host         string `json:"host,omitempty"`
+	Port         int    `json:"port,omitempty"`
+	DatabaseName string `json:"dbname,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+}
+
+type databaseStartOnlineMigrationRequest struct {
+	Source     *databaseOnlineMigrationSource `json:"source"`
+	DisableSSL bool                           `json:"disable_ssl,omitempty"`
+}
+
+type databaseOnlineMigrationStatus struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+func ResourceDigitalOceanDatabaseOnlineMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseOnlineMigrationCreate,
+		ReadContext:   resourceDigitalOceanDatabaseOnlineMigrationRead,
+		DeleteContext: resourceDigitalOceanDatabaseOnlineMigrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"db_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"disable_ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"migration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseOnlineMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	opts := &databaseStartOnlineMigrationRequest{
+		Source:     expandDatabaseOnlineMigrationSource(d.Get("source").([]interface{})),
+		DisableSSL: d.Get("disable_ssl").(bool),
+	}
+
+	log.Printf("[DEBUG] Starting online migration for database cluster: %s", clusterID)
+	migration, _, err := startDatabaseOnlineMigration(client, clusterID, opts)
+	if err != nil {
+		// The API rejects the request if a migration is already in progress.
+		return diag.Errorf("Error starting online migration for database cluster %s: %s", clusterID, err)
+	}
+
+	d.SetId(makeDatabaseOnlineMigrationID(clusterID, migration.ID))
+	log.Printf("[INFO] Database online migration ID: %s", migration.ID)
+
+	return resourceDigitalOceanDatabaseOnlineMigrationRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseOnlineMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	migrationID := strings.TrimPrefix(d.Id(), clusterID+"/online-migration/")
+
+	migration, resp, err := getDatabaseOnlineMigrationStatus(client, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Online migration for database cluster (%s) not found", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving online migration status: %s", err)
+	}
+
+	// A different ID means the migration tracked by this resource has been
+	// replaced by one started elsewhere.
+	if migration.ID != migrationID {
+		log.Printf("[WARN] Online migration %s for database cluster (%s) not found", migrationID, clusterID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("migration_id", migration.ID)
+	d.Set("status", migration.Status)
+	d.Set("created_at", migration.CreatedAt)
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseOnlineMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	migrationID := d.Get("migration_id").(string)
+
+	log.Printf("[INFO] Stopping online migration: %s", d.Id())
+	resp, err := stopDatabaseOnlineMigration(client, clusterID, migrationID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error stopping online migration: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandDatabaseOnlineMigrationSource(config []interface{}) *databaseOnlineMigrationSource {
+	source := config[0].(map[string]interface{})
+
+	return &databaseOnlineMigrationSource{
+		Host:         source["host"].(string),
+		Port:         source["port"].(int),
+		DatabaseName: source["db_name"].(string),
+		Username:     source["username"].(string),
+		Password:     source["password"].(string),
+	}
+}
+
+func startDatabaseOnlineMigration(client *godo.Client, clusterID string, opts *databaseStartOnlineMigrationRequest) (*databaseOnlineMigrationStatus, *godo.Response, error) {
+	path := fmt.Sprintf(databaseOnlineMigrationPath, clusterID)
+	req, err := client.NewRequest(context.Background(), http.MethodPut, path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migration := new(databaseOnlineMigrationStatus)
+	resp, err := client.Do(context.Background(), req, migration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return migration, resp, nil
+}
+
+func getDatabaseOnlineMigrationStatus(client *godo.Client, clusterID string) (*databaseOnlineMigrationStatus, *godo.Response, error) {
+	path := fmt.Sprintf(databaseOnlineMigrationPath, clusterID)
+	req, err := client.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migration := new(databaseOnlineMigrationStatus)
+	resp, err := client.Do(context.Background(), req, migration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return migration, resp, nil
+}
+
+func stopDatabaseOnlineMigration(client *godo.Client, clusterID string, migrationID string) (*godo.Response, error) {
+	path := fmt.Sprintf(databaseOnlineMigrationPath+"/%s", clusterID, migrationID)
+	req, err := client.NewRequest(context.Background(), http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(context.Background(), req, nil)
+}
+
+func makeDatabaseOnlineMigrationID(clusterID string, migrationID string) string {
+	return fmt.Sprintf("%s/online-migration/%s", clusterID, migrationID)
+}
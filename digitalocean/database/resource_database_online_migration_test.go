@@ -0,0 +1,67 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanDatabaseOnlineMigration_Basic(t *testing.T) {
+	sourceName := acceptance.RandomTestName("source")
+	targetName := acceptance.RandomTestName("target")
+	region := acceptance.TestAccRegion(t, "database:pg")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseOnlineMigrationConfigBasic, sourceName, region, targetName, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_online_migration.foobar", "cluster_id", "digitalocean_database_cluster.target", "id"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_online_migration.foobar", "migration_id"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_online_migration.foobar", "status"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_online_migration.foobar", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseOnlineMigrationConfigBasic = `
+resource "digitalocean_database_cluster" "source" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "%s"
+  node_count = 1
+}
+
+resource "digitalocean_database_cluster" "target" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "%s"
+  node_count = 1
+}
+
+resource "digitalocean_database_online_migration" "foobar" {
+  cluster_id = digitalocean_database_cluster.target.id
+
+  source {
+    host     = digitalocean_database_cluster.source.host
+    port     = digitalocean_database_cluster.source.port
+    db_name  = digitalocean_database_cluster.source.database
+    username = digitalocean_database_cluster.source.user
+    password = digitalocean_database_cluster.source.password
+  }
+}`
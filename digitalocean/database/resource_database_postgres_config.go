@@ -319,8 +319,17 @@ func ResourceDigitalOceanDatabasePostgreSQLConfig() *schema.Resource {
 							Computed: true,
 						},
 						"ignore_startup_parameters": {
-							Type:     schema.TypeSet,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice(
+									[]string{
+										"extra_float_digits",
+										"search_path",
+									},
+									false,
+								),
+							},
 							Optional: true,
 							Computed: true,
 						},
@@ -348,6 +357,14 @@ func ResourceDigitalOceanDatabasePostgreSQLConfig() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									"session",
+									"transaction",
+									"statement",
+								},
+								false,
+							),
 						},
 						"autodb_max_db_connections": {
 							Type:     schema.TypeInt,
@@ -607,8 +624,8 @@ func updatePostgreSQLConfig(ctx context.Context, d *schema.ResourceData, client
 		opts.SharedBuffersPercentage = godo.PtrTo(float32(v.(float64)))
 	}
 
-	if v, ok := d.GetOk("pgbouncer"); ok {
-		opts.PgBouncer = expandPgBouncer(v.([]interface{}))
+	if _, ok := d.GetOk("pgbouncer"); ok {
+		opts.PgBouncer = expandPgBouncer(d)
 	}
 
 	if v, ok := d.GetOk("backup_hour"); ok {
@@ -700,7 +717,7 @@ func resourceDigitalOceanDatabasePostgreSQLConfigRead(ctx context.Context, d *sc
 	d.Set("backup_minute", config.BackupMinute)
 	d.Set("work_mem", config.WorkMem)
 
-	if _, ok := d.GetOk("pgbouncer"); ok {
+	if config.PgBouncer != nil {
 		if err := d.Set("pgbouncer", flattenPGBouncerOpts(*config.PgBouncer)); err != nil {
 			return diag.Errorf("[DEBUG] Error setting pgbouncer - error: %#v", err)
 		}
@@ -742,19 +759,49 @@ func makeDatabasePostgreSQLConfigID(clusterID string) string {
 	return fmt.Sprintf("%s/postgresql-config", clusterID)
 }
 
-func expandPgBouncer(config []interface{}) *godo.PostgreSQLBouncerConfig {
-	configMap := config[0].(map[string]interface{})
+// expandPgBouncer only includes the settings present in the configuration as
+// the API would otherwise reset any omitted ones to their zero value.
+func expandPgBouncer(d *schema.ResourceData) *godo.PostgreSQLBouncerConfig {
+	pgBouncerConfig := &godo.PostgreSQLBouncerConfig{}
+
+	if v, ok := d.GetOkExists("pgbouncer.0.server_reset_query_always"); ok {
+		pgBouncerConfig.ServerResetQueryAlways = godo.PtrTo(v.(bool))
+	}
+
+	if v, ok := d.GetOk("pgbouncer.0.ignore_startup_parameters"); ok {
+		params := make([]string, 0, v.(*schema.Set).Len())
+		for _, p := range v.(*schema.Set).List() {
+			params = append(params, p.(string))
+		}
+		pgBouncerConfig.IgnoreStartupParameters = godo.PtrTo(params)
+	}
+
+	if v, ok := d.GetOkExists("pgbouncer.0.min_pool_size"); ok {
+		pgBouncerConfig.MinPoolSize = godo.PtrTo(v.(int))
+	}
+
+	if v, ok := d.GetOk("pgbouncer.0.server_lifetime"); ok {
+		pgBouncerConfig.ServerLifetime = godo.PtrTo(v.(int))
+	}
 
-	pgBouncerConfig := &godo.PostgreSQLBouncerConfig{
-		ServerResetQueryAlways:  godo.PtrTo(configMap["server_reset_query_always"].(bool)),
-		IgnoreStartupParameters: godo.PtrTo(configMap["ignore_startup_parameters"].([]string)),
-		MinPoolSize:             godo.PtrTo(configMap["min_pool_size"].(int)),
-		ServerLifetime:          godo.PtrTo(configMap["server_lifetime"].(int)),
-		ServerIdleTimeout:       godo.PtrTo(configMap["server_idle_timeout"].(int)),
-		AutodbPoolSize:          godo.PtrTo(configMap["autodb_pool_size"].(int)),
-		AutodbPoolMode:          godo.PtrTo(configMap["autodb_pool_mode"].(string)),
-		AutodbMaxDbConnections:  godo.PtrTo(configMap["autodb_max_db_connections"].(int)),
-		AutodbIdleTimeout:       godo.PtrTo(configMap["autodb_idle_timeout"].(int)),
+	if v, ok := d.GetOkExists("pgbouncer.0.server_idle_timeout"); ok {
+		pgBouncerConfig.ServerIdleTimeout = godo.PtrTo(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("pgbouncer.0.autodb_pool_size"); ok {
+		pgBouncerConfig.AutodbPoolSize = godo.PtrTo(v.(int))
+	}
+
+	if v, ok := d.GetOk("pgbouncer.0.autodb_pool_mode"); ok {
+		pgBouncerConfig.AutodbPoolMode = godo.PtrTo(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("pgbouncer.0.autodb_max_db_connections"); ok {
+		pgBouncerConfig.AutodbMaxDbConnections = godo.PtrTo(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("pgbouncer.0.autodb_idle_timeout"); ok {
+		pgBouncerConfig.AutodbIdleTimeout = godo.PtrTo(v.(int))
 	}
 
 	return pgBouncerConfig
@@ -775,7 +822,6 @@ func flattenPGBouncerOpts(opts godo.PostgreSQLBouncerConfig) []map[string]interf
 	item := make(map[string]interface{})
 
 	item["server_reset_query_always"] = opts.ServerResetQueryAlways
-	item["ignore_startup_parameters"] = opts.IgnoreStartupParameters
 	item["min_pool_size"] = opts.MinPoolSize
 	item["server_lifetime"] = opts.ServerLifetime
 	item["server_idle_timeout"] = opts.ServerIdleTimeout
@@ -784,6 +830,14 @@ func flattenPGBouncerOpts(opts godo.PostgreSQLBouncerConfig) []map[string]interf
 	item["autodb_max_db_connections"] = opts.AutodbMaxDbConnections
 	item["autodb_idle_timeout"] = opts.AutodbIdleTimeout
 
+	params := make([]interface{}, 0)
+	if opts.IgnoreStartupParameters != nil {
+		for _, p := range *opts.IgnoreStartupParameters {
+			params = append(params, p)
+		}
+	}
+	item["ignore_startup_parameters"] = params
+
 	result = append(result, item)
 
 	return result
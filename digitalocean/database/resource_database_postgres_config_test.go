@@ -10,7 +10,8 @@ import (
 
 func TestAccDigitalOceanDatabasePostgreSQLConfig_Basic(t *testing.T) {
 	name := acceptance.RandomTestName()
-	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterPostgreSQL, name, "15")
+	region := acceptance.TestAccRegion(t, "database:pg")
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterPostgreSQL, name, "15", region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -24,6 +25,10 @@ func TestAccDigitalOceanDatabasePostgreSQLConfig_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "timezone", "UTC"),
 					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "shared_buffers_percentage", "30.5"),
 					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "work_mem", "32"),
+					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "pgbouncer.0.server_reset_query_always", "true"),
+					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "pgbouncer.0.ignore_startup_parameters.#", "2"),
+					resource.TestCheckTypeSetElemAttr("digitalocean_database_postgresql_config.foobar", "pgbouncer.0.ignore_startup_parameters.*", "search_path"),
+					resource.TestCheckResourceAttr("digitalocean_database_postgresql_config.foobar", "pgbouncer.0.autodb_pool_mode", "transaction"),
 				),
 			},
 			{
@@ -51,4 +56,9 @@ resource "digitalocean_database_postgresql_config" "foobar" {
   timescaledb {
     max_background_workers = 1
   }
+  pgbouncer {
+    server_reset_query_always = true
+    ignore_startup_parameters = ["search_path", "extra_float_digits"]
+    autodb_pool_mode          = "transaction"
+  }
 }`
@@ -21,6 +21,7 @@ func ResourceDigitalOceanDatabaseRedisConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDigitalOceanDatabaseRedisConfigImport,
 		},
+		CustomizeDiff: validateRedisConfigClusterEngine,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:         schema.TypeString,
@@ -36,33 +37,38 @@ func ResourceDigitalOceanDatabaseRedisConfig() *schema.Resource {
 			},
 
 			"pubsub_client_output_buffer_limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(32, 512),
 			},
 
 			"number_of_databases": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 128),
 			},
 
 			"io_threads": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
 			},
 
 			"lfu_log_factor": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
 			},
 
 			"lfu_decay_time": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 120),
 			},
 
 			"ssl": {
@@ -72,9 +78,10 @@ func ResourceDigitalOceanDatabaseRedisConfig() *schema.Resource {
 			},
 
 			"timeout": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 31536000),
 			},
 
 			"notify_keyspace_events": {
@@ -139,6 +146,20 @@ func resourceDigitalOceanDatabaseRedisConfigUpdate(ctx context.Context, d *schem
 func updateRedisConfig(ctx context.Context, d *schema.ResourceData, client *godo.Client) error {
 	clusterID := d.Get("cluster_id").(string)
 
+	opts := expandRedisConfig(d)
+
+	log.Printf("[DEBUG] Redis configuration: %s", godo.Stringify(opts))
+	_, err := client.Databases.UpdateRedisConfig(ctx, clusterID, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// expandRedisConfig only includes the settings present in the configuration.
+// GetOkExists is used where zero is a valid value.
+func expandRedisConfig(d *schema.ResourceData) *godo.RedisConfig {
 	opts := &godo.RedisConfig{}
 
 	if v, ok := d.GetOk("maxmemory_policy"); ok {
@@ -157,7 +178,7 @@ func updateRedisConfig(ctx context.Context, d *schema.ResourceData, client *godo
 		opts.RedisIOThreads = godo.PtrTo(v.(int))
 	}
 
-	if v, ok := d.GetOk("lfu_log_factor"); ok {
+	if v, ok := d.GetOkExists("lfu_log_factor"); ok {
 		opts.RedisLFULogFactor = godo.PtrTo(v.(int))
 	}
 
@@ -185,10 +206,30 @@ func updateRedisConfig(ctx context.Context, d *schema.ResourceData, client *godo
 		opts.RedisACLChannelsDefault = godo.PtrTo(v.(string))
 	}
 
-	log.Printf("[DEBUG] Redis configuration: %s", godo.Stringify(opts))
-	_, err := client.Databases.UpdateRedisConfig(ctx, clusterID, opts)
+	return opts
+}
+
+// validateRedisConfigClusterEngine ensures the configuration is only applied to
+// Redis or Valkey clusters, which share the same settings.
+func validateRedisConfigClusterEngine(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	clusterID := diff.Get("cluster_id").(string)
+	if clusterID == "" || !diff.NewValueKnown("cluster_id") || !diff.HasChange("cluster_id") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	cluster, resp, err := client.Databases.Get(context.Background(), clusterID)
 	if err != nil {
-		return err
+		// Leave a missing cluster to be reported on apply.
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving database cluster (%s): %s", clusterID, err)
+	}
+
+	if cluster.EngineSlug != redisDBEngineSlug && cluster.EngineSlug != valkeyDBEngineSlug {
+		return fmt.Errorf("digitalocean_database_redis_config can only be used with Redis or Valkey database clusters, cluster %s uses %s", clusterID, cluster.EngineSlug)
 	}
 
 	return nil
@@ -208,6 +249,12 @@ func resourceDigitalOceanDatabaseRedisConfigRead(ctx context.Context, d *schema.
 		return diag.Errorf("Error retrieving Redis configuration: %s", err)
 	}
 
+	setRedisConfigAttributes(d, config)
+
+	return nil
+}
+
+func setRedisConfigAttributes(d *schema.ResourceData, config *godo.RedisConfig) {
 	d.Set("maxmemory_policy", config.RedisMaxmemoryPolicy)
 	d.Set("pubsub_client_output_buffer_limit", config.RedisPubsubClientOutputBufferLimit)
 	d.Set("number_of_databases", config.RedisNumberOfDatabases)
@@ -219,8 +266,6 @@ func resourceDigitalOceanDatabaseRedisConfigRead(ctx context.Context, d *schema.
 	d.Set("notify_keyspace_events", config.RedisNotifyKeyspaceEvents)
 	d.Set("persistence", config.RedisPersistence)
 	d.Set("acl_channels_default", config.RedisACLChannelsDefault)
-
-	return nil
 }
 
 func resourceDigitalOceanDatabaseRedisConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
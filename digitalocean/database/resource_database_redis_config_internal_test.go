@@ -0,0 +1,81 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandRedisConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseRedisConfig().Schema, map[string]interface{}{
+		"cluster_id":           "245bcfd0",
+		"io_threads":           2,
+		"lfu_log_factor":       0,
+		"lfu_decay_time":       5,
+		"number_of_databases":  32,
+		"acl_channels_default": "resetchannels",
+		"timeout":              0,
+	})
+
+	opts := expandRedisConfig(d)
+
+	if opts.RedisIOThreads == nil || *opts.RedisIOThreads != 2 {
+		t.Errorf("expected io_threads 2, got %v", opts.RedisIOThreads)
+	}
+	if opts.RedisLFULogFactor == nil || *opts.RedisLFULogFactor != 0 {
+		t.Errorf("expected an explicit lfu_log_factor of 0, got %v", opts.RedisLFULogFactor)
+	}
+	if opts.RedisLFUDecayTime == nil || *opts.RedisLFUDecayTime != 5 {
+		t.Errorf("expected lfu_decay_time 5, got %v", opts.RedisLFUDecayTime)
+	}
+	if opts.RedisNumberOfDatabases == nil || *opts.RedisNumberOfDatabases != 32 {
+		t.Errorf("expected number_of_databases 32, got %v", opts.RedisNumberOfDatabases)
+	}
+	if opts.RedisACLChannelsDefault == nil || *opts.RedisACLChannelsDefault != "resetchannels" {
+		t.Errorf("expected acl_channels_default resetchannels, got %v", opts.RedisACLChannelsDefault)
+	}
+	if opts.RedisTimeout == nil || *opts.RedisTimeout != 0 {
+		t.Errorf("expected an explicit timeout of 0, got %v", opts.RedisTimeout)
+	}
+
+	// Settings absent from the configuration must not be sent.
+	if opts.RedisPubsubClientOutputBufferLimit != nil {
+		t.Errorf("expected pubsub_client_output_buffer_limit to be omitted, got %d", *opts.RedisPubsubClientOutputBufferLimit)
+	}
+	if opts.RedisMaxmemoryPolicy != nil {
+		t.Errorf("expected maxmemory_policy to be omitted, got %s", *opts.RedisMaxmemoryPolicy)
+	}
+	if opts.RedisSSL != nil {
+		t.Errorf("expected ssl to be omitted, got %t", *opts.RedisSSL)
+	}
+}
+
+func TestSetRedisConfigAttributes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseRedisConfig().Schema, map[string]interface{}{
+		"cluster_id": "245bcfd0",
+	})
+
+	setRedisConfigAttributes(d, &godo.RedisConfig{
+		RedisIOThreads:          godo.PtrTo(4),
+		RedisLFULogFactor:       godo.PtrTo(0),
+		RedisLFUDecayTime:       godo.PtrTo(1),
+		RedisNumberOfDatabases:  godo.PtrTo(16),
+		RedisACLChannelsDefault: godo.PtrTo("allchannels"),
+	})
+
+	expected := map[string]interface{}{
+		"io_threads":           4,
+		"lfu_log_factor":       0,
+		"lfu_decay_time":       1,
+		"number_of_databases":  16,
+		"acl_channels_default": "allchannels",
+		"maxmemory_policy":     "",
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: expected %v, got %v", k, v, got)
+		}
+	}
+}
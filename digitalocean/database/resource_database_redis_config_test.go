@@ -10,7 +10,8 @@ import (
 
 func TestAccDigitalOceanDatabaseRedisConfig_Basic(t *testing.T) {
 	name := acceptance.RandomTestName()
-	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, name, "7")
+	region := acceptance.TestAccRegion(t, "database:redis")
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterRedis, name, "7", region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -30,6 +31,14 @@ func TestAccDigitalOceanDatabaseRedisConfig_Basic(t *testing.T) {
 						"digitalocean_database_redis_config.foobar", "ssl", "true"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_redis_config.foobar", "persistence", "rdb"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_redis_config.foobar", "io_threads", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_redis_config.foobar", "lfu_log_factor", "0"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_redis_config.foobar", "lfu_decay_time", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_redis_config.foobar", "acl_channels_default", "resetchannels"),
 				),
 			},
 			{
@@ -59,4 +68,8 @@ resource "digitalocean_database_redis_config" "foobar" {
   maxmemory_policy       = "%s"
   timeout                = %d
   notify_keyspace_events = "%s"
+  io_threads             = 1
+  lfu_log_factor         = 0
+  lfu_decay_time         = 2
+  acl_channels_default   = "resetchannels"
 }`
@@ -14,7 +14,6 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -161,22 +160,13 @@ func resourceDigitalOceanDatabaseReplicaCreate(ctx context.Context, d *schema.Re
 
 	// Retry requests that fail w. Failed Precondition (412). New DBs can be marked ready while
 	// first backup is still being created.
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		rc, resp, err := client.Databases.CreateReplica(context.Background(), clusterId, opts)
-		if err != nil {
-			if resp.StatusCode == 412 {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(fmt.Errorf("Error creating DatabaseReplica: %s", err))
-			}
-		}
-		replicaCluster = rc
-
-		return nil
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutCreate), clusterId, func() (resp *godo.Response, err error) {
+		replicaCluster, resp, err = client.Databases.CreateReplica(context.Background(), clusterId, opts)
+		return resp, err
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.Errorf("Error creating DatabaseReplica: %s", err)
 	}
 
 	err = setReplicaConnectionInfo(replicaCluster, d)
@@ -288,7 +278,12 @@ func resourceDigitalOceanDatabaseReplicaUpdate(ctx context.Context, d *schema.Re
 			}
 		}
 
-		resp, err := client.Databases.Resize(context.Background(), replicaID, opts)
+		var resp *godo.Response
+		err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutUpdate), clusterID, func() (*godo.Response, error) {
+			var err error
+			resp, err = client.Databases.Resize(context.Background(), replicaID, opts)
+			return resp, err
+		})
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				d.SetId("")
@@ -326,7 +321,9 @@ func resourceDigitalOceanDatabaseReplicaDelete(ctx context.Context, d *schema.Re
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting DatabaseReplica: %s", d.Id())
-	_, err := client.Databases.DeleteReplica(context.Background(), clusterId, name)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutDelete), clusterId, func() (*godo.Response, error) {
+		return client.Databases.DeleteReplica(context.Background(), clusterId, name)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting DatabaseReplica: %s", err)
 	}
@@ -15,12 +15,13 @@ import (
 func TestAccDigitalOceanDatabaseReplica_Basic(t *testing.T) {
 	var databaseReplica godo.DatabaseReplica
 	var database godo.Database
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	databaseName := acceptance.RandomTestName()
 	databaseReplicaName := acceptance.RandomTestName()
 
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
-	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName, region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -41,7 +42,7 @@ func TestAccDigitalOceanDatabaseReplica_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_replica.read-01", "size", "db-s-1vcpu-2gb"),
 					resource.TestCheckResourceAttr(
-						"digitalocean_database_replica.read-01", "region", "nyc3"),
+						"digitalocean_database_replica.read-01", "region", region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_replica.read-01", "name", databaseReplicaName),
 					resource.TestCheckResourceAttrSet(
@@ -73,13 +74,14 @@ func TestAccDigitalOceanDatabaseReplica_Basic(t *testing.T) {
 func TestAccDigitalOceanDatabaseReplica_WithVPC(t *testing.T) {
 	var database godo.Database
 	var databaseReplica godo.DatabaseReplica
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	vpcName := acceptance.RandomTestName()
 	databaseName := acceptance.RandomTestName()
 	databaseReplicaName := acceptance.RandomTestName()
 
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVPC, vpcName, databaseName)
-	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigWithVPC, databaseReplicaName)
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVPC, vpcName, region, databaseName, region)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigWithVPC, databaseReplicaName, region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -108,13 +110,14 @@ func TestAccDigitalOceanDatabaseReplica_WithVPC(t *testing.T) {
 func TestAccDigitalOceanDatabaseReplica_Resize(t *testing.T) {
 	var databaseReplica godo.DatabaseReplica
 	var database godo.Database
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	databaseName := acceptance.RandomTestName()
 	databaseReplicaName := acceptance.RandomTestName()
 
-	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
-	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
-	resizedConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigResized, databaseReplicaName)
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName, region)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName, region)
+	resizedConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigResized, databaseReplicaName, region)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -233,7 +236,7 @@ const testAccCheckDigitalOceanDatabaseReplicaConfigBasic = `
 resource "digitalocean_database_replica" "read-01" {
   cluster_id = digitalocean_database_cluster.foobar.id
   name       = "%s"
-  region     = "nyc3"
+  region     = "%s"
   size       = "db-s-1vcpu-2gb"
   tags       = ["staging"]
 }`
@@ -242,7 +245,7 @@ const testAccCheckDigitalOceanDatabaseReplicaConfigResized = `
 resource "digitalocean_database_replica" "read-01" {
   cluster_id       = digitalocean_database_cluster.foobar.id
   name             = "%s"
-  region           = "nyc3"
+  region           = "%s"
   size             = "db-s-2vcpu-4gb"
   storage_size_mib = 61440
   tags             = ["staging"]
@@ -254,7 +257,7 @@ const testAccCheckDigitalOceanDatabaseReplicaConfigWithVPC = `
 resource "digitalocean_database_replica" "read-01" {
   cluster_id           = digitalocean_database_cluster.foobar.id
   name                 = "%s"
-  region               = "nyc1"
+  region               = "%s"
   size                 = "db-s-1vcpu-2gb"
   tags                 = ["staging"]
   private_network_uuid = digitalocean_vpc.foobar.id
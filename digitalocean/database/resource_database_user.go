@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/digitalocean/godo"
@@ -17,12 +18,51 @@ import (
 
 var mutexKV = mutexkv.NewMutexKV()
 
+const (
+	databaseUsersPath = "/v2/databases/%s/users"
+	kafkaDBEngineSlug = "kafka"
+)
+
+// The version of godo used by the provider does not yet support schema
+// registry ACLs, so database users are created, updated, and read by the data
+// source with requests built directly using the client.
+type databaseUserSettings struct {
+	ACL               []*godo.KafkaACL     `json:"acl,omitempty"`
+	SchemaRegistryACL []*schemaRegistryACL `json:"schema_registry_acl,omitempty"`
+}
+
+type schemaRegistryACL struct {
+	ID         string `json:"id,omitempty"`
+	Permission string `json:"permission,omitempty"`
+	Resource   string `json:"resource,omitempty"`
+}
+
+type databaseCreateUserRequest struct {
+	Name          string                          `json:"name"`
+	MySQLSettings *godo.DatabaseMySQLUserSettings `json:"mysql_settings,omitempty"`
+	Settings      *databaseUserSettings           `json:"settings,omitempty"`
+}
+
+type databaseUpdateUserRequest struct {
+	Settings *databaseUserSettings `json:"settings,omitempty"`
+}
+
+type databaseUser struct {
+	godo.DatabaseUser
+	Settings *databaseUserSettings `json:"settings,omitempty"`
+}
+
+type databaseUserRoot struct {
+	User *databaseUser `json:"user"`
+}
+
 func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDatabaseUserCreate,
 		ReadContext:   resourceDigitalOceanDatabaseUserRead,
 		UpdateContext: resourceDigitalOceanDatabaseUserUpdate,
 		DeleteContext: resourceDigitalOceanDatabaseUserDelete,
+		CustomizeDiff: validateDatabaseUserSettingsClusterEngine,
 		Importer: &schema.ResourceImporter{
 			State: resourceDigitalOceanDatabaseUserImport,
 		},
@@ -62,6 +102,11 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 							Optional: true,
 							Elem:     userACLSchema(),
 						},
+						"schema_registry_acl": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     userSchemaRegistryACLSchema(),
+						},
 					},
 				},
 			},
@@ -74,6 +119,13 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			// The API always generates passwords itself, so rotation is
+			// triggered by changing an arbitrary value rather than by
+			// supplying the new password.
+			"password_rotation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"access_cert": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -114,11 +166,36 @@ func userACLSchema() *schema.Resource {
 	}
 }
 
+func userSchemaRegistryACLSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The schema registry subject prefix the ACL applies to.",
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"schema_registry_read",
+					"schema_registry_write",
+				}, false),
+			},
+		},
+	}
+}
+
 func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 	clusterID := d.Get("cluster_id").(string)
 
-	opts := &godo.DatabaseCreateUserRequest{
+	opts := &databaseCreateUserRequest{
 		Name: d.Get("name").(string),
 	}
 
@@ -138,7 +215,11 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 	defer mutexKV.Unlock(key)
 
 	log.Printf("[DEBUG] Database User create configuration: %#v", opts)
-	user, _, err := client.Databases.CreateUser(context.Background(), clusterID, opts)
+	var user *databaseUser
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutCreate), clusterID, func() (resp *godo.Response, err error) {
+		user, resp, err = createDatabaseUser(client, clusterID, opts)
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("Error creating Database User: %s", err)
 	}
@@ -151,7 +232,40 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 		return diag.Errorf("Error setting user settings: %#v", err)
 	}
 
-	setDatabaseUserAttributes(d, user)
+	setDatabaseUserAttributes(d, &user.DatabaseUser)
+
+	return nil
+}
+
+// validateDatabaseUserSettingsClusterEngine rejects ACLs for users of
+// clusters that are not running Kafka. The check is skipped when the cluster
+// is not known until apply.
+func validateDatabaseUserSettingsClusterEngine(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	clusterID := diff.Get("cluster_id").(string)
+	if clusterID == "" || !diff.NewValueKnown("cluster_id") || !diff.HasChange("settings") {
+		return nil
+	}
+
+	hasACLs := len(diff.Get("settings.0.acl").([]interface{})) > 0
+	hasSchemaRegistryACLs := diff.Get("settings.0.schema_registry_acl").(*schema.Set).Len() > 0
+	if !hasACLs && !hasSchemaRegistryACLs {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	cluster, resp, err := client.Databases.Get(context.Background(), clusterID)
+	if err != nil {
+		// Leave a missing cluster to be reported on apply.
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving database cluster (%s): %s", clusterID, err)
+	}
+
+	if cluster.EngineSlug != kafkaDBEngineSlug {
+		return fmt.Errorf("settings.acl and settings.schema_registry_acl can only be used with Kafka database clusters, cluster %s uses %s", clusterID, cluster.EngineSlug)
+	}
 
 	return nil
 }
@@ -208,34 +322,59 @@ func setDatabaseUserAttributes(d *schema.ResourceData, user *godo.DatabaseUser)
 
 func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
 
-	if d.HasChange("mysql_auth_plugin") {
+	if d.HasChanges("mysql_auth_plugin", "password_rotation") {
 		authReq := &godo.DatabaseResetUserAuthRequest{}
 		if d.Get("mysql_auth_plugin").(string) != "" {
+			// Always send the current plugin for MySQL users so resetting
+			// the password does not change how the user authenticates.
 			authReq.MySQLSettings = &godo.DatabaseMySQLUserSettings{
 				AuthPlugin: d.Get("mysql_auth_plugin").(string),
 			}
-		} else {
+		} else if d.HasChange("mysql_auth_plugin") {
 			// If blank, restore default value.
 			authReq.MySQLSettings = &godo.DatabaseMySQLUserSettings{
 				AuthPlugin: godo.SQLAuthPluginCachingSHA2,
 			}
 		}
 
-		_, _, err := client.Databases.ResetUserAuth(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), authReq)
+		var user *godo.DatabaseUser
+		err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutUpdate), clusterID, func() (resp *godo.Response, err error) {
+			user, resp, err = client.Databases.ResetUserAuth(context.Background(), clusterID, name, authReq)
+			return resp, err
+		})
 		if err != nil {
+			if d.HasChange("password_rotation") {
+				return diag.Errorf("Error resetting password for DatabaseUser: %s", err)
+			}
 			return diag.Errorf("Error updating mysql_auth_plugin for DatabaseUser: %s", err)
 		}
+
+		setDatabaseUserAttributes(d, user)
 	}
 	if d.HasChange("settings") {
-		updateReq := &godo.DatabaseUpdateUserRequest{}
+		updateReq := &databaseUpdateUserRequest{}
 		if v, ok := d.GetOk("settings"); ok {
 			updateReq.Settings = expandUserSettings(v.([]interface{}))
 		}
-		_, _, err := client.Databases.UpdateUser(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), updateReq)
+		var user *databaseUser
+		err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutUpdate), clusterID, func() (resp *godo.Response, err error) {
+			user, resp, err = updateDatabaseUser(client, clusterID, name, updateReq)
+			return resp, err
+		})
 		if err != nil {
 			return diag.Errorf("Error updating settings for DatabaseUser: %s", err)
 		}
+
+		// As on create, the update response is the only place the settings
+		// and the IDs of their ACLs are returned.
+		if user.Settings != nil {
+			if err := d.Set("settings", flattenUserSettings(user.Settings)); err != nil {
+				return diag.Errorf("Error setting user settings: %#v", err)
+			}
+		}
 	}
 
 	return resourceDigitalOceanDatabaseUserRead(ctx, d, meta)
@@ -252,7 +391,9 @@ func resourceDigitalOceanDatabaseUserDelete(ctx context.Context, d *schema.Resou
 	defer mutexKV.Unlock(key)
 
 	log.Printf("[INFO] Deleting Database User: %s", d.Id())
-	_, err := client.Databases.DeleteUser(context.Background(), clusterID, name)
+	err := retryOnDatabaseMaintenance(ctx, d.Timeout(schema.TimeoutDelete), clusterID, func() (*godo.Response, error) {
+		return client.Databases.DeleteUser(context.Background(), clusterID, name)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting Database User: %s", err)
 	}
@@ -262,15 +403,15 @@ func resourceDigitalOceanDatabaseUserDelete(ctx context.Context, d *schema.Resou
 }
 
 func resourceDigitalOceanDatabaseUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if strings.Contains(d.Id(), ",") {
-		s := strings.Split(d.Id(), ",")
-		d.SetId(makeDatabaseUserID(s[0], s[1]))
-		d.Set("cluster_id", s[0])
-		d.Set("name", s[1])
-	} else {
+	clusterID, name, ok := splitDatabaseSubresourceImportID(d.Id(), "user")
+	if !ok {
 		return nil, errors.New("must use the ID of the source database cluster and the name of the user joined with a comma (e.g. `id,name`)")
 	}
 
+	d.SetId(makeDatabaseUserID(clusterID, name))
+	d.Set("cluster_id", clusterID)
+	d.Set("name", name)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -278,18 +419,80 @@ func makeDatabaseUserID(clusterID string, name string) string {
 	return fmt.Sprintf("%s/user/%s", clusterID, name)
 }
 
-func expandUserSettings(raw []interface{}) *godo.DatabaseUserSettings {
+func createDatabaseUser(client *godo.Client, clusterID string, opts *databaseCreateUserRequest) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUsersPath, clusterID)
+	req, err := client.NewRequest(context.Background(), http.MethodPost, path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+func getDatabaseUser(client *godo.Client, clusterID string, name string) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUsersPath+"/%s", clusterID, name)
+	req, err := client.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+func updateDatabaseUser(client *godo.Client, clusterID string, name string, opts *databaseUpdateUserRequest) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUsersPath+"/%s", clusterID, name)
+	req, err := client.NewRequest(context.Background(), http.MethodPut, path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+func expandUserSettings(raw []interface{}) *databaseUserSettings {
 	if len(raw) == 0 || raw[0] == nil {
-		return &godo.DatabaseUserSettings{}
+		return &databaseUserSettings{}
 	}
 	userSettingsConfig := raw[0].(map[string]interface{})
 
-	userSettings := &godo.DatabaseUserSettings{
-		ACL: expandUserACLs(userSettingsConfig["acl"].([]interface{})),
+	userSettings := &databaseUserSettings{
+		ACL:               expandUserACLs(userSettingsConfig["acl"].([]interface{})),
+		SchemaRegistryACL: expandUserSchemaRegistryACLs(userSettingsConfig["schema_registry_acl"].(*schema.Set).List()),
 	}
 	return userSettings
 }
 
+func expandUserSchemaRegistryACLs(rawACLs []interface{}) []*schemaRegistryACL {
+	acls := make([]*schemaRegistryACL, 0, len(rawACLs))
+	for _, rawACL := range rawACLs {
+		a := rawACL.(map[string]interface{})
+		acl := &schemaRegistryACL{
+			Resource:   a["resource"].(string),
+			Permission: a["permission"].(string),
+		}
+		acls = append(acls, acl)
+	}
+	return acls
+}
+
 func expandUserACLs(rawACLs []interface{}) []*godo.KafkaACL {
 	acls := make([]*godo.KafkaACL, 0, len(rawACLs))
 	for _, rawACL := range rawACLs {
@@ -303,16 +506,29 @@ func expandUserACLs(rawACLs []interface{}) []*godo.KafkaACL {
 	return acls
 }
 
-func flattenUserSettings(settings *godo.DatabaseUserSettings) []map[string]interface{} {
+func flattenUserSettings(settings *databaseUserSettings) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 	if settings != nil {
 		r := make(map[string]interface{})
 		r["acl"] = flattenUserACLs(settings.ACL)
+		r["schema_registry_acl"] = flattenUserSchemaRegistryACLs(settings.SchemaRegistryACL)
 		result = append(result, r)
 	}
 	return result
 }
 
+func flattenUserSchemaRegistryACLs(acls []*schemaRegistryACL) []interface{} {
+	result := make([]interface{}, len(acls))
+	for i, acl := range acls {
+		item := make(map[string]interface{})
+		item["id"] = acl.ID
+		item["resource"] = acl.Resource
+		item["permission"] = strings.ToLower(acl.Permission)
+		result[i] = item
+	}
+	return result
+}
+
 func flattenUserACLs(acls []*godo.KafkaACL) []map[string]interface{} {
 	result := make([]map[string]interface{}, len(acls))
 	for i, acl := range acls {
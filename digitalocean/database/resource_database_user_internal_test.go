@@ -0,0 +1,65 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDatabaseUserSchemaRegistryACLs(t *testing.T) {
+	body := `{"user": {"name": "foobar", "role": "normal", "settings": {
+		"acl": [{"id": "acl-1", "topic": "topic-1", "permission": "admin"}],
+		"schema_registry_acl": [{"id": "sr-1", "resource": "topic-*", "permission": "SCHEMA_REGISTRY_READ"}]
+	}}}`
+
+	root := new(databaseUserRoot)
+	if err := json.Unmarshal([]byte(body), root); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if root.User.Name != "foobar" || root.User.Role != "normal" {
+		t.Errorf("expected user foobar with role normal, got %s with role %s", root.User.Name, root.User.Role)
+	}
+
+	flattened := flattenUserSettings(root.User.Settings)
+	if len(flattened) != 1 {
+		t.Fatalf("expected one settings block, got %d", len(flattened))
+	}
+
+	acls := flattened[0]["schema_registry_acl"].([]interface{})
+	if len(acls) != 1 {
+		t.Fatalf("expected one schema registry ACL, got %d", len(acls))
+	}
+
+	acl := acls[0].(map[string]interface{})
+	if acl["id"] != "sr-1" || acl["resource"] != "topic-*" || acl["permission"] != "schema_registry_read" {
+		t.Errorf("unexpected schema registry ACL: %#v", acl)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseUser().Schema, map[string]interface{}{
+		"settings": []interface{}{
+			map[string]interface{}{
+				"acl": []interface{}{
+					map[string]interface{}{"topic": "topic-1", "permission": "admin"},
+				},
+				"schema_registry_acl": []interface{}{
+					map[string]interface{}{"resource": "topic-*", "permission": "schema_registry_read"},
+				},
+			},
+		},
+	})
+	settings := expandUserSettings(d.Get("settings").([]interface{}))
+	if len(settings.SchemaRegistryACL) != 1 || settings.SchemaRegistryACL[0].Resource != "topic-*" {
+		t.Errorf("unexpected expanded schema registry ACLs: %#v", settings.SchemaRegistryACL)
+	}
+
+	out, err := json.Marshal(&databaseUpdateUserRequest{Settings: settings})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `{"settings":{"acl":[{"permission":"admin","topic":"topic-1"}],"schema_registry_acl":[{"permission":"schema_registry_read","resource":"topic-*"}]}}`
+	if string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -18,6 +19,7 @@ func TestAccDigitalOceanDatabaseUser_Basic(t *testing.T) {
 	databaseClusterName := acceptance.RandomTestName()
 	databaseUserName := acceptance.RandomTestName()
 	databaseUserNameUpdated := databaseUserName + "-up"
+	region := acceptance.TestAccRegion(t, "database:pg")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -25,7 +27,7 @@ func TestAccDigitalOceanDatabaseUser_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -44,7 +46,7 @@ func TestAccDigitalOceanDatabaseUser_Basic(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, databaseUserNameUpdated),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, region, databaseUserNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserNotExists("digitalocean_database_user.foobar_user", databaseUserName),
@@ -61,6 +63,7 @@ func TestAccDigitalOceanDatabaseUser_MongoDB(t *testing.T) {
 	var databaseUser godo.DatabaseUser
 	databaseClusterName := acceptance.RandomTestName()
 	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:mongodb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -68,7 +71,7 @@ func TestAccDigitalOceanDatabaseUser_MongoDB(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMongo, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMongo, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -87,8 +90,9 @@ func TestAccDigitalOceanDatabaseUser_MongoDB(t *testing.T) {
 func TestAccDigitalOceanDatabaseUser_MongoDBMultiUser(t *testing.T) {
 	databaseClusterName := acceptance.RandomTestName()
 	users := []string{"foo", "bar", "baz", "one", "two"}
+	region := acceptance.TestAccRegion(t, "database:mongodb")
 	config := fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMongoMultiUser,
-		databaseClusterName,
+		databaseClusterName, region,
 		users[0], users[0],
 		users[1], users[1],
 		users[2], users[2],
@@ -128,6 +132,7 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 	var databaseUser godo.DatabaseUser
 	databaseClusterName := acceptance.RandomTestName()
 	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:mysql")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -135,7 +140,7 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuth, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuth, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -150,7 +155,7 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuthUpdate, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuthUpdate, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -165,7 +170,7 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuthRemoved, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMySQLAuthRemoved, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -183,17 +188,56 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseUser_PasswordRotation(t *testing.T) {
+	var databaseUser godo.DatabaseUser
+	var password string
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:mysql")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigPasswordRotation, databaseClusterName, region, databaseUserName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					testAccCheckDigitalOceanDatabaseUserPassword("digitalocean_database_user.foobar_user", &password, false),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "password_rotation", "one"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "mysql_auth_plugin", "mysql_native_password"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigPasswordRotation, databaseClusterName, region, databaseUserName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					testAccCheckDigitalOceanDatabaseUserPassword("digitalocean_database_user.foobar_user", &password, true),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "password_rotation", "two"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "mysql_auth_plugin", "mysql_native_password"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseUser_KafkaACLs(t *testing.T) {
 	var databaseUser godo.DatabaseUser
 	databaseClusterName := acceptance.RandomTestName()
 	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:kafka")
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
 		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaACL, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaACL, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -234,7 +278,7 @@ func TestAccDigitalOceanDatabaseUser_KafkaACLs(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaACLUpdate, databaseClusterName, databaseUserName),
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaACLUpdate, databaseClusterName, region, databaseUserName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
 					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
@@ -260,6 +304,67 @@ func TestAccDigitalOceanDatabaseUser_KafkaACLs(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseUser_KafkaSchemaRegistryACLs(t *testing.T) {
+	var databaseUser godo.DatabaseUser
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:kafka")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaSchemaRegistryACL, databaseClusterName, region, databaseUserName, "schema_registry_read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "settings.0.schema_registry_acl.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_database_user.foobar_user", "settings.0.schema_registry_acl.*", map[string]string{
+							"resource":   "topic-*",
+							"permission": "schema_registry_read",
+						}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaSchemaRegistryACL, databaseClusterName, region, databaseUserName, "schema_registry_write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "settings.0.schema_registry_acl.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_database_user.foobar_user", "settings.0.schema_registry_acl.*", map[string]string{
+							"resource":   "topic-*",
+							"permission": "schema_registry_write",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseUser_SchemaRegistryACLNonKafka(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "database:pg")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseClusterName, region),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseClusterName, region) +
+					fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigSchemaRegistryACLOnly, databaseUserName),
+				ExpectError: regexp.MustCompile("can only be used with Kafka database clusters"),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseUserDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -328,9 +433,9 @@ func testAccCheckDigitalOceanDatabaseUserNotExists(n string, databaseUserName st
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 		clusterID := rs.Primary.Attributes["cluster_id"]
 
-		_, resp, err := client.Databases.GetDB(context.Background(), clusterID, databaseUserName)
+		_, resp, err := client.Databases.GetUser(context.Background(), clusterID, databaseUserName)
 
-		if err != nil && resp.StatusCode != http.StatusNotFound {
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
 			return err
 		}
 
@@ -353,13 +458,38 @@ func testAccCheckDigitalOceanDatabaseUserAttributes(databaseUser *godo.DatabaseU
 	}
 }
 
+// testAccCheckDigitalOceanDatabaseUserPassword records the user's password. If
+// changed is true, it also verifies that the password differs from the one
+// previously recorded.
+func testAccCheckDigitalOceanDatabaseUserPassword(n string, password *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["password"]
+		if current == "" {
+			return fmt.Errorf("No password is set")
+		}
+
+		if changed && current == *password {
+			return fmt.Errorf("Expected password to be rotated")
+		}
+
+		*password = current
+
+		return nil
+	}
+}
+
 const testAccCheckDigitalOceanDatabaseUserConfigBasic = `
 resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
   engine     = "pg"
   version    = "15"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 
   maintenance_window {
@@ -379,7 +509,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mongodb"
   version    = "6"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 
   maintenance_window {
@@ -399,7 +529,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mongodb"
   version    = "6"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -434,7 +564,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mysql"
   version    = "8"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -450,7 +580,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "kafka"
   version    = "3.5"
   size       = "db-s-2vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 3
 }
 
@@ -483,7 +613,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "kafka"
   version    = "3.5"
   size       = "db-s-2vcpu-2gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 3
 }
 
@@ -498,13 +628,51 @@ resource "digitalocean_database_user" "foobar_user" {
   }
 }`
 
+const testAccCheckDigitalOceanDatabaseUserConfigKafkaSchemaRegistryACL = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "kafka"
+  version    = "3.5"
+  size       = "db-s-2vcpu-2gb"
+  region     = "%s"
+  node_count = 3
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+  settings {
+    acl {
+      topic      = "topic-*"
+      permission = "produceconsume"
+    }
+    schema_registry_acl {
+      resource   = "topic-*"
+      permission = "%s"
+    }
+  }
+}`
+
+const testAccCheckDigitalOceanDatabaseUserConfigSchemaRegistryACLOnly = `
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+  settings {
+    schema_registry_acl {
+      resource   = "*"
+      permission = "schema_registry_read"
+    }
+  }
+}`
+
 const testAccCheckDigitalOceanDatabaseUserConfigMySQLAuthUpdate = `
 resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
   engine     = "mysql"
   version    = "8"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -520,7 +688,7 @@ resource "digitalocean_database_cluster" "foobar" {
   engine     = "mysql"
   version    = "8"
   size       = "db-s-1vcpu-1gb"
-  region     = "nyc1"
+  region     = "%s"
   node_count = 1
 }
 
@@ -528,3 +696,20 @@ resource "digitalocean_database_user" "foobar_user" {
   cluster_id = digitalocean_database_cluster.foobar.id
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDatabaseUserConfigPasswordRotation = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "mysql"
+  version    = "8"
+  size       = "db-s-1vcpu-1gb"
+  region     = "%s"
+  node_count = 1
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id        = digitalocean_database_cluster.foobar.id
+  name              = "%s"
+  mysql_auth_plugin = "mysql_native_password"
+  password_rotation = "%s"
+}`
@@ -15,8 +15,21 @@ func init() {
 	resource.AddTestSweepers("digitalocean_database_cluster", &resource.Sweeper{
 		Name: "digitalocean_database_cluster",
 		F:    testSweepDatabaseCluster,
+		Dependencies: []string{
+			"digitalocean_database_connection_pool",
+			"digitalocean_database_kafka_topic",
+		},
 	})
 
+	resource.AddTestSweepers("digitalocean_database_connection_pool", &resource.Sweeper{
+		Name: "digitalocean_database_connection_pool",
+		F:    testSweepDatabaseConnectionPool,
+	})
+
+	resource.AddTestSweepers("digitalocean_database_kafka_topic", &resource.Sweeper{
+		Name: "digitalocean_database_kafka_topic",
+		F:    testSweepDatabaseKafkaTopic,
+	})
 }
 
 func testSweepDatabaseCluster(region string) error {
@@ -45,3 +58,86 @@ func testSweepDatabaseCluster(region string) error {
 
 	return nil
 }
+
+// listSweepableDatabaseClusters returns the database clusters created by
+// acceptance tests that use the given engine.
+func listSweepableDatabaseClusters(client *godo.Client, engine string) ([]godo.Database, error) {
+	opt := &godo.ListOptions{PerPage: 200}
+	databases, _, err := client.Databases.List(context.Background(), opt)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []godo.Database
+	for _, db := range databases {
+		if strings.HasPrefix(db.Name, sweep.TestNamePrefix) && db.EngineSlug == engine {
+			clusters = append(clusters, db)
+		}
+	}
+
+	return clusters, nil
+}
+
+func testSweepDatabaseConnectionPool(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusters, err := listSweepableDatabaseClusters(client, postgresDBEngineSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, db := range clusters {
+		pools, _, err := client.Databases.ListPools(context.Background(), db.ID, &godo.ListOptions{PerPage: 200})
+		if err != nil {
+			return err
+		}
+
+		// Pools are deleted one at a time, as the API rejects concurrent
+		// modifications to the same cluster.
+		for _, pool := range pools {
+			log.Printf("Destroying database connection pool %s in cluster %s", pool.Name, db.Name)
+
+			if _, err := client.Databases.DeletePool(context.Background(), db.ID, pool.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testSweepDatabaseKafkaTopic(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusters, err := listSweepableDatabaseClusters(client, kafkaDBEngineSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, db := range clusters {
+		topics, _, err := client.Databases.ListTopics(context.Background(), db.ID, &godo.ListOptions{PerPage: 200})
+		if err != nil {
+			return err
+		}
+
+		for _, topic := range topics {
+			log.Printf("Destroying database kafka topic %s in cluster %s", topic.Name, db.Name)
+
+			if _, err := client.Databases.DeleteTopic(context.Background(), db.ID, topic.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
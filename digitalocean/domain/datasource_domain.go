@@ -31,11 +31,22 @@ func DataSourceDigitalOceanDomain() *schema.Resource {
 				Computed:    true,
 				Description: "ttl of the domain",
 			},
+			"zone_default_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the default ttl of the domain, used for records created without a ttl",
+			},
 			"zone_file": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "zone file of the domain",
 			},
+			"nameservers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "the domain's authoritative nameservers, from the NS records at its apex",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -57,7 +68,14 @@ func dataSourceDigitalOceanDomainRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("name", domain.Name)
 	d.Set("urn", domain.URN())
 	d.Set("ttl", domain.TTL)
+	d.Set("zone_default_ttl", domain.TTL)
 	d.Set("zone_file", domain.ZoneFile)
 
+	nameservers, err := getDigitalOceanDomainNameservers(context.Background(), client, domain.Name)
+	if err != nil {
+		return diag.Errorf("Error retrieving nameservers for domain: %s", err)
+	}
+	d.Set("nameservers", nameservers)
+
 	return nil
 }
@@ -45,6 +45,12 @@ data "digitalocean_domain" "foobar" {
 						"data.digitalocean_domain.foobar", "name", domainName),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_domain.foobar", "urn", expectedURN),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_domain.foobar", "nameservers.#", "3"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_domain.foobar", "nameservers.0", "ns1.digitalocean.com"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_domain.foobar", "zone_default_ttl", "1800"),
 				),
 			},
 		},
@@ -15,8 +15,9 @@ func DataSourceDigitalOceanRecords() *schema.Resource {
 				Required: true,
 			},
 		},
-		FlattenRecord: flattenDigitalOceanRecord,
-		GetRecords:    getDigitalOceanRecords,
+		FlattenRecord:      flattenDigitalOceanRecord,
+		GetRecords:         getDigitalOceanRecords,
+		GetFilteredRecords: getDigitalOceanRecordsFiltered,
 	}
 
 	return datalist.NewResource(dataListConfig)
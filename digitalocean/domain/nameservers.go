@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// digitalOceanNameservers are the nameservers that are authoritative for
+// domains managed by DigitalOcean DNS.
+var digitalOceanNameservers = []string{
+	"ns1.digitalocean.com",
+	"ns2.digitalocean.com",
+	"ns3.digitalocean.com",
+}
+
+// isDigitalOceanApexNSRecord reports whether a record is one of the NS records
+// at the apex of the domain that delegate it to DigitalOcean. Removing these
+// breaks resolution of the whole zone.
+func isDigitalOceanApexNSRecord(domain, recordType, name, value string) bool {
+	if !strings.EqualFold(recordType, "NS") {
+		return false
+	}
+
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	if name != "@" && name != strings.ToLower(domain) {
+		return false
+	}
+
+	value = strings.TrimSuffix(strings.ToLower(value), ".")
+	for _, ns := range digitalOceanNameservers {
+		if value == ns {
+			return true
+		}
+	}
+
+	return false
+}
+
+func apexNSRecordDeletionError(domain, value string) error {
+	return fmt.Errorf("refusing to remove the apex NS record for %s pointing at %s, as this would break resolution of the domain; set `allow_apex_ns_deletion = true` and apply before removing it", domain, strings.TrimSuffix(value, "."))
+}
+
+// getDigitalOceanDomainNameservers returns the values of the NS records at the
+// apex of the domain, i.e. its authoritative nameservers.
+func getDigitalOceanDomainNameservers(ctx context.Context, client *godo.Client, domain string) ([]string, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	nameservers := []string{}
+
+	for {
+		records, resp, err := client.Domains.RecordsByType(ctx, domain, "NS", opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			if record.Name == "@" {
+				nameservers = append(nameservers, record.Data)
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	sort.Strings(nameservers)
+
+	return nameservers, nil
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestIsDigitalOceanApexNSRecord(t *testing.T) {
+	cases := []struct {
+		recordType, name, value string
+		expected                bool
+	}{
+		{"NS", "@", "ns1.digitalocean.com.", true},
+		{"NS", "@", "ns3.digitalocean.com", true},
+		{"NS", "example.com.", "NS2.DigitalOcean.com.", true},
+		{"NS", "example.com", "ns2.digitalocean.com.", true},
+		{"NS", "sub", "ns1.digitalocean.com.", false},
+		{"NS", "sub.example.com.", "ns1.digitalocean.com.", false},
+		{"NS", "@", "ns1.example.net.", false},
+		{"NS", "@", "ns4.digitalocean.com.", false},
+		{"CNAME", "@", "ns1.digitalocean.com.", false},
+	}
+
+	for _, tc := range cases {
+		actual := isDigitalOceanApexNSRecord("example.com", tc.recordType, tc.name, tc.value)
+		if actual != tc.expected {
+			t.Errorf("%s %s %s: expected %t, got %t", tc.recordType, tc.name, tc.value, tc.expected, actual)
+		}
+	}
+}
+
+func TestResourceDigitalOceanRecordDelete_apexNS(t *testing.T) {
+	r := ResourceDigitalOceanRecord()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"domain": "example.com",
+		"type":   "NS",
+		"name":   "@",
+		"value":  "ns1.digitalocean.com.",
+	})
+	d.SetId("1234")
+
+	// The check happens before any API request is made, so no client is
+	// needed.
+	diags := resourceDigitalOceanRecordDelete(context.Background(), d, nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "allow_apex_ns_deletion") {
+		t.Fatalf("expected deletion to be refused, got: %#v", diags)
+	}
+}
+
+func TestResourceDigitalOceanRecordDiff_apexNS(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"id":                     "1234",
+			"domain":                 "example.com",
+			"type":                   "NS",
+			"name":                   "@",
+			"value":                  "ns1.digitalocean.com.",
+			"ttl":                    "1800",
+			"allow_apex_ns_deletion": "false",
+		},
+	}
+
+	cases := []struct {
+		name        string
+		config      map[string]interface{}
+		expectError bool
+	}{
+		{
+			"Unchanged",
+			map[string]interface{}{"domain": "example.com", "type": "NS", "name": "@", "value": "ns1.digitalocean.com."},
+			false,
+		},
+		{
+			"ChangedTTL",
+			map[string]interface{}{"domain": "example.com", "type": "NS", "name": "@", "value": "ns1.digitalocean.com.", "ttl": 3600},
+			false,
+		},
+		{
+			"ChangedValue",
+			map[string]interface{}{"domain": "example.com", "type": "NS", "name": "@", "value": "ns1.example.net."},
+			true,
+		},
+		{
+			"ChangedName",
+			map[string]interface{}{"domain": "example.com", "type": "NS", "name": "sub", "value": "ns1.digitalocean.com."},
+			true,
+		},
+		{
+			"ChangedValueAllowed",
+			map[string]interface{}{"domain": "example.com", "type": "NS", "name": "@", "value": "ns1.example.net.", "allow_apex_ns_deletion": true},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ResourceDigitalOceanRecord().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.expectError && (err == nil || !strings.Contains(err.Error(), "allow_apex_ns_deletion")) {
+				t.Errorf("expected the change to be refused, got: %v", err)
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetDigitalOceanDomainNameservers(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/domains/example.com/records" || r.URL.Query().Get("type") != "NS" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"domain_records":[
+			{"id":1,"type":"NS","name":"@","data":"ns2.digitalocean.com"},
+			{"id":2,"type":"NS","name":"@","data":"ns1.digitalocean.com"},
+			{"id":3,"type":"NS","name":"sub","data":"ns1.example.net"}
+		],"links":{}}`)
+	})).GodoClient()
+
+	nameservers, err := getDigitalOceanDomainNameservers(context.Background(), client, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"ns1.digitalocean.com", "ns2.digitalocean.com"}
+	if !reflect.DeepEqual(nameservers, expected) {
+		t.Errorf("expected %v, got %v", expected, nameservers)
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"strings"
+)
+
+// recordNamesMatch reports whether two record names are the same name of the
+// domain. Names are compared case-insensitively, and may be relative to the
+// domain or fully qualified with the trailing dot, the apex being "@" or
+// empty.
+func recordNamesMatch(domain, old, new string) bool {
+	return canonicalRecordName(domain, old) == canonicalRecordName(domain, new)
+}
+
+// canonicalRecordName returns the name relative to the domain, or "@" for the
+// apex. As in ConstructFqdn, a name is only fully qualified if it has the
+// trailing dot.
+func canonicalRecordName(domain, name string) string {
+	name = strings.ToLower(name)
+	domain = strings.ToLower(domain)
+
+	if name == "" || name == "@" {
+		return "@"
+	}
+	if !strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	name = strings.TrimSuffix(name, ".")
+	if name == domain {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+domain) {
+		return strings.TrimSuffix(name, "."+domain)
+	}
+
+	// A name outside of the domain is kept fully qualified so that it does
+	// not match the relative name.
+	return name + "."
+}
+
+// canonicalTXTValue returns the value of a TXT record without its quotes. The
+// API may return a value as one or more quoted strings, long values being
+// split in strings of at most 255 characters, which are joined. A value which
+// is not made only of quoted strings is returned as is.
+func canonicalTXTValue(value string) string {
+	if !strings.HasPrefix(value, `"`) {
+		return value
+	}
+
+	var canonical strings.Builder
+	rest := value
+	for rest != "" {
+		if rest[0] != '"' {
+			return value
+		}
+
+		closed := false
+		i := 1
+		for ; i < len(rest); i++ {
+			c := rest[i]
+			if c == '\\' && i+1 < len(rest) {
+				i++
+				canonical.WriteByte(rest[i])
+				continue
+			}
+			if c == '"' {
+				closed = true
+				break
+			}
+			canonical.WriteByte(c)
+		}
+		if !closed {
+			return value
+		}
+
+		rest = strings.TrimLeft(rest[i+1:], " ")
+	}
+
+	return canonical.String()
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCanonicalTXTValue(t *testing.T) {
+	cases := []struct {
+		value, expected string
+	}{
+		{`v=spf1 -all`, `v=spf1 -all`},
+		{`"v=spf1 -all"`, `v=spf1 -all`},
+		{`"v=DKIM1; k=rsa; p=abc" "def"`, `v=DKIM1; k=rsa; p=abcdef`},
+		{`"say \"hello\""`, `say "hello"`},
+		{`""`, ``},
+		{`"unterminated`, `"unterminated`},
+		{`"quoted" trailing`, `"quoted" trailing`},
+	}
+
+	for _, tc := range cases {
+		if actual := canonicalTXTValue(tc.value); actual != tc.expected {
+			t.Errorf("expected %q to be canonicalized to %q, got %q", tc.value, tc.expected, actual)
+		}
+	}
+}
+
+func TestRecordDiffSuppress(t *testing.T) {
+	r := ResourceDigitalOceanRecord()
+
+	cases := []struct {
+		name       string
+		attribute  string
+		recordType string
+		old, new   string
+		suppressed bool
+	}{
+		{name: "NameApex", attribute: "name", recordType: "A", old: "@", new: "example.com.", suppressed: true},
+		{name: "NameEmptyApex", attribute: "name", recordType: "A", old: "", new: "@"},
+		{name: "NameFullyQualified", attribute: "name", recordType: "A", old: "www", new: "www.example.com.", suppressed: true},
+		{name: "NameCase", attribute: "name", recordType: "A", old: "www", new: "WWW", suppressed: true},
+		{name: "NameFullyQualifiedCase", attribute: "name", recordType: "A", old: "www", new: "WWW.Example.COM.", suppressed: true},
+		{name: "NameRelativeWithDomain", attribute: "name", recordType: "A", old: "www", new: "www.example.com"},
+		{name: "NameOtherDomain", attribute: "name", recordType: "A", old: "www", new: "www.example.net."},
+		{name: "NameChanged", attribute: "name", recordType: "A", old: "www", new: "api"},
+
+		{name: "CNAMETrailingDot", attribute: "value", recordType: "CNAME", old: "lb.example.net.", new: "lb.example.net", suppressed: true},
+		{name: "CNAMECase", attribute: "value", recordType: "CNAME", old: "lb.example.net.", new: "LB.Example.net.", suppressed: true},
+		{name: "CNAMERelative", attribute: "value", recordType: "CNAME", old: "www.example.com.", new: "www", suppressed: true},
+		{name: "CNAMEApex", attribute: "value", recordType: "CNAME", old: "@", new: "example.com.", suppressed: true},
+		{name: "CNAMEChanged", attribute: "value", recordType: "CNAME", old: "lb.example.net.", new: "lb.example.org."},
+		{name: "MXTrailingDot", attribute: "value", recordType: "MX", old: "mail.example.com.", new: "mail.example.com", suppressed: true},
+		{name: "NSTrailingDot", attribute: "value", recordType: "NS", old: "ns1.digitalocean.com.", new: "ns1.digitalocean.com", suppressed: true},
+		{name: "SRVTrailingDot", attribute: "value", recordType: "SRV", old: "sip.example.com.", new: "sip.example.com", suppressed: true},
+		{name: "AChanged", attribute: "value", recordType: "A", old: "192.168.0.10", new: "192.168.0.11"},
+		{name: "TXTQuoted", attribute: "value", recordType: "TXT", old: `"v=spf1 -all"`, new: "v=spf1 -all", suppressed: true},
+		{name: "TXTSplit", attribute: "value", recordType: "TXT", old: `"v=DKIM1; p=abc" "def"`, new: "v=DKIM1; p=abcdef", suppressed: true},
+		{name: "TXTCase", attribute: "value", recordType: "TXT", old: "Example", new: "example"},
+		{name: "TXTTrailingDot", attribute: "value", recordType: "TXT", old: "example.com.", new: "example.com"},
+		{name: "Create", attribute: "value", recordType: "TXT", old: "", new: `""`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"domain": "example.com",
+				"type":   tc.recordType,
+				"name":   "www",
+				"value":  "foo",
+			})
+
+			suppressed := r.Schema[tc.attribute].DiffSuppressFunc(tc.attribute, tc.old, tc.new, d)
+			if suppressed != tc.suppressed {
+				t.Errorf("expected the diff of %s from %q to %q to be suppressed: %t", tc.attribute, tc.old, tc.new, tc.suppressed)
+			}
+		})
+	}
+}
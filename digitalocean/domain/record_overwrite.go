@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+)
+
+// isRecordConflict reports whether a record could not be created because it
+// conflicts with an existing record. Other validation errors are also
+// returned as a 422, so the message is checked too.
+func isRecordConflict(err error) bool {
+	return util.IsDigitalOceanError(err, http.StatusUnprocessableEntity, "already exist") ||
+		util.IsDigitalOceanError(err, http.StatusConflict, "already exist")
+}
+
+// recordValuesMatch reports whether the value of an existing record is the
+// configured value. Hostnames may be configured relative to the domain or
+// fully qualified, with or without the trailing dot, and TXT values with or
+// without quotes.
+func recordValuesMatch(domain, recordType, configured, existing string) bool {
+	if configured == existing {
+		return true
+	}
+
+	switch recordType {
+	case "CNAME", "MX", "NS", "SRV":
+	case "TXT":
+		return canonicalTXTValue(configured) == canonicalTXTValue(existing)
+	default:
+		return false
+	}
+
+	configured = strings.TrimSuffix(strings.ToLower(configured), ".")
+	existing = strings.TrimSuffix(strings.ToLower(existing), ".")
+	domain = strings.ToLower(domain)
+
+	if configured == "@" {
+		configured = domain
+	}
+	if existing == "@" {
+		existing = domain
+	}
+
+	return configured == existing || configured+"."+domain == existing
+}
+
+// findRecordToOverwrite looks up the existing record that a record, which
+// failed to be created, conflicts with. As types such as A or TXT allow
+// several records with the same name, a record is only overwritten if it has
+// the configured value or is the only record of the type with the name, as
+// otherwise it is not known which of them to adopt.
+func findRecordToOverwrite(ctx context.Context, client *godo.Client, domain, recordType, name, value string) (*godo.DomainRecord, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	fqdn := ConstructFqdn(name, domain)
+
+	var existing []godo.DomainRecord
+	for {
+		records, resp, err := client.Domains.RecordsByTypeAndName(ctx, domain, recordType, fqdn, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving %s records named %s: %s", recordType, fqdn, err)
+		}
+
+		existing = append(existing, records...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving %s records named %s: %s", recordType, fqdn, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	for i := range existing {
+		if recordValuesMatch(domain, recordType, value, existing[i].Data) {
+			return &existing[i], nil
+		}
+	}
+
+	switch {
+	case len(existing) == 0:
+		return nil, fmt.Errorf("no existing %s record named %s was found to overwrite", recordType, fqdn)
+	case len(existing) == 1:
+		return &existing[0], nil
+	default:
+		return nil, fmt.Errorf("found %d %s records named %s and none has the value %q; import the record to manage instead", len(existing), recordType, fqdn, value)
+	}
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRecordValuesMatch(t *testing.T) {
+	cases := []struct {
+		recordType, configured, existing string
+		expected                         bool
+	}{
+		{"A", "192.168.0.10", "192.168.0.10", true},
+		{"A", "192.168.0.10", "192.168.0.11", false},
+		{"TXT", "Example", "example", false},
+		{"TXT", `"Example"`, "Example", true},
+		{"CNAME", "www.example.com.", "www.example.com", true},
+		{"CNAME", "WWW.example.com", "www.example.com", true},
+		{"CNAME", "www", "www.example.com", true},
+		{"CNAME", "@", "example.com", true},
+		{"CNAME", "www.example.net.", "www.example.com", false},
+		{"MX", "mail.example.com.", "mail.example.com", true},
+	}
+
+	for _, tc := range cases {
+		actual := recordValuesMatch("example.com", tc.recordType, tc.configured, tc.existing)
+		if actual != tc.expected {
+			t.Errorf("expected %s value %q matching %q to be %t", tc.recordType, tc.configured, tc.existing, tc.expected)
+		}
+	}
+}
+
+func TestFindRecordToOverwrite(t *testing.T) {
+	records := map[string]string{
+		"CNAME": `[{"id":1,"type":"CNAME","name":"www","data":"lb.example.net"}]`,
+		"A":     `[{"id":2,"type":"A","name":"www","data":"192.168.0.10"},{"id":3,"type":"A","name":"www","data":"192.168.0.11"}]`,
+		"TXT":   `[]`,
+	}
+
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/domains/example.com/records" || r.URL.Query().Get("name") != "www.example.com" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"domain_records":%s,"links":{}}`, records[r.URL.Query().Get("type")])
+	})).GodoClient()
+
+	cases := []struct {
+		name       string
+		recordType string
+		value      string
+		expectedID int
+	}{
+		{name: "SingleRecord", recordType: "CNAME", value: "other.example.net.", expectedID: 1},
+		{name: "MatchingValue", recordType: "A", value: "192.168.0.11", expectedID: 3},
+		{name: "AmbiguousValue", recordType: "A", value: "192.168.0.12"},
+		{name: "NoRecord", recordType: "TXT", value: "foo"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			record, err := findRecordToOverwrite(context.Background(), client, "example.com", tc.recordType, "www", tc.value)
+			if tc.expectedID == 0 {
+				if err == nil {
+					t.Fatalf("expected an error, got record %#v", record)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if record.ID != tc.expectedID {
+				t.Errorf("expected record %d, got %d", tc.expectedID, record.ID)
+			}
+		})
+	}
+}
+
+func TestResourceDigitalOceanRecordCreate_AllowOverwrite(t *testing.T) {
+	conflict := `{"id":"unprocessable_entity","message":"CNAME records with this name already exist."}`
+
+	cases := []struct {
+		name           string
+		allowOverwrite bool
+		createError    string
+		expectError    bool
+	}{
+		{name: "Allowed", allowOverwrite: true, createError: conflict},
+		{name: "NotAllowed", createError: conflict, expectError: true},
+		{
+			name:           "NotAConflict",
+			allowOverwrite: true,
+			createError:    `{"id":"unprocessable_entity","message":"Data needs to end with a dot (.)"}`,
+			expectError:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var edited *godo.DomainRecordEditRequest
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/domains/example.com/records":
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprint(w, tc.createError)
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com/records":
+					fmt.Fprint(w, `{"domain_records":[{"id":42,"type":"CNAME","name":"www","data":"lb.example.net","ttl":300}],"links":{}}`)
+				case r.Method == http.MethodPut && r.URL.Path == "/v2/domains/example.com/records/42":
+					edited = &godo.DomainRecordEditRequest{}
+					if err := json.NewDecoder(r.Body).Decode(edited); err != nil {
+						t.Fatalf("unable to decode request body: %s", err)
+					}
+					fmt.Fprint(w, `{"domain_record":{"id":42,"type":"CNAME","name":"www","data":"app.example.net","ttl":300}}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com/records/42":
+					data := "lb.example.net"
+					if edited != nil {
+						data = edited.Data
+					}
+					fmt.Fprintf(w, `{"domain_record":{"id":42,"type":"CNAME","name":"www","data":%q,"ttl":300}}`, strings.TrimSuffix(data, "."))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			r := ResourceDigitalOceanRecord()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"domain":          "example.com",
+				"type":            "CNAME",
+				"name":            "www",
+				"value":           "app.example.net.",
+				"ttl":             300,
+				"allow_overwrite": tc.allowOverwrite,
+			})
+
+			diags := r.CreateContext(context.Background(), d, meta)
+			if tc.expectError {
+				if !diags.HasError() {
+					t.Fatalf("expected an error, got none")
+				}
+				if d.Id() != "" {
+					t.Errorf("expected no record to be adopted, got %s", d.Id())
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if d.Id() != "42" {
+				t.Errorf("expected the existing record to be adopted, got ID %q", d.Id())
+			}
+			if edited == nil || edited.Data != "app.example.net." {
+				t.Errorf("expected the existing record to be updated to the configuration, got %#v", edited)
+			}
+			if v := d.Get("value").(string); v != "app.example.net." {
+				t.Errorf("expected value app.example.net., got %s", v)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -66,21 +67,91 @@ func getDigitalOceanRecords(meta interface{}, extra map[string]interface{}) ([]i
 		return nil, fmt.Errorf("unable to find `domain` key from query data")
 	}
 
-	var allRecords []interface{}
+	return listDigitalOceanRecords(domain, recordListFuncForFilters(client, domain, nil), nil)
+}
+
+// getDigitalOceanRecordsFiltered pushes exact type and name filters down to
+// the API where possible, so that only the matching records are retrieved.
+// The remaining filters are applied to each page as it is retrieved, so that
+// the records of large domains are not all held at once.
+func getDigitalOceanRecordsFiltered(meta interface{}, extra map[string]interface{}, filters []datalist.Filter, matches func(record interface{}) (bool, error)) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	domain, ok := extra["domain"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `domain` key from query data")
+	}
+
+	return listDigitalOceanRecords(domain, recordListFuncForFilters(client, domain, filters), matches)
+}
+
+type recordListFunc func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error)
+
+// recordListFuncForFilters returns the narrowest list endpoint that can serve
+// the filters. Records can only be looked up by a single exact type and
+// name, so filters matching any of several values fall back to listing all
+// of the records of the domain. The API looks up names fully qualified.
+func recordListFuncForFilters(client *godo.Client, domain string, filters []datalist.Filter) recordListFunc {
+	var recordType, name string
+	for _, f := range filters {
+		if f.MatchBy != "exact" || len(f.Values) != 1 {
+			continue
+		}
 
+		switch f.Key {
+		case "type":
+			recordType = f.Values[0]
+		case "name":
+			name = ConstructFqdn(f.Values[0], domain)
+		}
+	}
+
+	switch {
+	case recordType != "" && name != "":
+		return func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+			return client.Domains.RecordsByTypeAndName(ctx, domain, recordType, name, opts)
+		}
+	case recordType != "":
+		return func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+			return client.Domains.RecordsByType(ctx, domain, recordType, opts)
+		}
+	case name != "":
+		return func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+			return client.Domains.RecordsByName(ctx, domain, name, opts)
+		}
+	}
+
+	return func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+		return client.Domains.Records(ctx, domain, opts)
+	}
+}
+
+func listDigitalOceanRecords(domain string, list recordListFunc, matches func(record interface{}) (bool, error)) ([]interface{}, error) {
 	opts := &godo.ListOptions{
 		Page:    1,
 		PerPage: 200,
 	}
 
+	var recordList []interface{}
+
 	for {
-		records, resp, err := client.Domains.Records(context.Background(), domain, opts)
+		records, resp, err := list(context.Background(), opts)
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving records: %s", err)
+			return nil, fmt.Errorf("Error retrieving records for domain %s: %s", domain, err)
 		}
 
 		for _, record := range records {
-			allRecords = append(allRecords, record)
+			if matches != nil {
+				ok, err := matches(record)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+
+			recordList = append(recordList, record)
 		}
 
 		if resp.Links == nil || resp.Links.IsLastPage() {
@@ -89,13 +160,13 @@ func getDigitalOceanRecords(meta interface{}, extra map[string]interface{}) ([]i
 
 		page, err := resp.Links.CurrentPage()
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving projects: %s", err)
+			return nil, fmt.Errorf("Error retrieving records for domain %s: %s", domain, err)
 		}
 
 		opts.Page = page + 1
 	}
 
-	return allRecords, nil
+	return recordList, nil
 }
 
 func flattenDigitalOceanRecord(rawRecord interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestRecordListFuncForFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filters  []datalist.Filter
+		expected string
+	}{
+		{
+			"NoFilters",
+			nil,
+			"",
+		},
+		{
+			"ByType",
+			[]datalist.Filter{{Key: "type", Values: []string{"A"}, MatchBy: "exact"}},
+			"type=A",
+		},
+		{
+			"ByName",
+			[]datalist.Filter{{Key: "name", Values: []string{"www"}, MatchBy: "exact"}},
+			"name=www.example.com",
+		},
+		{
+			"ByApexName",
+			[]datalist.Filter{{Key: "name", Values: []string{"@"}, MatchBy: "exact"}},
+			"name=example.com",
+		},
+		{
+			"ByTypeAndName",
+			[]datalist.Filter{
+				{Key: "type", Values: []string{"A"}, MatchBy: "exact"},
+				{Key: "name", Values: []string{"www"}, MatchBy: "exact"},
+			},
+			"name=www.example.com&type=A",
+		},
+		{
+			"ByNameRegex",
+			[]datalist.Filter{{Key: "name", Values: []string{"^www"}, MatchBy: "re"}},
+			"",
+		},
+		{
+			"ByAnyOfSeveralTypes",
+			[]datalist.Filter{{Key: "type", Values: []string{"A", "AAAA"}, MatchBy: "exact"}},
+			"",
+		},
+		{
+			"ByTypeAndNameRegex",
+			[]datalist.Filter{
+				{Key: "type", Values: []string{"A"}, MatchBy: "exact"},
+				{Key: "name", Values: []string{"^www"}, MatchBy: "re"},
+			},
+			"type=A",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var query url.Values
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/domains/example.com/records" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				query = r.URL.Query()
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"domain_records":[{"id":1,"type":"A","name":"www"}],"links":{}}`)
+			})).GodoClient()
+
+			records, err := listDigitalOceanRecords("example.com", recordListFuncForFilters(client, "example.com", tt.filters), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(records) != 1 {
+				t.Fatalf("expected 1 record, got %d", len(records))
+			}
+
+			query.Del("page")
+			query.Del("per_page")
+			if got := query.Encode(); got != tt.expected {
+				t.Errorf("expected query %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataSourceDigitalOceanRecordsRead(t *testing.T) {
+	var pages int
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprintf(w, `{"domain_records":[
+				{"id":1,"type":"A","name":"www","data":"192.0.2.1","ttl":1800},
+				{"id":2,"type":"CNAME","name":"www-old","data":"www.example.com","ttl":1800},
+				{"id":3,"type":"A","name":"api","data":"192.0.2.2","ttl":1800}],
+				"links":{"pages":{"next":"%[1]s/v2/domains/example.com/records?page=2","last":"%[1]s/v2/domains/example.com/records?page=2"}}}`, "http://"+r.Host)
+			return
+		}
+		fmt.Fprintf(w, `{"domain_records":[
+			{"id":4,"type":"A","name":"www2","data":"192.0.2.3","ttl":1800},
+			{"id":5,"type":"MX","name":"@","data":"mail.example.com","priority":10,"ttl":1800}],
+			"links":{"pages":{"prev":"%[1]s/v2/domains/example.com/records?page=1","first":"%[1]s/v2/domains/example.com/records?page=1"}}}`, "http://"+r.Host)
+	}))
+
+	// All of the A records with a name matching a regular expression.
+	r := DataSourceDigitalOceanRecords()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"domain": "example.com",
+		"filter": []interface{}{
+			map[string]interface{}{"key": "type", "values": []interface{}{"A", "AAAA"}},
+			map[string]interface{}{"key": "name", "values": []interface{}{"^www"}, "match_by": "re"},
+		},
+	})
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	if pages != 2 {
+		t.Errorf("expected 2 pages to be retrieved, got %d", pages)
+	}
+
+	var ids []int
+	for _, record := range d.Get("records").([]interface{}) {
+		ids = append(ids, record.(map[string]interface{})["id"].(int))
+	}
+	if !reflect.DeepEqual(ids, []int{1, 4}) {
+		t.Errorf("expected records [1 4], got %v", ids)
+	}
+	if v := d.Get("records.1.value").(string); v != "192.0.2.3" {
+		t.Errorf("expected value 192.0.2.3, got %s", v)
+	}
+	if v := d.Get("records.1.domain").(string); v != "example.com" {
+		t.Errorf("expected domain example.com, got %s", v)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -54,9 +55,7 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					domain := d.Get("domain").(string) + "."
-
-					return (old == "@" && new == domain) || (old+"."+domain == new)
+					return old != "" && recordNamesMatch(d.Get("domain").(string), old, new)
 				},
 			},
 
@@ -89,9 +88,7 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					domain := d.Get("domain").(string) + "."
-
-					return (old == "@" && new == domain) || (old == new+"."+domain)
+					return old != "" && recordValuesMatch(d.Get("domain").(string), d.Get("type").(string), new, old)
 				},
 			},
 
@@ -115,6 +112,20 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 					"iodef",
 				}, false),
 			},
+
+			"allow_apex_ns_deletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the record may be deleted or changed if it is an NS record at the apex of the domain pointing at DigitalOcean's nameservers.",
+			},
+
+			"allow_overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether an existing record with the same name and type is adopted and updated if the record cannot be created because it conflicts with it.",
+			},
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -148,7 +159,19 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 				}
 			}
 
-			return nil
+			// Catch changes that would replace or rewrite a protected apex NS
+			// record at plan time. Destroying it is checked on delete.
+			if diff.Id() != "" && !diff.Get("allow_apex_ns_deletion").(bool) && diff.HasChanges("type", "domain", "name", "value") {
+				oldDomain, _ := diff.GetChange("domain")
+				oldType, _ := diff.GetChange("type")
+				oldName, _ := diff.GetChange("name")
+				oldValue, _ := diff.GetChange("value")
+				if isDigitalOceanApexNSRecord(oldDomain.(string), oldType.(string), oldName.(string), oldValue.(string)) {
+					return apexNSRecordDeletionError(oldDomain.(string), oldValue.(string))
+				}
+			}
+
+			return customizeDiffRecordTTL(diff, v)
 		},
 	}
 }
@@ -168,10 +191,29 @@ func resourceDigitalOceanRecordCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("`port` is required for when type is `SRV`")
 	}
 
+	domain := d.Get("domain").(string)
+
 	log.Printf("[DEBUG] record create configuration: %#v", newRecord)
-	rec, _, err := client.Domains.CreateRecord(context.Background(), d.Get("domain").(string), newRecord)
+	rec, _, err := client.Domains.CreateRecord(context.Background(), domain, newRecord)
 	if err != nil {
-		return diag.Errorf("Failed to create record: %s", err)
+		if !d.Get("allow_overwrite").(bool) || !isRecordConflict(err) {
+			return diag.Errorf("Failed to create record: %s", err)
+		}
+
+		existing, findErr := findRecordToOverwrite(ctx, client, domain, newRecord.Type, newRecord.Name, newRecord.Data)
+		if findErr != nil {
+			return diag.Errorf("Failed to create record: %s; unable to overwrite the existing record: %s", err, findErr)
+		}
+
+		if isDigitalOceanApexNSRecord(domain, existing.Type, existing.Name, existing.Data) && !d.Get("allow_apex_ns_deletion").(bool) &&
+			!recordValuesMatch(domain, existing.Type, newRecord.Data, existing.Data) {
+			return diag.FromErr(apexNSRecordDeletionError(domain, existing.Data))
+		}
+
+		log.Printf("[INFO] Overwriting existing record %d: %s", existing.ID, err)
+		d.SetId(strconv.Itoa(existing.ID))
+
+		return resourceDigitalOceanRecordUpdate(ctx, d, meta)
 	}
 
 	d.SetId(strconv.Itoa(rec.ID))
@@ -221,6 +263,11 @@ func resourceDigitalOceanRecordRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// The apex may be returned without a name.
+	if rec.Name == "" {
+		rec.Name = "@"
+	}
+
 	d.Set("name", rec.Name)
 	d.Set("type", rec.Type)
 	d.Set("value", rec.Data)
@@ -251,6 +298,9 @@ func resourceDigitalOceanRecordImport(d *schema.ResourceData, meta interface{})
 		d.Set("domain", s[0])
 	}
 
+	d.Set("allow_apex_ns_deletion", false)
+	d.Set("allow_overwrite", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -278,14 +328,19 @@ func resourceDigitalOceanRecordUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDigitalOceanRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
-
 	domain := d.Get("domain").(string)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("invalid record ID: %v", err)
 	}
 
+	value := d.Get("value").(string)
+	if isDigitalOceanApexNSRecord(domain, d.Get("type").(string), d.Get("name").(string), value) && !d.Get("allow_apex_ns_deletion").(bool) {
+		return diag.FromErr(apexNSRecordDeletionError(domain, value))
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
 	log.Printf("[INFO] Deleting record: %s, %d", domain, id)
 
 	resp, delErr := client.Domains.DeleteRecord(context.Background(), domain, id)
@@ -330,6 +385,42 @@ func expandDigitalOceanRecordResource(d *schema.ResourceData) (*godo.DomainRecor
 	return record, nil
 }
 
+// customizeDiffRecordTTL plans the default TTL of the domain for records
+// without a configured ttl, so that they are reconciled when the default is
+// changed. Explicitly configured TTLs are diffed against the record as usual.
+func customizeDiffRecordTTL(diff *schema.ResourceDiff, meta interface{}) error {
+	domain := diff.Get("domain").(string)
+	if meta == nil || domain == "" || !recordTTLOmitted(diff.GetRawConfig()) {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	zone, resp, err := client.Domains.Get(context.Background(), domain)
+	if err != nil {
+		// The domain may not have been created yet.
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving default TTL of domain %s: %s", domain, err)
+	}
+
+	if zone.TTL > 0 && diff.Get("ttl").(int) != zone.TTL {
+		return diff.SetNew("ttl", zone.TTL)
+	}
+
+	return nil
+}
+
+// recordTTLOmitted reports whether ttl is left out of the configuration, as
+// opposed to being set, possibly to the default TTL of the domain.
+func recordTTLOmitted(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("ttl") {
+		return false
+	}
+
+	return rawConfig.GetAttr("ttl").IsNull()
+}
+
 func ConstructFqdn(name, domain string) string {
 	if name == "@" {
 		return domain
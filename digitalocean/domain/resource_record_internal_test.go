@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDigitalOceanRecordDiff_TTL(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v2/domains/example.com" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+			return
+		}
+		fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":3600}}`)
+	}))
+
+	cases := []struct {
+		name     string
+		domain   string
+		stateTTL string
+		ttl      interface{}
+		expected string
+	}{
+		{"OmittedFollowsChangedDefault", "example.com", "1800", nil, "3600"},
+		{"OmittedMatchesDefault", "example.com", "3600", nil, ""},
+		{"OmittedOnCreate", "example.com", "", nil, "3600"},
+		{"OmittedWithUnknownDomain", "new.example.com", "", nil, ""},
+		{"ExplicitMatchingState", "example.com", "1800", 1800, ""},
+		{"ExplicitEditedInConsole", "example.com", "3600", 1800, "1800"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := map[string]interface{}{
+				"domain": tc.domain,
+				"type":   "A",
+				"name":   "www",
+				"value":  "192.168.0.10",
+			}
+			rawTTL := cty.NullVal(cty.Number)
+			if tc.ttl != nil {
+				cfg["ttl"] = tc.ttl
+				rawTTL = cty.NumberIntVal(int64(tc.ttl.(int)))
+			}
+
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"ttl": rawTTL}),
+			}
+			if tc.stateTTL != "" {
+				state.ID = "1234"
+				state.Attributes = map[string]string{
+					"id":                     "1234",
+					"domain":                 tc.domain,
+					"type":                   "A",
+					"name":                   "www",
+					"value":                  "192.168.0.10",
+					"ttl":                    tc.stateTTL,
+					"allow_apex_ns_deletion": "false",
+				}
+			}
+
+			diff, err := ResourceDigitalOceanRecord().Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attr *terraform.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes["ttl"]
+			}
+			if tc.expected == "" {
+				if attr != nil && !attr.NewComputed && attr.Old != attr.New {
+					t.Errorf("expected no diff for ttl, got %#v", attr)
+				}
+				return
+			}
+			if attr == nil || attr.New != tc.expected {
+				t.Errorf("expected ttl to be planned as %s, got %#v", tc.expected, attr)
+			}
+		})
+	}
+}
@@ -587,6 +587,52 @@ func TestAccDigitalOceanRecord_TXT(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanRecord_AllowOverwrite(t *testing.T) {
+	var record godo.DomainRecord
+	var externalID int
+	domain := acceptance.RandomTestName() + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanRecordDestroy,
+		Steps: []resource.TestStep{
+			// Create a CNAME outside of Terraform, as external-dns would.
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordConfig_domainOnly, domain),
+				Check: func(s *terraform.State) error {
+					client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+					rec, _, err := client.Domains.CreateRecord(context.Background(), domain, &godo.DomainRecordEditRequest{
+						Type: "CNAME",
+						Name: "terraform",
+						Data: "a.foobar-test-terraform.com.",
+					})
+					if err != nil {
+						return err
+					}
+					externalID = rec.ID
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordConfig_allowOverwrite, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanRecordExists("digitalocean_record.foobar", &record),
+					testAccCheckDigitalOceanRecordAttributesHostname("b.foobar-test-terraform.com", &record),
+					func(s *terraform.State) error {
+						if record.ID != externalID {
+							return fmt.Errorf("expected the existing record %d to be adopted, got %d", externalID, record.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"digitalocean_record.foobar", "value", "b.foobar-test-terraform.com."),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanRecord_ExpectedErrors(t *testing.T) {
 	var (
 		srvNoPort = `resource "digitalocean_record" "pgsql_default_pub_srv" {
@@ -990,3 +1036,22 @@ resource "digitalocean_record" "txt" {
   name   = "%s."
   value  = "v=spf1 a:smtp01.example.com a:mail.example.com -all"
 }`
+
+const testAccCheckDigitalOceanRecordConfig_domainOnly = `
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}`
+
+const testAccCheckDigitalOceanRecordConfig_allowOverwrite = `
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_record" "foobar" {
+  domain = digitalocean_domain.foobar.name
+
+  name            = "terraform"
+  value           = "b.foobar-test-terraform.com."
+  type            = "CNAME"
+  allow_overwrite = true
+}`
@@ -0,0 +1,196 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dropletAssociatedResource is a resource that is destroyed along with the
+// Droplet.
+type dropletAssociatedResource struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	DestroyedAt  string `json:"destroyed_at,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+type dropletAssociatedResources struct {
+	ReservedIPs     []dropletAssociatedResource `json:"reserved_ips"`
+	FloatingIPs     []dropletAssociatedResource `json:"floating_ips"`
+	Snapshots       []dropletAssociatedResource `json:"snapshots"`
+	Volumes         []dropletAssociatedResource `json:"volumes"`
+	VolumeSnapshots []dropletAssociatedResource `json:"volume_snapshots"`
+}
+
+// byKind returns the associated resources keyed by a description of their
+// kind. Reserved IPs are also returned as floating IPs, so only the former
+// are included.
+func (r *dropletAssociatedResources) byKind() map[string][]dropletAssociatedResource {
+	return map[string][]dropletAssociatedResource{
+		"reserved IP":     r.ReservedIPs,
+		"snapshot":        r.Snapshots,
+		"volume":          r.Volumes,
+		"volume snapshot": r.VolumeSnapshots,
+	}
+}
+
+type dropletAssociatedResourcesDestroyStatus struct {
+	Resources   *dropletAssociatedResources `json:"resources"`
+	CompletedAt string                      `json:"completed_at"`
+	Failures    int                         `json:"failures"`
+}
+
+// associatedResourcesPath is the path of the endpoints that destroy a Droplet
+// with its associated resources, which have no godo methods.
+func associatedResourcesPath(id int) string {
+	return fmt.Sprintf("/v2/droplets/%d/destroy_with_associated_resources", id)
+}
+
+func listDropletAssociatedResources(ctx context.Context, client *godo.Client, id int) (*dropletAssociatedResources, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, associatedResourcesPath(id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletAssociatedResources)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+func deleteDropletWithAssociatedResourcesDangerous(ctx context.Context, client *godo.Client, id int) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodDelete, associatedResourcesPath(id)+"/dangerous", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Dangerous", "true")
+
+	return client.Do(ctx, req, nil)
+}
+
+func getDropletAssociatedResourcesDestroyStatus(ctx context.Context, client *godo.Client, id int) (*dropletAssociatedResourcesDestroyStatus, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, associatedResourcesPath(id)+"/status", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletAssociatedResourcesDestroyStatus)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// destroyDropletWithAssociatedResources destroys the Droplet along with all of
+// its associated resources and waits for the destruction to complete. As the
+// associated resources may also be managed by Terraform, a warning listing
+// them is returned so that they can be removed from state.
+func destroyDropletWithAssociatedResources(ctx context.Context, d *schema.ResourceData, client *godo.Client, id int) diag.Diagnostics {
+	associated, resp, err := listDropletAssociatedResources(ctx, client, id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("Error retrieving the resources associated with droplet (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting droplet with its associated resources: %s", d.Id())
+	resp, err = deleteDropletWithAssociatedResourcesDangerous(ctx, client, id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("Error deleting droplet (%s) with its associated resources: %s", d.Id(), err)
+	}
+
+	var status *dropletAssociatedResourcesDestroyStatus
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		status, _, err = getDropletAssociatedResourcesDestroyStatus(ctx, client, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if status.CompletedAt == "" {
+			log.Printf("[DEBUG] Waiting for droplet (%s) and its associated resources to be destroyed", d.Id())
+			return retry.RetryableError(fmt.Errorf("droplet (%s) and its associated resources are still being destroyed", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("Error waiting for droplet (%s) and its associated resources to be destroyed: %s", d.Id(), err)
+	}
+
+	if status.Failures > 0 {
+		return diag.Errorf("Error destroying the resources associated with droplet (%s):\n%s", d.Id(),
+			strings.Join(describeDropletAssociatedResources(status.Resources, true), "\n"))
+	}
+
+	if destroyed := describeDropletAssociatedResources(associated, false); len(destroyed) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Destroyed the resources associated with droplet (%s)", d.Id()),
+			Detail: "The following resources were destroyed along with the Droplet. Any of them that are managed by Terraform " +
+				"no longer exist and should be removed from state:\n" + strings.Join(destroyed, "\n"),
+		}}
+	}
+
+	return nil
+}
+
+// describeDropletAssociatedResources returns a sorted description of each of
+// the associated resources. If failed is true, only those that could not be
+// destroyed are described, along with the reason.
+func describeDropletAssociatedResources(associated *dropletAssociatedResources, failed bool) []string {
+	var descriptions []string
+	if associated == nil {
+		return descriptions
+	}
+
+	for kind, resources := range associated.byKind() {
+		for _, r := range resources {
+			if !failed {
+				descriptions = append(descriptions, fmt.Sprintf("- %s %s (%s)", kind, r.Name, r.ID))
+			} else if r.ErrorMessage != "" {
+				descriptions = append(descriptions, fmt.Sprintf("- %s %s (%s): %s", kind, r.Name, r.ID, r.ErrorMessage))
+			}
+		}
+	}
+	sort.Strings(descriptions)
+
+	return descriptions
+}
+
+// validateDropletDestroyWithAssociatedResources refuses to destroy the
+// associated resources of a Droplet when volumes are attached through
+// volume_ids. Those volumes are managed by Terraform in the same configuration,
+// and would otherwise be destroyed twice. Other associated resources managed by
+// Terraform can not be detected, and are listed in a warning when destroyed.
+func validateDropletDestroyWithAssociatedResources(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("destroy_with_associated_resources").(bool) {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("volume_ids") {
+		return nil
+	}
+
+	if volumeIDs := rawConfig.GetAttr("volume_ids"); !volumeIDs.IsNull() && (!volumeIDs.IsKnown() || volumeIDs.LengthInt() > 0) {
+		return fmt.Errorf("destroy_with_associated_resources can not be used with volume_ids, as the volumes would be destroyed both with the Droplet and by Terraform")
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDestroyDropletWithAssociatedResources(t *testing.T) {
+	testCases := []struct {
+		name             string
+		status           string
+		expectedSeverity diag.Severity
+		expectedMsg      string
+	}{
+		{
+			"Success",
+			`{"resources":{"reserved_ips":[{"id":"6186916","name":"45.55.96.47","destroyed_at":"2020-04-01T18:11:49Z"}],"volumes":[{"id":"ba49449a-7435-11ea-b89e-0a58ac14480f","name":"volume-nyc1-01","destroyed_at":"2020-04-01T18:11:49Z"}]},"completed_at":"2020-04-01T18:11:49Z","failures":0}`,
+			diag.Warning,
+			"- reserved IP 45.55.96.47 (6186916)\n- volume volume-nyc1-01 (ba49449a-7435-11ea-b89e-0a58ac14480f)",
+		},
+		{
+			"Failure",
+			`{"resources":{"reserved_ips":[{"id":"6186916","name":"45.55.96.47","destroyed_at":"2020-04-01T18:11:49Z"}],"volumes":[{"id":"ba49449a-7435-11ea-b89e-0a58ac14480f","name":"volume-nyc1-01","error_message":"volume is in use"}]},"completed_at":"2020-04-01T18:11:49Z","failures":1}`,
+			diag.Error,
+			"- volume volume-nyc1-01 (ba49449a-7435-11ea-b89e-0a58ac14480f): volume is in use",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusRequests := 0
+			deleted := false
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/droplets/1234/destroy_with_associated_resources":
+					fmt.Fprint(w, `{"reserved_ips":[{"id":"6186916","name":"45.55.96.47"}],"floating_ips":[{"id":"6186916","name":"45.55.96.47"}],"snapshots":[],"volumes":[{"id":"ba49449a-7435-11ea-b89e-0a58ac14480f","name":"volume-nyc1-01"}],"volume_snapshots":[]}`)
+				case r.Method == http.MethodDelete && r.URL.Path == "/v2/droplets/1234/destroy_with_associated_resources/dangerous":
+					if r.Header.Get("X-Dangerous") != "true" {
+						t.Error("expected the X-Dangerous header to be set")
+					}
+					deleted = true
+					w.WriteHeader(http.StatusAccepted)
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/droplets/1234/destroy_with_associated_resources/status":
+					statusRequests++
+					if statusRequests == 1 {
+						fmt.Fprint(w, `{"resources":{},"completed_at":"","failures":0}`)
+						return
+					}
+					fmt.Fprint(w, tc.status)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})).GodoClient()
+
+			d := ResourceDigitalOceanDroplet().TestResourceData()
+			d.SetId("1234")
+
+			diags := destroyDropletWithAssociatedResources(context.Background(), d, client, 1234)
+			if !deleted {
+				t.Error("expected the droplet to be deleted with its associated resources")
+			}
+			if statusRequests != 2 {
+				t.Errorf("expected the status to be polled until completion, got %d requests", statusRequests)
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected a single diagnostic, got %#v", diags)
+			}
+			if diags[0].Severity != tc.expectedSeverity {
+				t.Errorf("expected severity %v, got %v", tc.expectedSeverity, diags[0].Severity)
+			}
+			if msg := diags[0].Summary + diags[0].Detail; !strings.Contains(msg, tc.expectedMsg) {
+				t.Errorf("expected the diagnostic to contain %q, got %q", tc.expectedMsg, msg)
+			}
+		})
+	}
+}
+
+func TestDestroyDropletWithAssociatedResourcesNotFound(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+	})).GodoClient()
+
+	d := ResourceDigitalOceanDroplet().TestResourceData()
+	d.SetId("1234")
+
+	if diags := destroyDropletWithAssociatedResources(context.Background(), d, client, 1234); diags != nil {
+		t.Errorf("expected an already destroyed droplet to be ignored, got %#v", diags)
+	}
+}
+
+func TestValidateDropletDestroyWithAssociatedResources(t *testing.T) {
+	testCases := []struct {
+		name        string
+		volumeIDs   []interface{}
+		destroy     bool
+		expectedErr bool
+	}{
+		{"NoVolumes", nil, true, false},
+		{"VolumesWithoutDestroy", []interface{}{"ba49449a-7435-11ea-b89e-0a58ac14480f"}, false, false},
+		{"VolumesWithDestroy", []interface{}{"ba49449a-7435-11ea-b89e-0a58ac14480f"}, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := map[string]interface{}{
+				"name":                              "foo",
+				"image":                             "ubuntu-22-04-x64",
+				"size":                              "s-1vcpu-1gb",
+				"region":                            "nyc3",
+				"destroy_with_associated_resources": tc.destroy,
+			}
+			rawVolumeIDs := cty.NullVal(cty.Set(cty.String))
+			if tc.volumeIDs != nil {
+				cfg["volume_ids"] = tc.volumeIDs
+				var ids []cty.Value
+				for _, id := range tc.volumeIDs {
+					ids = append(ids, cty.StringVal(id.(string)))
+				}
+				rawVolumeIDs = cty.SetVal(ids)
+			}
+
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"volume_ids": rawVolumeIDs}),
+			}
+
+			_, err := ResourceDigitalOceanDroplet().Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), nil)
+			if tc.expectedErr {
+				if err == nil || !strings.Contains(err.Error(), "volume_ids") {
+					t.Fatalf("expected an error about volume_ids, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
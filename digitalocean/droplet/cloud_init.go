@@ -0,0 +1,169 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// cloudInitBootFinished is written by cloud-init once it has finished
+	// running all of its modules.
+	cloudInitBootFinished = "/var/lib/cloud/instance/boot-finished"
+	cloudInitOutputLog    = "/var/log/cloud-init-output.log"
+
+	cloudInitOutputLines  = 20
+	cloudInitPollInterval = 10 * time.Second
+)
+
+// cloudInitExecutor runs a command on a Droplet and returns its output. An
+// error is returned if the command could not be run or exited unsuccessfully.
+type cloudInitExecutor interface {
+	Run(ctx context.Context, command string) (string, error)
+}
+
+// waitForCloudInit polls the Droplet until cloud-init has finished, or the
+// timeout is reached. Failing to connect is retried, as SSH may not be
+// available until part way through boot. On timeout, the error includes the
+// end of the cloud-init output, if it can be retrieved.
+func waitForCloudInit(ctx context.Context, executor cloudInitExecutor, timeout, pollInterval time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		_, err := executor.Run(ctx, "test -f "+cloudInitBootFinished)
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		log.Printf("[DEBUG] cloud-init has not finished yet: %s", err)
+
+		select {
+		case <-ctx.Done():
+			return cloudInitTimeoutError(executor, timeout, lastErr)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func cloudInitTimeoutError(executor cloudInitExecutor, timeout time.Duration, lastErr error) error {
+	msg := fmt.Sprintf("timeout after %s waiting for cloud-init to finish (last error: %s)", timeout, lastErr)
+
+	// The original context has expired, so allow a little longer to retrieve
+	// the output.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output, err := executor.Run(ctx, fmt.Sprintf("tail -n %d %s", cloudInitOutputLines, cloudInitOutputLog))
+	if err != nil || strings.TrimSpace(output) == "" {
+		return fmt.Errorf("%s", msg)
+	}
+
+	return fmt.Errorf("%s\n\nLast lines of %s:\n%s", msg, cloudInitOutputLog, strings.TrimRight(output, "\n"))
+}
+
+// sshCloudInitExecutor runs commands over SSH, connecting for each command so
+// that reboots during provisioning are tolerated.
+type sshCloudInitExecutor struct {
+	address string
+	config  *ssh.ClientConfig
+}
+
+func newSSHCloudInitExecutor(host string, port int, user, privateKey string) (*sshCloudInitExecutor, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private_key: %s", err)
+	}
+
+	return &sshCloudInitExecutor{
+		address: net.JoinHostPort(host, strconv.Itoa(port)),
+		config: &ssh.ClientConfig{
+			User: user,
+			Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			// The Droplet has just been created, so its host key can not be
+			// known in advance.
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			Timeout:         15 * time.Second,
+		},
+	}, nil
+}
+
+func (e *sshCloudInitExecutor) Run(ctx context.Context, command string) (string, error) {
+	dialer := net.Dialer{Timeout: e.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", e.address)
+	if err != nil {
+		return "", err
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, e.address, e.config)
+	if err != nil {
+		conn.Close()
+		return "", err
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	// Closing the client interrupts the command if the context is done first.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			client.Close()
+		case <-done:
+		}
+	}()
+
+	output, err := session.CombinedOutput(command)
+	return string(output), err
+}
+
+// waitForDropletCloudInit waits for cloud-init to finish on a new Droplet,
+// if wait_for_cloud_init is set.
+func waitForDropletCloudInit(ctx context.Context, d *schema.ResourceData) error {
+	if !d.Get("wait_for_cloud_init").(bool) {
+		return nil
+	}
+
+	connection := d.Get("cloud_init_connection").([]interface{})
+	if len(connection) == 0 || connection[0] == nil {
+		return fmt.Errorf("cloud_init_connection must be set when wait_for_cloud_init is true")
+	}
+	conn := connection[0].(map[string]interface{})
+
+	host := conn["host"].(string)
+	if host == "" {
+		host = d.Get("ipv4_address").(string)
+	}
+	if host == "" {
+		return fmt.Errorf("the droplet does not have a public IPv4 address to connect to, set cloud_init_connection.host")
+	}
+
+	executor, err := newSSHCloudInitExecutor(host, conn["port"].(int), conn["user"].(string), conn["private_key"].(string))
+	if err != nil {
+		return err
+	}
+
+	timeout, err := time.ParseDuration(d.Get("cloud_init_timeout").(string))
+	if err != nil {
+		return fmt.Errorf("invalid cloud_init_timeout: %s", err)
+	}
+
+	log.Printf("[INFO] Waiting for cloud-init to finish on droplet (%s)", d.Id())
+	return waitForCloudInit(ctx, executor, timeout, cloudInitPollInterval)
+}
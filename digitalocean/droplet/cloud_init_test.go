@@ -0,0 +1,147 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type fakeCloudInitExecutor struct {
+	// finishedAfter is the number of checks after which cloud-init is
+	// reported as finished, or -1 if it never finishes.
+	finishedAfter int
+	output        string
+	checks        int
+	commands      []string
+}
+
+func (e *fakeCloudInitExecutor) Run(ctx context.Context, command string) (string, error) {
+	e.commands = append(e.commands, command)
+
+	if strings.HasPrefix(command, "tail ") {
+		return e.output, nil
+	}
+
+	e.checks++
+	if e.checks <= 1 {
+		return "", fmt.Errorf("dial tcp: connection refused")
+	}
+	if e.finishedAfter >= 0 && e.checks >= e.finishedAfter {
+		return "", nil
+	}
+
+	return "", fmt.Errorf("Process exited with status 1")
+}
+
+func TestWaitForCloudInit(t *testing.T) {
+	executor := &fakeCloudInitExecutor{finishedAfter: 3}
+
+	err := waitForCloudInit(context.Background(), executor, time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if executor.checks != 3 {
+		t.Errorf("expected 3 checks, got %d", executor.checks)
+	}
+	for _, c := range executor.commands {
+		if c != "test -f /var/lib/cloud/instance/boot-finished" {
+			t.Errorf("unexpected command: %s", c)
+		}
+	}
+}
+
+func TestWaitForCloudInit_timeout(t *testing.T) {
+	executor := &fakeCloudInitExecutor{
+		finishedAfter: -1,
+		output:        "Cloud-init v. 23.1 running 'modules:final'\nE: Unable to locate package nosuchpackage\n",
+	}
+
+	err := waitForCloudInit(context.Background(), executor, 50*time.Millisecond, time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, expected := range []string{
+		"timeout after 50ms waiting for cloud-init to finish",
+		"Process exited with status 1",
+		"Last lines of /var/log/cloud-init-output.log:",
+		"E: Unable to locate package nosuchpackage",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %s", expected, err)
+		}
+	}
+
+	last := executor.commands[len(executor.commands)-1]
+	if last != "tail -n 20 /var/log/cloud-init-output.log" {
+		t.Errorf("unexpected final command: %s", last)
+	}
+}
+
+func TestWaitForCloudInit_timeoutWithoutOutput(t *testing.T) {
+	executor := &fakeCloudInitExecutor{finishedAfter: -1}
+
+	err := waitForCloudInit(context.Background(), executor, 10*time.Millisecond, time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), "Last lines") {
+		t.Errorf("expected no cloud-init output in error, got: %s", err)
+	}
+}
+
+func TestValidateDropletCloudInit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		expectError bool
+	}{
+		{
+			"NotWaiting",
+			map[string]interface{}{},
+			false,
+		},
+		{
+			"WaitingWithoutConnection",
+			map[string]interface{}{"wait_for_cloud_init": true},
+			true,
+		},
+		{
+			"WaitingWithConnection",
+			map[string]interface{}{
+				"wait_for_cloud_init": true,
+				"cloud_init_connection": []interface{}{
+					map[string]interface{}{"private_key": "key"},
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"name":   "foo",
+				"image":  "ubuntu-22-04-x64",
+				"size":   "s-1vcpu-1gb",
+				"region": "nyc3",
+			}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			_, err := ResourceDigitalOceanDroplet().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tt.expectError && (err == nil || !strings.Contains(err.Error(), "cloud_init_connection must be set")) {
+				t.Errorf("expected an error, got: %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -3,10 +3,13 @@ package droplet
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +28,8 @@ func DataSourceDigitalOceanDroplet() *schema.Resource {
 	recordSchema["name"].ExactlyOneOf = []string{"id", "tag", "name"}
 	recordSchema["name"].Optional = true
 
+	recordSchema["gpu_info"] = size.GPUInfoSchema()
+
 	recordSchema["tag"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -45,8 +50,11 @@ func dataSourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceDa
 	var foundDroplet godo.Droplet
 
 	if id, ok := d.GetOk("id"); ok {
-		droplet, _, err := client.Droplets.Get(context.Background(), id.(int))
+		droplet, resp, err := client.Droplets.Get(context.Background(), id.(int))
 		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return diag.Errorf("no droplet found with id %d", id.(int))
+			}
 			return diag.FromErr(err)
 		}
 
@@ -64,12 +72,17 @@ func dataSourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceDa
 
 		foundDroplet = *droplet
 	} else if v, ok := d.GetOk("name"); ok {
-		dropletList, err := getDigitalOceanDroplets(meta, nil)
+		// Only the Droplets with exactly this name are listed, rather than
+		// paging through all of them.
+		name := v.(string)
+		dropletList, err := listDigitalOceanDroplets(func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+			return client.Droplets.ListByName(ctx, name, opts)
+		}, nil)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		droplet, err := findDropletByName(dropletList, v.(string))
+		droplet, err := findDropletByName(dropletList, name)
 
 		if err != nil {
 			return diag.FromErr(err)
@@ -89,6 +102,10 @@ func dataSourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("gpu_info", flattenDropletGPUInfo(&foundDroplet)); err != nil {
+		return diag.Errorf("Error setting gpu_info: %s", err)
+	}
+
 	d.SetId(strconv.Itoa(foundDroplet.ID))
 	return nil
 }
@@ -107,7 +124,15 @@ func findDropletByName(droplets []interface{}, name string) (*godo.Droplet, erro
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no droplet found with name %s", name)
 	}
-	return nil, fmt.Errorf("too many droplets found with name %s (found %d, expected 1)", name, len(results))
+	candidates := make([]string, 0, len(results))
+	for _, droplet := range results {
+		candidate := strconv.Itoa(droplet.ID)
+		if droplet.Region != nil {
+			candidate += fmt.Sprintf(" (%s)", droplet.Region.Slug)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return nil, fmt.Errorf("too many droplets found with name %s (found %d, expected 1), use id to select one of: %s", name, len(results), strings.Join(candidates, ", "))
 }
 
 func findDropletByTag(droplets []interface{}, tag string) (*godo.Droplet, error) {
@@ -0,0 +1,84 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanDropletReadByName(t *testing.T) {
+	testCases := []struct {
+		name             string
+		droplets         string
+		expectedID       string
+		expectedGPUModel string
+		expectedErrorMsg string
+	}{
+		{
+			"Exact",
+			`[{"id":1,"name":"web","size_slug":"s-1vcpu-1gb","size":{"slug":"s-1vcpu-1gb"},"region":{"slug":"nyc3"},"image":{"slug":"ubuntu-22-04-x64"},"networks":{}}]`,
+			"1",
+			"",
+			"",
+		},
+		{
+			// The GPUs are read from the size embedded in the Droplet.
+			"GPU",
+			`[{"id":2,"name":"web","size_slug":"gpu-h100x1-80gb","size":{"slug":"gpu-h100x1-80gb","gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}},"region":{"slug":"tor1"},"image":{"slug":"gpu-h100x1-base"},"networks":{}}]`,
+			"2",
+			"nvidia_h100",
+			"",
+		},
+		{
+			"NotFound",
+			`[]`,
+			"",
+			"",
+			"no droplet found with name web",
+		},
+		{
+			"Ambiguous",
+			`[{"id":1,"name":"web","region":{"slug":"nyc3"}},{"id":2,"name":"web","region":{"slug":"ams3"}}]`,
+			"",
+			"",
+			"too many droplets found with name web (found 2, expected 1), use id to select one of: 1 (nyc3), 2 (ams3)",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/droplets" || r.URL.Query().Get("name") != "web" {
+					t.Errorf("expected droplets to be listed by name, got: %s", r.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"droplets":%s,"links":{}}`, tt.droplets)
+			}))
+
+			d := schema.TestResourceDataRaw(t, DataSourceDigitalOceanDroplet().Schema, map[string]interface{}{
+				"name": "web",
+			})
+
+			diags := dataSourceDigitalOceanDropletRead(context.Background(), d, meta)
+			if tt.expectedErrorMsg == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if d.Id() != tt.expectedID {
+					t.Errorf("expected droplet %s, got %s", tt.expectedID, d.Id())
+				}
+				if model := d.Get("gpu_info.0.model"); tt.expectedGPUModel != "" && model != tt.expectedGPUModel {
+					t.Errorf("expected GPU model %s, got %v", tt.expectedGPUModel, model)
+				}
+				return
+			}
+
+			if !diags.HasError() || diags[0].Summary != tt.expectedErrorMsg {
+				t.Errorf("expected error %q, got: %v", tt.expectedErrorMsg, diags)
+			}
+		})
+	}
+}
@@ -16,7 +16,8 @@ import (
 func TestAccDataSourceDigitalOceanDroplet_BasicByName(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
-	resourceConfig := testAccCheckDataSourceDigitalOceanDropletConfig_basicByName(name)
+	region := acceptance.TestAccRegion(t, defaultSize, "ipv6")
+	resourceConfig := testAccCheckDataSourceDigitalOceanDropletConfig_basicByName(name, region)
 	dataSourceConfig := `
 data "digitalocean_droplet" "foobar" {
   name = digitalocean_droplet.foo.name
@@ -38,7 +39,7 @@ data "digitalocean_droplet" "foobar" {
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "image", "ubuntu-22-04-x64"),
 					resource.TestCheckResourceAttr(
-						"data.digitalocean_droplet.foobar", "region", "nyc3"),
+						"data.digitalocean_droplet.foobar", "region", region),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "ipv6", "true"),
 					resource.TestCheckResourceAttr(
@@ -55,13 +56,14 @@ data "digitalocean_droplet" "foobar" {
 func TestAccDataSourceDigitalOceanDroplet_BasicById(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "ipv6")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicById(name),
+				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicById(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceDigitalOceanDropletExists("data.digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -69,7 +71,7 @@ func TestAccDataSourceDigitalOceanDroplet_BasicById(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "image", "ubuntu-22-04-x64"),
 					resource.TestCheckResourceAttr(
-						"data.digitalocean_droplet.foobar", "region", "nyc3"),
+						"data.digitalocean_droplet.foobar", "region", region),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "ipv6", "true"),
 					resource.TestCheckResourceAttr(
@@ -86,19 +88,20 @@ func TestAccDataSourceDigitalOceanDroplet_BasicByTag(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
 	tagName := acceptance.RandomTestName("tag")
+	region := acceptance.TestAccRegion(t, defaultSize, "ipv6")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicWithTag(tagName, name),
+				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicWithTag(tagName, name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foo", &droplet),
 				),
 			},
 			{
-				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicByTag(tagName, name),
+				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicByTag(tagName, name, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceDigitalOceanDropletExists("data.digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -106,7 +109,7 @@ func TestAccDataSourceDigitalOceanDroplet_BasicByTag(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "image", "ubuntu-22-04-x64"),
 					resource.TestCheckResourceAttr(
-						"data.digitalocean_droplet.foobar", "region", "nyc3"),
+						"data.digitalocean_droplet.foobar", "region", region),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet.foobar", "ipv6", "true"),
 					resource.TestCheckResourceAttr(
@@ -156,40 +159,40 @@ func testAccCheckDataSourceDigitalOceanDropletExists(n string, droplet *godo.Dro
 	}
 }
 
-func testAccCheckDataSourceDigitalOceanDropletConfig_basicByName(name string) string {
+func testAccCheckDataSourceDigitalOceanDropletConfig_basicByName(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_vpc" "foobar" {
   name   = "%s"
-  region = "nyc3"
+  region = "%s"
 }
 
 resource "digitalocean_droplet" "foo" {
   name     = "%s"
   size     = "%s"
   image    = "%s"
-  region   = "nyc3"
+  region   = "%s"
   ipv6     = true
   vpc_uuid = digitalocean_vpc.foobar.id
-}`, acceptance.RandomTestName(), name, defaultSize, defaultImage)
+}`, acceptance.RandomTestName(), region, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDataSourceDigitalOceanDropletConfig_basicById(name string) string {
+func testAccCheckDataSourceDigitalOceanDropletConfig_basicById(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foo" {
   name   = "%s"
   size   = "%s"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
   ipv6   = true
 }
 
 data "digitalocean_droplet" "foobar" {
   id = digitalocean_droplet.foo.id
 }
-`, name, defaultSize, defaultImage)
+`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDataSourceDigitalOceanDropletConfig_basicWithTag(tagName string, name string) string {
+func testAccCheckDataSourceDigitalOceanDropletConfig_basicWithTag(tagName, name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "foo" {
   name = "%s"
@@ -199,14 +202,14 @@ resource "digitalocean_droplet" "foo" {
   name   = "%s"
   size   = "%s"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
   ipv6   = true
   tags   = [digitalocean_tag.foo.id]
 }
-`, tagName, name, defaultSize, defaultImage)
+`, tagName, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDataSourceDigitalOceanDropletConfig_basicByTag(tagName string, name string) string {
+func testAccCheckDataSourceDigitalOceanDropletConfig_basicByTag(tagName, name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "foo" {
   name = "%s"
@@ -216,7 +219,7 @@ resource "digitalocean_droplet" "foo" {
   name   = "%s"
   size   = "%s"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
   ipv6   = true
   tags   = [digitalocean_tag.foo.id]
 }
@@ -224,5 +227,5 @@ resource "digitalocean_droplet" "foo" {
 data "digitalocean_droplet" "foobar" {
   tag = digitalocean_tag.foo.id
 }
-`, tagName, name, defaultSize, defaultImage)
+`, tagName, name, defaultSize, defaultImage, region)
 }
@@ -10,6 +10,7 @@ func DataSourceDigitalOceanDroplets() *schema.Resource {
 		RecordSchema:        dropletSchema(),
 		ResultAttributeName: "droplets",
 		GetRecords:          getDigitalOceanDroplets,
+		GetFilteredRecords:  getDigitalOceanDropletsFiltered,
 		FlattenRecord:       flattenDigitalOceanDroplet,
 	}
 
@@ -11,22 +11,23 @@ import (
 func TestAccDataSourceDigitalOceanDroplets_Basic(t *testing.T) {
 	name1 := acceptance.RandomTestName("01")
 	name2 := acceptance.RandomTestName("02")
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resourcesConfig := fmt.Sprintf(`
 resource "digitalocean_droplet" "foo" {
   name   = "%s"
   size   = "%s"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
 }
 
 resource "digitalocean_droplet" "bar" {
   name   = "%s"
   size   = "%s"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
 }
-`, name1, defaultSize, defaultImage, name2, defaultSize, defaultImage)
+`, name1, defaultSize, defaultImage, region, name2, defaultSize, defaultImage, region)
 
 	datasourceConfig := fmt.Sprintf(`
 data "digitalocean_droplets" "result" {
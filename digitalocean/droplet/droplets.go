@@ -9,6 +9,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -82,6 +83,11 @@ func dropletSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Description: "the Droplets public ipv6 address",
 		},
+		"ipv6_addresses": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "all of the Droplets ipv6 addresses",
+		},
 		"ipv6_address_private": {
 			Type:        schema.TypeString,
 			Description: "the Droplets private ipv4 address",
@@ -118,6 +124,46 @@ func dropletSchema() map[string]*schema.Schema {
 func getDigitalOceanDroplets(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	return listDigitalOceanDroplets(client.Droplets.List, nil)
+}
+
+// getDigitalOceanDropletsFiltered pushes exact name and tag filters down to
+// the API where possible, so that only the matching Droplets are retrieved.
+// The remaining filters are applied to each page as it is retrieved.
+func getDigitalOceanDropletsFiltered(meta interface{}, extra map[string]interface{}, filters []datalist.Filter, matches func(record interface{}) (bool, error)) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	return listDigitalOceanDroplets(dropletListFuncForFilters(client, filters), matches)
+}
+
+type dropletListFunc func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error)
+
+// dropletListFuncForFilters returns the narrowest list endpoint that can serve
+// the filters. A Droplet can only be looked up by a single exact name or tag,
+// so filters matching any of several values fall back to listing all Droplets.
+func dropletListFuncForFilters(client *godo.Client, filters []datalist.Filter) dropletListFunc {
+	for _, f := range filters {
+		if f.Key == "name" && f.MatchBy == "exact" && len(f.Values) == 1 {
+			name := f.Values[0]
+			return func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+				return client.Droplets.ListByName(ctx, name, opts)
+			}
+		}
+	}
+
+	for _, f := range filters {
+		if f.Key == "tags" && f.MatchBy == "exact" && len(f.Values) > 0 && (f.All || len(f.Values) == 1) {
+			tag := f.Values[0]
+			return func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+				return client.Droplets.ListByTag(ctx, tag, opts)
+			}
+		}
+	}
+
+	return client.Droplets.List
+}
+
+func listDigitalOceanDroplets(list dropletListFunc, matches func(record interface{}) (bool, error)) ([]interface{}, error) {
 	opts := &godo.ListOptions{
 		Page:    1,
 		PerPage: 200,
@@ -126,13 +172,23 @@ func getDigitalOceanDroplets(meta interface{}, extra map[string]interface{}) ([]
 	var dropletList []interface{}
 
 	for {
-		droplets, resp, err := client.Droplets.List(context.Background(), opts)
+		droplets, resp, err := list(context.Background(), opts)
 
 		if err != nil {
 			return nil, fmt.Errorf("Error retrieving droplets: %s", err)
 		}
 
 		for _, droplet := range droplets {
+			if matches != nil {
+				ok, err := matches(droplet)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+
 			dropletList = append(dropletList, droplet)
 		}
 
@@ -189,6 +245,8 @@ func flattenDigitalOceanDroplet(rawDroplet, meta interface{}, extra map[string]i
 		flattenedDroplet["ipv6_address"] = strings.ToLower(publicIPv6)
 	}
 
+	flattenedDroplet["ipv6_addresses"] = FindIPv6Addrs(&droplet)
+
 	if privateIPv6 := FindIPv6AddrByType(&droplet, "private"); privateIPv6 != "" {
 		flattenedDroplet["ipv6_address_private"] = strings.ToLower(privateIPv6)
 	}
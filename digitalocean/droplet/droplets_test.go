@@ -0,0 +1,131 @@
+package droplet
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestDropletListFuncForFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filters  []datalist.Filter
+		expected string
+	}{
+		{
+			"NoFilters",
+			nil,
+			"",
+		},
+		{
+			"ByName",
+			[]datalist.Filter{{Key: "name", Values: []string{"web-1"}, MatchBy: "exact"}},
+			"name=web-1",
+		},
+		{
+			"ByNameSubstring",
+			[]datalist.Filter{{Key: "name", Values: []string{"web"}, MatchBy: "substring"}},
+			"",
+		},
+		{
+			"ByAnyOfSeveralNames",
+			[]datalist.Filter{{Key: "name", Values: []string{"web-1", "web-2"}, MatchBy: "exact"}},
+			"",
+		},
+		{
+			"ByTag",
+			[]datalist.Filter{{Key: "tags", Values: []string{"web"}, MatchBy: "exact"}},
+			"tag_name=web",
+		},
+		{
+			"ByAllTags",
+			[]datalist.Filter{{Key: "tags", Values: []string{"web", "prod"}, All: true, MatchBy: "exact"}},
+			"tag_name=web",
+		},
+		{
+			"ByAnyOfSeveralTags",
+			[]datalist.Filter{{Key: "tags", Values: []string{"web", "prod"}, MatchBy: "exact"}},
+			"",
+		},
+		{
+			"ByNameAndTag",
+			[]datalist.Filter{
+				{Key: "tags", Values: []string{"web"}, MatchBy: "exact"},
+				{Key: "name", Values: []string{"web-1"}, MatchBy: "exact"},
+			},
+			"name=web-1",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var query url.Values
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"droplets":[{"id":1,"name":"web-1"}],"links":{}}`)
+			})).GodoClient()
+
+			droplets, err := listDigitalOceanDroplets(dropletListFuncForFilters(client, tt.filters), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(droplets) != 1 {
+				t.Fatalf("expected 1 droplet, got %d", len(droplets))
+			}
+
+			query.Del("page")
+			query.Del("per_page")
+			if got := query.Encode(); got != tt.expected {
+				t.Errorf("expected query %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestListDigitalOceanDropletsMatches(t *testing.T) {
+	var pages int
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprintf(w, `{"droplets":[{"id":1},{"id":2}],"links":{"pages":{"next":"%s/v2/droplets?page=2","last":"%s/v2/droplets?page=2"}}}`, "http://"+r.Host, "http://"+r.Host)
+			return
+		}
+		fmt.Fprintf(w, `{"droplets":[{"id":3},{"id":4}],"links":{"pages":{"prev":"%s/v2/droplets?page=1","first":"%s/v2/droplets?page=1"}}}`, "http://"+r.Host, "http://"+r.Host)
+	})).GodoClient()
+
+	droplets, err := listDigitalOceanDroplets(client.Droplets.List, func(record interface{}) (bool, error) {
+		return record.(godo.Droplet).ID%2 == 0, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pages != 2 {
+		t.Errorf("expected 2 pages to be retrieved, got %d", pages)
+	}
+	if len(droplets) != 2 || droplets[0].(godo.Droplet).ID != 2 || droplets[1].(godo.Droplet).ID != 4 {
+		t.Errorf("unexpected droplets: %v", droplets)
+	}
+}
@@ -17,6 +17,7 @@ import (
 func TestAccDigitalOceanDroplet_importBasic(t *testing.T) {
 	resourceName := "digitalocean_droplet.foobar"
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -24,7 +25,7 @@ func TestAccDigitalOceanDroplet_importBasic(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 			},
 
 			{
@@ -54,6 +55,7 @@ func TestAccDigitalOceanDroplet_ImportWithNoImageSlug(t *testing.T) {
 		name            = acceptance.RandomTestName()
 		restoredName    = acceptance.RandomTestName("restored")
 	)
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -61,7 +63,7 @@ func TestAccDigitalOceanDroplet_ImportWithNoImageSlug(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					takeDropletSnapshot(t, name, &droplet, snapshotID),
@@ -70,7 +72,7 @@ func TestAccDigitalOceanDroplet_ImportWithNoImageSlug(t *testing.T) {
 		},
 	})
 
-	importConfig := testAccCheckDigitalOceanDropletConfig_fromSnapshot(t, restoredName, *snapshotID)
+	importConfig := testAccCheckDigitalOceanDropletConfig_fromSnapshot(t, restoredName, *snapshotID, region)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -120,12 +122,12 @@ func takeDropletSnapshot(t *testing.T, name string, droplet *godo.Droplet, snaps
 	}
 }
 
-func testAccCheckDigitalOceanDropletConfig_fromSnapshot(t *testing.T, name string, snapshotID int) string {
+func testAccCheckDigitalOceanDropletConfig_fromSnapshot(t *testing.T, name string, snapshotID int, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "from-snapshot" {
   name   = "%s"
   size   = "%s"
   image  = "%d"
-  region = "nyc3"
-}`, name, defaultSize, snapshotID)
+  region = "%s"
+}`, name, defaultSize, snapshotID, region)
 }
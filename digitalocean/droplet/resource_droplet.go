@@ -1,18 +1,24 @@
 package droplet
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpc"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -84,6 +90,13 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Default:  false,
 			},
 
+			"destroy_with_associated_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to also destroy the Droplet's snapshots, volumes, volume snapshots, and reserved IPs when the Droplet is destroyed",
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,6 +112,8 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed: true,
 			},
 
+			"gpu_info": size.GPUInfoSchema(),
+
 			"vcpus": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -135,12 +150,66 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed: true,
 			},
 
+			"pending_action": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The action of the Droplet that is in progress, if any. New actions are only started once it has finished.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"backups": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
+			"backup_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The backup policy for the Droplet. Requires backups to be enabled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plan": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultBackupPlan,
+							ValidateFunc: validation.StringInSlice([]string{"daily", "weekly"}, false),
+							Description:  "The backup plan, either daily or weekly.",
+						},
+						"weekday": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, false),
+							Description:  "The day of the week on which weekly backups are taken.",
+						},
+						"hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{0, 4, 8, 12, 16, 20}),
+							Description:  "The hour of the day, in UTC, at which the backup window starts.",
+						},
+					},
+				},
+			},
+
 			"ipv6": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -153,6 +222,13 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed: true,
 			},
 
+			"ipv6_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "All of the Droplet's IPv6 addresses, lowest first.",
+			},
+
 			"private_networking": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -187,11 +263,29 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 				StateFunc:    util.HashStringStateFunc(),
 				// In order to support older statefiles with fully saved user data
+				// and templates that only differ in trailing newlines.
+				DiffSuppressFunc: suppressEquivalentUserData,
+			},
+
+			"user_data_store": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      userDataStoreHash,
+				ValidateFunc: validation.StringInSlice([]string{userDataStoreHash, userDataStoreRaw}, false),
+				Description:  "How user_data is stored in state, either hash or raw.",
+				// Droplets created before this was added store a hash.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return new != "" && old == d.Get("user_data")
+					return old == "" && new == userDataStoreHash
 				},
 			},
 
+			"user_data_raw": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The full content of user_data when user_data_store is raw.",
+			},
+
 			"volume_ids": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -209,7 +303,59 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 			"droplet_agent": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+				// The agent is only installed when the Droplet is created, so
+				// changing this on an existing Droplet is an in-place update
+				// that only warns. See dropletAgentChangeWarning.
+			},
+
+			"wait_for_cloud_init": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to wait for cloud-init to finish on the Droplet before it is considered created.",
+			},
+
+			"cloud_init_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "10m",
+				ValidateFunc: validateCloudInitTimeout,
+				Description:  "How long to wait for cloud-init to finish, e.g. \"10m\".",
+			},
+
+			"cloud_init_connection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The SSH connection used to check whether cloud-init has finished.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The host to connect to. Defaults to the Droplet's public IPv4 address.",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"user": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "root",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"private_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
 			},
 
 			"tags": tag.TagsSchema(),
@@ -221,9 +367,17 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			"vpc_name": vpc.VPCNameSchema("vpc_uuid"),
 		},
 
 		CustomizeDiff: customdiff.All(
+			validateDropletBackupPolicy,
+			validateDropletResizeDisk,
+			validateDropletGPURegion,
+			validateDropletCloudInit,
+			validateDropletDestroyWithAssociatedResources,
+			customizeDiffDropletUserDataRaw,
 			// If the `ipv6` attribute is changed to `true`, we need to mark the
 			// `ipv6_address` attribute as changing in the plan. If not, the plan
 			// will become inconsistent once the address is known when referenced
@@ -244,6 +398,7 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 					return old.(bool) && !new.(bool)
 				},
 			),
+			vpc.CustomizeDiffVPCName("vpc_uuid"),
 		),
 	}
 }
@@ -310,9 +465,11 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		opts.WithDropletAgent = godo.PtrTo(attr.(bool))
 	}
 
-	if attr, ok := d.GetOk("vpc_uuid"); ok {
-		opts.VPCUUID = attr.(string)
+	vpcID, err := vpc.ExpandVPCID(ctx, d, meta, "vpc_uuid")
+	if err != nil {
+		return diag.Errorf("Error resolving vpc_name: %s", err)
 	}
+	opts.VPCUUID = vpcID
 
 	// Get configured ssh_keys
 	if v, ok := d.GetOk("ssh_keys"); ok {
@@ -325,13 +482,16 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Droplet create configuration: %#v", opts)
 
-	droplet, _, err := client.Droplets.Create(context.Background(), opts)
+	opts.BackupPolicy = expandDropletBackupPolicy(d.Get("backup_policy").([]interface{}))
+	retries := meta.(*config.CombinedConfig).DropletCreateRetries()
+	droplet, err := createDropletWithRetries(ctx, client, opts, retries)
 	if err != nil {
 		return diag.Errorf("Error creating droplet: %s", err)
 	}
 
 	// Assign the droplets id
 	d.SetId(strconv.Itoa(droplet.ID))
+	d.Set("user_data_raw", userDataRaw(opts.UserData, d.Get("user_data_store").(string)))
 	log.Printf("[INFO] Droplet ID: %s", d.Id())
 
 	// Ensure Droplet status has moved to "active."
@@ -340,6 +500,16 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error waiting for droplet (%s) to become ready: %s", d.Id(), err)
 	}
 
+	// The Droplet is left in state if this fails, and so will be tainted.
+	if err := waitForDropletCloudInit(ctx, d); err != nil {
+		return diag.Errorf("Error waiting for cloud-init to finish on droplet (%s): %s", d.Id(), err)
+	}
+
+	// The backup policy's computed fields are only known once it has been read back.
+	if opts.BackupPolicy != nil {
+		return resourceDigitalOceanDropletRead(ctx, d, meta)
+	}
+
 	// waitForDropletAttribute updates the Droplet's state and calls setDropletAttributes.
 	// So there is no need to call resourceDigitalOceanDropletRead and add additional API calls.
 	return nil
@@ -371,6 +541,33 @@ func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("gpu_info", flattenDropletGPUInfo(droplet)); err != nil {
+		return diag.Errorf("Error setting gpu_info: %s", err)
+	}
+
+	// A token without access to the actions of the droplet can still read it.
+	pendingAction, err := util.FindPendingDropletAction(ctx, client, id)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the actions of droplet (%s): %s", d.Id(), err)
+	} else if err := d.Set("pending_action", flattenDropletPendingAction(pendingAction)); err != nil {
+		return diag.Errorf("Error setting pending_action: %s", err)
+	}
+
+	// The backup policy is only read back when it is managed, so that droplets
+	// relying on the default policy do not show a diff.
+	if len(d.Get("backup_policy").([]interface{})) > 0 && d.Get("backups").(bool) {
+		policy, _, err := client.Droplets.GetBackupPolicy(ctx, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving backup policy for droplet (%s): %s", d.Id(), err)
+		}
+
+		if policy != nil && policy.BackupPolicy != nil {
+			if err := d.Set("backup_policy", flattenDropletBackupPolicy(policy.BackupPolicy)); err != nil {
+				return diag.Errorf("Error setting backup_policy: %s", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -397,15 +594,18 @@ func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
 	d.Set("ipv4_address", FindIPv4AddrByType(droplet, "public"))
 	d.Set("ipv4_address_private", FindIPv4AddrByType(droplet, "private"))
 	d.Set("ipv6_address", strings.ToLower(FindIPv6AddrByType(droplet, "public")))
+	d.Set("ipv6_addresses", FindIPv6Addrs(droplet))
 
 	if features := droplet.Features; features != nil {
 		d.Set("backups", containsDigitalOceanDropletFeature(features, "backups"))
 		d.Set("ipv6", containsDigitalOceanDropletFeature(features, "ipv6"))
 		d.Set("private_networking", containsDigitalOceanDropletFeature(features, "private_networking"))
 		d.Set("monitoring", containsDigitalOceanDropletFeature(features, "monitoring"))
+		d.Set("droplet_agent", containsDigitalOceanDropletFeature(features, "droplet_agent"))
 	}
 
-	if err := d.Set("volume_ids", flattenDigitalOceanDropletVolumeIds(droplet.VolumeIDs)); err != nil {
+	volumeIDs := reconcileDigitalOceanDropletVolumeIds(d.Get("volume_ids").(*schema.Set), droplet.VolumeIDs)
+	if err := d.Set("volume_ids", volumeIDs); err != nil {
 		return fmt.Errorf("Error setting `volume_ids`: %+v", err)
 	}
 
@@ -441,21 +641,70 @@ func resourceDigitalOceanDropletImport(d *schema.ResourceData, meta interface{})
 		d.Set("image", godo.Stringify(droplet.Image.ID))
 	}
 
+	// Start tracking all of the attached volumes, as those that are not in
+	// state are otherwise assumed to be attached by other means.
+	d.Set("volume_ids", flattenDigitalOceanDropletVolumeIds(droplet.VolumeIDs))
+
 	// This is a non API attribute. So set to the default setting in the schema.
 	d.Set("resize_disk", true)
+	d.Set("graceful_shutdown", false)
+	d.Set("destroy_with_associated_resources", false)
+	d.Set("wait_for_cloud_init", false)
+	d.Set("cloud_init_timeout", "10m")
+	d.Set("user_data_store", userDataStoreHash)
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// FindIPv6AddrByType returns the lowest IPv6 address of the given type. The
+// API does not return a Droplet's networks in a consistent order, so the
+// first address can not be used when the Droplet has several.
 func FindIPv6AddrByType(d *godo.Droplet, addrType string) string {
+	addrs := findIPv6Addrs(d, func(addr godo.NetworkV6) bool {
+		return addr.Type == addrType
+	})
+	if len(addrs) == 0 {
+		return ""
+	}
+	return addrs[0]
+}
+
+// FindIPv6Addrs returns all of a Droplet's IPv6 addresses, lowest first.
+func FindIPv6Addrs(d *godo.Droplet) []string {
+	return findIPv6Addrs(d, func(addr godo.NetworkV6) bool {
+		return true
+	})
+}
+
+func findIPv6Addrs(d *godo.Droplet, include func(godo.NetworkV6) bool) []string {
+	if d.Networks == nil {
+		return nil
+	}
+
+	type ipv6Addr struct {
+		ip   net.IP
+		addr string
+	}
+
+	var found []ipv6Addr
 	for _, addr := range d.Networks.V6 {
-		if addr.Type == addrType {
-			if ip := net.ParseIP(addr.IPAddress); ip != nil {
-				return strings.ToLower(addr.IPAddress)
-			}
+		if !include(addr) {
+			continue
+		}
+		if ip := net.ParseIP(addr.IPAddress); ip != nil {
+			found = append(found, ipv6Addr{ip: ip.To16(), addr: strings.ToLower(addr.IPAddress)})
 		}
 	}
-	return ""
+
+	sort.Slice(found, func(i, j int) bool {
+		return bytes.Compare(found[i].ip, found[j].ip) < 0
+	})
+
+	addrs := make([]string, 0, len(found))
+	for _, f := range found {
+		addrs = append(addrs, f.addr)
+	}
+	return addrs
 }
 
 func FindIPv4AddrByType(d *godo.Droplet, addrType string) string {
@@ -478,57 +727,15 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("invalid droplet id: %v", err)
 	}
 
-	if d.HasChange("size") {
-		newSize := d.Get("size")
-		resizeDisk := d.Get("resize_disk").(bool)
-
-		_, _, err = client.DropletActions.PowerOff(context.Background(), id)
-		if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
-			return diag.Errorf(
-				"Error powering off droplet (%s): %s", d.Id(), err)
-		}
-
-		// Wait for power off
-		_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutUpdate, meta)
-		if err != nil {
-			return diag.Errorf(
-				"Error waiting for droplet (%s) to become powered off: %s", d.Id(), err)
-		}
-
-		// Resize the droplet
-		var action *godo.Action
-		action, _, err = client.DropletActions.Resize(context.Background(), id, newSize.(string), resizeDisk)
-		if err != nil {
-			newErr := powerOnAndWait(ctx, d, meta)
-			if newErr != nil {
-				return diag.Errorf(
-					"Error powering on droplet (%s) after failed resize: %s", d.Id(), err)
-			}
-			return diag.Errorf(
-				"Error resizing droplet (%s): %s", d.Id(), err)
-		}
-
-		// Wait for the resize action to complete.
-		if err = util.WaitForAction(client, action); err != nil {
-			newErr := powerOnAndWait(ctx, d, meta)
-			if newErr != nil {
-				return diag.Errorf(
-					"Error powering on droplet (%s) after waiting for resize to finish: %s", d.Id(), err)
-			}
-			return diag.Errorf(
-				"Error waiting for resize droplet (%s) to finish: %s", d.Id(), err)
-		}
-
-		_, _, err = client.DropletActions.PowerOn(context.Background(), id)
-
-		if err != nil {
-			return diag.Errorf(
-				"Error powering on droplet (%s) after resize: %s", d.Id(), err)
-		}
+	if err := vpc.CheckVPCNameChange(ctx, d, meta, "vpc_uuid"); err != nil {
+		return diag.FromErr(err)
+	}
 
-		// Wait for power on
-		_, err = waitForDropletAttribute(ctx, d, "active", []string{"off"}, "status", schema.TimeoutUpdate, meta)
-		if err != nil {
+	if d.HasChange("size") {
+		if err := resizeDroplet(ctx, d, meta, id); err != nil {
+			// Keep the previous size and resize_disk in state, as the
+			// droplet can not be assumed to have been resized.
+			d.Partial(true)
 			return diag.FromErr(err)
 		}
 	}
@@ -536,14 +743,23 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 
+		if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+
 		// Rename the droplet
-		_, _, err = client.DropletActions.Rename(context.Background(), id, newName.(string))
+		action, _, err := client.DropletActions.Rename(context.Background(), id, newName.(string))
 
 		if err != nil {
 			return diag.Errorf(
 				"Error renaming droplet (%s): %s", d.Id(), err)
 		}
 
+		if err := util.WaitForActionContext(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf(
+				"Error waiting for rename droplet (%s) to finish: %s", d.Id(), err)
+		}
+
 		// Wait for the name to change
 		_, err = waitForDropletAttribute(
 			ctx, d, newName.(string), []string{"", oldName.(string)}, "name", schema.TimeoutUpdate, meta)
@@ -554,19 +770,39 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChanges("backups", "backup_policy") {
+		if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("backups") {
 		if d.Get("backups").(bool) {
-			// Enable backups on droplet
-			action, _, err := client.DropletActions.EnableBackups(context.Background(), id)
+			// Enable backups on droplet, with the default policy if none is
+			// configured.
+			var action *godo.Action
+			var err error
+			if policy := expandDropletBackupPolicy(d.Get("backup_policy").([]interface{})); policy != nil {
+				action, _, err = client.DropletActions.EnableBackupsWithPolicy(context.Background(), id, policy)
+			} else {
+				action, _, err = client.DropletActions.EnableBackups(context.Background(), id)
+			}
 			if err != nil {
 				return diag.Errorf(
 					"Error enabling backups on droplet (%s): %s", d.Id(), err)
 			}
 
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForActionContext(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("Error waiting for backups to be enabled for droplet (%s): %s", d.Id(), err)
+			}
+
+			// The droplet's features may lag behind the completed action.
+			_, err = waitForDropletAttribute(ctx, d, "true", []string{"", "false"}, "backups", schema.TimeoutUpdate, meta)
+			if err != nil {
 				return diag.Errorf("Error waiting for backups to be enabled for droplet (%s): %s", d.Id(), err)
 			}
 		} else {
+			// Existing backups are not deleted, and remain until they expire.
 			// Disable backups on droplet
 			action, _, err := client.DropletActions.DisableBackups(context.Background(), id)
 			if err != nil {
@@ -574,15 +810,45 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 					"Error disabling backups on droplet (%s): %s", d.Id(), err)
 			}
 
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForActionContext(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("Error waiting for backups to be disabled for droplet (%s): %s", d.Id(), err)
 			}
+
+			_, err = waitForDropletAttribute(ctx, d, "false", []string{"", "true"}, "backups", schema.TimeoutUpdate, meta)
+			if err != nil {
+				return diag.Errorf("Error waiting for backups to be disabled for droplet (%s): %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChange("backup_policy") && d.Get("backups").(bool) {
+		// Removing the backup policy reverts the droplet to the default policy
+		// rather than disabling backups.
+		policy := expandDropletBackupPolicy(d.Get("backup_policy").([]interface{}))
+		if policy == nil {
+			policy = &godo.DropletBackupPolicyRequest{
+				Plan:    defaultBackupPlan,
+				Weekday: defaultBackupWeekday,
+				Hour:    godo.PtrTo(defaultBackupHour),
+			}
+		}
+
+		action, _, err := client.DropletActions.ChangeBackupPolicy(context.Background(), id, policy)
+		if err != nil {
+			return diag.Errorf(
+				"Error changing backup policy for droplet (%s): %s", d.Id(), err)
+		}
+
+		if err := util.WaitForActionContext(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error waiting for backup policy to be changed for droplet (%s): %s", d.Id(), err)
 		}
 	}
 
 	// As there is no way to disable private networking,
 	// we only check if it needs to be enabled
 	if d.HasChange("private_networking") && d.Get("private_networking").(bool) {
+		if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+
 		_, _, err = client.DropletActions.EnablePrivateNetworking(context.Background(), id)
 
 		if err != nil {
@@ -602,6 +868,10 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 
 	// As there is no way to disable IPv6, we only check if it needs to be enabled
 	if d.HasChange("ipv6") && d.Get("ipv6").(bool) {
+		if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+
 		_, _, err = client.DropletActions.EnableIPv6(context.Background(), id)
 		if err != nil {
 			return diag.Errorf(
@@ -653,6 +923,10 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		oldIDSet := newSet(oldIDs.(*schema.Set).List())
 		newIDSet := newSet(newIDs.(*schema.Set).List())
 		for volumeID := range leftDiff(newIDSet, oldIDSet) {
+			if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+				return diag.FromErr(err)
+			}
+
 			action, _, err := client.StorageActions.Attach(context.Background(), volumeID, id)
 			if err != nil {
 				return diag.Errorf("Error attaching volume %q to droplet (%s): %s", volumeID, d.Id(), err)
@@ -667,6 +941,15 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange("user_data_store") {
+		// The user data itself is unchanged, or the Droplet would have been
+		// replaced, so only how it is stored changes.
+		v, _ := configuredUserData(d)
+		d.Set("user_data_raw", userDataRaw(v, d.Get("user_data_store").(string)))
+	}
+
+	warnings = append(warnings, dropletAgentChangeWarning(d)...)
+
 	readErr := resourceDigitalOceanDropletRead(ctx, d, meta)
 	if readErr != nil {
 		readErr = append(warnings, readErr...)
@@ -691,23 +974,14 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 			"Error waiting for droplet to be unlocked for destroy (%s): %s", d.Id(), err)
 	}
 
-	shutdown := d.Get("graceful_shutdown").(bool)
-	if shutdown {
-		log.Printf("[INFO] Shutting down droplet: %s", d.Id())
-
-		// Shutdown the droplet
-		// DO API doesn't return an error if we try to shutdown an already shutdown droplet
-		_, _, err = client.DropletActions.Shutdown(context.Background(), id)
-		if err != nil {
-			return diag.Errorf(
-				"Error shutting down the the droplet (%s): %s", d.Id(), err)
+	if d.Get("graceful_shutdown").(bool) {
+		if err := shutdownDropletForDestroy(ctx, d, meta, id); err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		// Wait for shutdown
-		_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutDelete, meta)
-		if err != nil {
-			return diag.Errorf("Error waiting for droplet (%s) to become off: %s", d.Id(), err)
-		}
+	if d.Get("destroy_with_associated_resources").(bool) {
+		return destroyDropletWithAssociatedResources(ctx, d, client, id)
 	}
 
 	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
@@ -737,6 +1011,60 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// shutdownDropletForDestroy gracefully shuts down a droplet ahead of deleting
+// it. If the droplet does not power off within half of the delete timeout, it
+// is forcibly powered off instead within the time left. Droplets that are
+// already off are left as is.
+func shutdownDropletForDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}, id int) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+
+	droplet, _, err := client.Droplets.Get(context.Background(), id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving droplet (%s): %s", d.Id(), err)
+	}
+
+	if droplet.Status == "off" {
+		log.Printf("[INFO] Droplet (%s) is already off, skipping shutdown", d.Id())
+		return nil
+	}
+
+	if err := util.WaitForNoPendingDropletAction(ctx, client, id, time.Until(deadline)); err != nil {
+		return fmt.Errorf("Error waiting for the pending action of droplet (%s) to finish: %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Shutting down droplet: %s", d.Id())
+
+	// DO API doesn't return an error if we try to shutdown an already shutdown droplet
+	_, _, err = client.DropletActions.Shutdown(context.Background(), id)
+	if err != nil {
+		return fmt.Errorf("Error shutting down the the droplet (%s): %s", d.Id(), err)
+	}
+
+	_, err = waitForDropletAttributeWithTimeout(ctx, d, "off", []string{"active"}, "status", time.Until(deadline)/2, meta)
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(*resource.TimeoutError); !ok {
+		return fmt.Errorf("Error waiting for droplet (%s) to become off: %s", d.Id(), err)
+	}
+
+	log.Printf("[WARN] Droplet (%s) did not shut down in time, powering it off", d.Id())
+
+	_, _, err = client.DropletActions.PowerOff(context.Background(), id)
+	if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
+		return fmt.Errorf("Error powering off droplet (%s): %s", d.Id(), err)
+	}
+
+	_, err = waitForDropletAttributeWithTimeout(ctx, d, "off", []string{"active"}, "status", time.Until(deadline), meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for droplet (%s) to become off: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("[INFO] Waiting for droplet (%s) to be destroyed", d.Id())
 
@@ -754,6 +1082,11 @@ func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta int
 
 func waitForDropletAttribute(
 	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeoutKey string, meta interface{}) (interface{}, error) {
+	return waitForDropletAttributeWithTimeout(ctx, d, target, pending, attribute, d.Timeout(timeoutKey), meta)
+}
+
+func waitForDropletAttributeWithTimeout(
+	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (interface{}, error) {
 	// Wait for the droplet so we can get the networking attributes
 	// that show up after a while
 	log.Printf(
@@ -764,7 +1097,7 @@ func waitForDropletAttribute(
 		Pending:    pending,
 		Target:     []string{target},
 		Refresh:    dropletStateRefreshFunc(ctx, d, attribute, meta),
-		Timeout:    d.Timeout(timeoutKey),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 
@@ -821,28 +1154,111 @@ func dropletStateRefreshFunc(
 	}
 }
 
-// Powers on the droplet and waits for it to be active
-func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-	id, err := strconv.Atoi(d.Id())
+// resizeDroplet powers off the droplet, resizes it, and powers it back on,
+// waiting for each action to complete before starting the next one. The new
+// size is then verified against the droplet returned by the API.
+func resizeDroplet(ctx context.Context, d *schema.ResourceData, meta interface{}, id int) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	newSize := d.Get("size").(string)
+	resizeDisk := d.Get("resize_disk").(bool)
+
+	// A previous resize which failed may have left an action in progress.
+	if err := waitForDropletPendingAction(ctx, client, d, id, schema.TimeoutUpdate); err != nil {
+		return err
+	}
+
+	droplet, _, err := client.Droplets.Get(context.Background(), id)
 	if err != nil {
-		return fmt.Errorf("invalid droplet id: %v", err)
+		return fmt.Errorf("Error retrieving droplet (%s): %s", d.Id(), err)
 	}
 
-	client := meta.(*config.CombinedConfig).GodoClient()
-	_, _, err = client.DropletActions.PowerOn(context.Background(), id)
+	if droplet.Status != "off" {
+		action, _, err := client.DropletActions.PowerOff(context.Background(), id)
+		if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
+			return fmt.Errorf("Error powering off droplet (%s): %s", d.Id(), err)
+		}
+
+		if action != nil {
+			if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+				return fmt.Errorf("Error waiting for droplet (%s) to become powered off: %s", d.Id(), err)
+			}
+		}
+	}
+
+	action, _, err := client.DropletActions.Resize(context.Background(), id, newSize, resizeDisk)
+	if err != nil {
+		if newErr := powerOnDropletAndWait(ctx, client, id, timeout); newErr != nil {
+			return fmt.Errorf("Error powering on droplet (%s) after failed resize: %s", d.Id(), newErr)
+		}
+		return fmt.Errorf("Error resizing droplet (%s): %s", d.Id(), err)
+	}
+
+	if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+		if newErr := powerOnDropletAndWait(ctx, client, id, timeout); newErr != nil {
+			return fmt.Errorf("Error powering on droplet (%s) after waiting for resize to finish: %s", d.Id(), newErr)
+		}
+		return fmt.Errorf("Error waiting for resize droplet (%s) to finish: %s", d.Id(), err)
+	}
+
+	if err := powerOnDropletAndWait(ctx, client, id, timeout); err != nil {
+		return fmt.Errorf("Error powering on droplet (%s) after resize: %s", d.Id(), err)
+	}
+
+	droplet, _, err = client.Droplets.Get(context.Background(), id)
 	if err != nil {
+		return fmt.Errorf("Error retrieving droplet (%s) after resize: %s", d.Id(), err)
+	}
+
+	if droplet.Size == nil || droplet.Size.Slug != newSize {
+		return fmt.Errorf("Error resizing droplet (%s): expected size %s after resize, found %s", d.Id(), newSize, droplet.SizeSlug)
+	}
+
+	return setDropletAttributes(d, droplet)
+}
+
+func powerOnDropletAndWait(ctx context.Context, client *godo.Client, id int, timeout time.Duration) error {
+	if err := util.WaitForNoPendingDropletAction(ctx, client, id, timeout); err != nil {
 		return err
 	}
-	// this method is only used for droplet updates so use that as the timeout parameter
-	// Wait for power on
-	_, err = waitForDropletAttribute(ctx, d, "active", []string{"off"}, "status", schema.TimeoutUpdate, meta)
+
+	action, _, err := client.DropletActions.PowerOn(context.Background(), id)
 	if err != nil {
 		return err
 	}
 
+	return util.WaitForActionContext(ctx, client, action, timeout)
+}
+
+// waitForDropletPendingAction waits for the action of the droplet that is in
+// progress, if any, to finish, as the API refuses to start another one.
+func waitForDropletPendingAction(ctx context.Context, client *godo.Client, d *schema.ResourceData, id int, timeoutKey string) error {
+	if err := util.WaitForNoPendingDropletAction(ctx, client, id, d.Timeout(timeoutKey)); err != nil {
+		return fmt.Errorf("Error waiting for the pending action of droplet (%s) to finish: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
+func flattenDropletPendingAction(action *godo.Action) []interface{} {
+	if action == nil {
+		return []interface{}{}
+	}
+
+	startedAt := ""
+	if action.StartedAt != nil {
+		startedAt = action.StartedAt.UTC().Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":         action.ID,
+			"type":       action.Type,
+			"started_at": startedAt,
+		},
+	}
+}
+
 // Detach volumes from droplet
 func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
 	var errors []error
@@ -913,3 +1329,332 @@ func flattenDigitalOceanDropletVolumeIds(volumeids []string) *schema.Set {
 
 	return flattenedVolumes
 }
+
+// reconcileDigitalOceanDropletVolumeIds returns the volumes in state that are
+// still attached to the Droplet. Volumes that are attached but not in state,
+// e.g. by a digitalocean_volume_attachment resource, are left out so that they
+// are not detached to match the configuration.
+func reconcileDigitalOceanDropletVolumeIds(state *schema.Set, attached []string) *schema.Set {
+	reconciled := schema.NewSet(schema.HashString, []interface{}{})
+	for _, v := range attached {
+		if state.Contains(v) {
+			reconciled.Add(v)
+		}
+	}
+
+	return reconciled
+}
+
+func validateDropletCloudInit(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("wait_for_cloud_init").(bool) {
+		return nil
+	}
+
+	if len(diff.Get("cloud_init_connection").([]interface{})) == 0 {
+		return fmt.Errorf("cloud_init_connection must be set when wait_for_cloud_init is true")
+	}
+
+	return nil
+}
+
+func validateCloudInitTimeout(v interface{}, k string) (ws []string, errors []error) {
+	timeout, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. \"10m\": %s", k, err))
+		return
+	}
+
+	if timeout <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got: %s", k, v))
+	}
+
+	return
+}
+
+func validateDropletBackupPolicy(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	policy := diff.Get("backup_policy").([]interface{})
+	if len(policy) == 0 {
+		return nil
+	}
+
+	if !diff.Get("backups").(bool) {
+		return fmt.Errorf("backup_policy can only be set when backups is true")
+	}
+
+	return nil
+}
+
+// validateDropletResizeDisk rejects resizing a droplet with resize_disk set to
+// a size with a smaller disk than the droplet currently has, as disks can not
+// be shrunk.
+func validateDropletResizeDisk(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("size") || !diff.NewValueKnown("size") || !diff.Get("resize_disk").(bool) {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	newSize := diff.Get("size").(string)
+
+	s, err := size.FindSize(context.Background(), client, newSize)
+	if err != nil {
+		return err
+	}
+
+	// Unknown sizes are left for the API to reject.
+	if s == nil {
+		return nil
+	}
+
+	if currentDisk := diff.Get("disk").(int); s.Disk < currentDisk {
+		return fmt.Errorf("cannot resize droplet to %s with resize_disk = true: its %dGB disk is smaller than the droplet's current %dGB disk", newSize, s.Disk, currentDisk)
+	}
+
+	return nil
+}
+
+// validateDropletGPURegion checks that a GPU size is offered in the Droplet's
+// region, as GPU sizes are only available in a few regions.
+func validateDropletGPURegion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("size") || !diff.NewValueKnown("region") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("size", "region") {
+		return nil
+	}
+
+	slug := strings.ToLower(diff.Get("size").(string))
+	region := strings.ToLower(diff.Get("region").(string))
+	if !isGPUSizeSlug(slug) || region == "" {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	s, err := size.FindSize(ctx, client, slug)
+	if err != nil {
+		return err
+	}
+
+	// Unknown sizes are left for the API to reject.
+	if s == nil || s.GPUInfo == nil {
+		return nil
+	}
+
+	for _, r := range s.Regions {
+		if r == region {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("GPU size %s is not available in region %s, it is offered in: %s", slug, region, strings.Join(s.Regions, ", "))
+}
+
+const (
+	userDataStoreHash = "hash"
+	userDataStoreRaw  = "raw"
+)
+
+// userDataRaw returns the value of user_data_raw for the given user data.
+func userDataRaw(userData, store string) string {
+	if store != userDataStoreRaw {
+		return ""
+	}
+
+	return userData
+}
+
+// suppressEquivalentUserData suppresses differences between the hash of the
+// user data in state and the configured user data when they only differ by
+// trailing newlines. Older states stored the full content rather than a hash.
+func suppressEquivalentUserData(k, old, new string, d *schema.ResourceData) bool {
+	// new is the hash, so the content is read from the configuration.
+	userData := d.Get("user_data").(string)
+	if new == "" || old == "" || userData == "" {
+		return false
+	}
+
+	normalized := normalizeUserData(userData)
+	if normalizeUserData(old) == normalized {
+		return true
+	}
+
+	for _, v := range []string{userData, normalized, normalized + "\n"} {
+		if old == util.HashString(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeUserData(v string) string {
+	return strings.TrimRight(v, "\r\n")
+}
+
+// configuredUserData returns the user data from the configuration, as the
+// value of user_data is its hash.
+func configuredUserData(d interface{ GetRawConfig() cty.Value }) (string, bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("user_data") {
+		return "", false
+	}
+
+	v := rawConfig.GetAttr("user_data")
+	if !v.IsKnown() || v.IsNull() {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// customizeDiffDropletUserDataRaw plans user_data_raw from the configured user
+// data so that what has changed can be seen in the plan. The attribute is
+// sensitive, so the content is only shown by e.g. terraform show -json.
+func customizeDiffDropletUserDataRaw(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	store := diff.Get("user_data_store").(string)
+	if store == userDataStoreRaw && !diff.NewValueKnown("user_data") {
+		return diff.SetNewComputed("user_data_raw")
+	}
+
+	v, _ := configuredUserData(diff)
+	raw := userDataRaw(v, store)
+
+	old := diff.Get("user_data_raw").(string)
+	if diff.Id() != "" && normalizeUserData(old) == normalizeUserData(raw) {
+		return nil
+	}
+
+	return diff.SetNew("user_data_raw", raw)
+}
+
+// dropletAgentChangeWarning warns when droplet_agent is changed on an existing
+// Droplet. The Droplet is not changed, and the attribute is read back from its
+// features, so the change is planned again until the configuration matches.
+func dropletAgentChangeWarning(d *schema.ResourceData) diag.Diagnostics {
+	if !d.HasChange("droplet_agent") {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "droplet_agent only applies when a Droplet is created",
+			Detail: fmt.Sprintf("The droplet_agent argument for droplet (%s) was changed to %t, but the agent is only installed when a Droplet is created. "+
+				"The existing Droplet has not been changed, and the change will be planned again until droplet_agent matches whether the agent is installed.",
+				d.Id(), d.Get("droplet_agent").(bool)),
+		},
+	}
+}
+
+// isGPUSizeSlug reports whether a size slug is for a GPU Droplet. It is used
+// to avoid listing sizes for Droplets that can not have GPUs.
+func isGPUSizeSlug(slug string) bool {
+	return strings.HasPrefix(slug, "gpu-")
+}
+
+// flattenDropletGPUInfo flattens the GPUs of the size embedded in a Droplet.
+func flattenDropletGPUInfo(droplet *godo.Droplet) []interface{} {
+	if droplet.Size == nil {
+		return size.FlattenGPUInfo(nil)
+	}
+
+	return size.FlattenGPUInfo(droplet.Size.GPUInfo)
+}
+
+// defaultBackupPlan, defaultBackupWeekday and defaultBackupHour are the backup
+// policy of a Droplet whose backups are enabled without a policy.
+const (
+	defaultBackupPlan    = "weekly"
+	defaultBackupWeekday = "SUN"
+	defaultBackupHour    = 0
+)
+
+// The wait between retries of a Droplet create request starts at
+// dropletCreateRetryWaitMin and doubles after each attempt, up to
+// dropletCreateRetryWaitMax.
+var (
+	dropletCreateRetryWaitMin = 5 * time.Second
+	dropletCreateRetryWaitMax = 60 * time.Second
+)
+
+// transientDropletCreateErrors are the messages of the 422 errors returned
+// when a Droplet can not be created at the moment, e.g. due to a temporary
+// lack of capacity in the region, but can be if the request is retried.
+var transientDropletCreateErrors = []string{
+	"currently unavailable",
+	"temporarily unavailable",
+	"capacity",
+	"please try again",
+}
+
+// isTransientDropletCreateError returns true if the error returned by a
+// Droplet create request is known to be transient. Validation errors, e.g. for
+// an invalid size or image, are not.
+func isTransientDropletCreateError(err error) bool {
+	for _, transient := range transientDropletCreateErrors {
+		if util.IsDigitalOceanError(err, http.StatusUnprocessableEntity, transient) {
+			return true
+		}
+	}
+	return false
+}
+
+// createDropletWithRetries creates the Droplet, retrying up to retries times
+// with an exponential backoff when the request fails with a transient error.
+func createDropletWithRetries(ctx context.Context, client *godo.Client, opts *godo.DropletCreateRequest, retries int) (*godo.Droplet, error) {
+	wait := dropletCreateRetryWaitMin
+	for attempt := 0; ; attempt++ {
+		droplet, _, err := client.Droplets.Create(ctx, opts)
+		if err == nil {
+			return droplet, nil
+		}
+		if attempt >= retries || !isTransientDropletCreateError(err) {
+			return nil, err
+		}
+
+		log.Printf("[INFO] Transient error creating Droplet (%s), retrying in %s (retry %d of %d): %s", opts.Name, wait, attempt+1, retries, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > dropletCreateRetryWaitMax {
+			wait = dropletCreateRetryWaitMax
+		}
+	}
+}
+
+func expandDropletBackupPolicy(config []interface{}) *godo.DropletBackupPolicyRequest {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	raw := config[0].(map[string]interface{})
+	policy := &godo.DropletBackupPolicyRequest{
+		Plan: raw["plan"].(string),
+		Hour: godo.PtrTo(raw["hour"].(int)),
+	}
+
+	if policy.Plan == "weekly" {
+		policy.Weekday = raw["weekday"].(string)
+	}
+
+	return policy
+}
+
+func flattenDropletBackupPolicy(policy *godo.DropletBackupPolicyConfig) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"plan":    policy.Plan,
+			"weekday": policy.Weekday,
+			"hour":    policy.Hour,
+		},
+	}
+}
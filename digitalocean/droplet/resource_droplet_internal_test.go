@@ -0,0 +1,541 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateDropletGPURegion(t *testing.T) {
+	var requests int
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"sizes":[{"slug":"gpu-h100x1-80gb","regions":["nyc2","tor1"],"gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}}],"links":{}}`)
+	}))
+
+	testCases := []struct {
+		name             string
+		size             string
+		region           string
+		expectRequest    bool
+		expectedErrorMsg string
+	}{
+		{"NotGPU", "s-1vcpu-1gb", "nyc3", false, ""},
+		{"OfferedInRegion", "gpu-h100x1-80gb", "tor1", true, ""},
+		{"NotOfferedInRegion", "gpu-h100x1-80gb", "nyc3", true, "GPU size gpu-h100x1-80gb is not available in region nyc3, it is offered in: nyc2, tor1"},
+		{"UnknownSize", "gpu-unknown", "nyc3", true, ""},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":   "foo",
+				"image":  "ubuntu-22-04-x64",
+				"size":   tt.size,
+				"region": tt.region,
+			})
+
+			_, err := ResourceDigitalOceanDroplet().Diff(context.Background(), nil, cfg, meta)
+			if tt.expectedErrorMsg == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if tt.expectedErrorMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErrorMsg)) {
+				t.Errorf("expected error %q, got: %v", tt.expectedErrorMsg, err)
+			}
+			if got := requests > 0; got != tt.expectRequest {
+				t.Errorf("expected sizes to be retrieved: %t, got %t", tt.expectRequest, got)
+			}
+		})
+	}
+}
+
+func TestDropletAgentChangeOnExistingDroplet(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"id":                "1234",
+			"name":              "foo",
+			"image":             "ubuntu-22-04-x64",
+			"size":              "s-1vcpu-1gb",
+			"region":            "nyc3",
+			"droplet_agent":     "true",
+			"graceful_shutdown": "false",
+			"resize_disk":       "true",
+			"backups":           "false",
+			"ipv6":              "false",
+			"monitoring":        "false",
+		},
+	}
+
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "foo",
+		"image":         "ubuntu-22-04-x64",
+		"size":          "s-1vcpu-1gb",
+		"region":        "nyc3",
+		"droplet_agent": false,
+	})
+
+	r := ResourceDigitalOceanDroplet()
+	diff, err := r.Diff(context.Background(), state, cfg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil || diff.Attributes["droplet_agent"] == nil {
+		t.Fatal("expected an in-place diff for droplet_agent")
+	}
+	if diff.RequiresNew() {
+		t.Error("expected the droplet not to be replaced")
+	}
+
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/droplets/1234":
+			fmt.Fprint(w, `{"droplet":{"id":1234,"name":"foo","status":"active","features":["droplet_agent"],"size_slug":"s-1vcpu-1gb","size":{"slug":"s-1vcpu-1gb"},"region":{"slug":"nyc3"},"networks":{}}}`)
+		case "/v2/droplets/1234/actions":
+			fmt.Fprint(w, `{"actions":[],"links":{}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	diags := r.UpdateContext(context.Background(), d, meta)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "droplet_agent only applies when a Droplet is created" {
+		t.Errorf("expected a droplet_agent warning, got %#v", diags)
+	}
+	if !d.Get("droplet_agent").(bool) {
+		t.Error("expected droplet_agent to be read back from the droplet's features")
+	}
+}
+
+func TestReconcileDigitalOceanDropletVolumeIds(t *testing.T) {
+	state := schema.NewSet(schema.HashString, []interface{}{"vol-a", "vol-b", "vol-detached"})
+
+	// vol-c is attached by other means, e.g. a digitalocean_volume_attachment.
+	reconciled := reconcileDigitalOceanDropletVolumeIds(state, []string{"vol-c", "vol-b", "vol-a"})
+
+	expected := schema.NewSet(schema.HashString, []interface{}{"vol-a", "vol-b"})
+	if !reconciled.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected.List(), reconciled.List())
+	}
+}
+
+func TestDropletUserDataDiff(t *testing.T) {
+	baseAttributes := func(userData, store, raw string) map[string]string {
+		attrs := map[string]string{
+			"id":                  "1234",
+			"name":                "foo",
+			"image":               "ubuntu-22-04-x64",
+			"size":                "s-1vcpu-1gb",
+			"region":              "nyc3",
+			"user_data":           userData,
+			"user_data_raw":       raw,
+			"graceful_shutdown":   "false",
+			"resize_disk":         "true",
+			"backups":             "false",
+			"ipv6":                "false",
+			"monitoring":          "false",
+			"wait_for_cloud_init": "false",
+			"cloud_init_timeout":  "10m",
+		}
+		if store != "" {
+			attrs["user_data_store"] = store
+		}
+		return attrs
+	}
+
+	testCases := []struct {
+		name            string
+		state           map[string]string
+		userData        string
+		store           string
+		expectedChanges []string
+		expectReplace   bool
+	}{
+		{"HashUnchanged", baseAttributes(util.HashString("#cloud-config\n"), "hash", ""), "#cloud-config\n", "", nil, false},
+		{"HashTrailingNewlineRemoved", baseAttributes(util.HashString("#cloud-config\n"), "hash", ""), "#cloud-config", "", nil, false},
+		{"HashTrailingNewlineAdded", baseAttributes(util.HashString("#cloud-config"), "hash", ""), "#cloud-config\n", "", nil, false},
+		{"HashChanged", baseAttributes(util.HashString("#cloud-config"), "hash", ""), "#cloud-config\nruncmd: []", "", []string{"user_data"}, true},
+		{"StoreNotInState", baseAttributes(util.HashString("#cloud-config"), "", ""), "#cloud-config", "", nil, false},
+		{"FullContentInState", baseAttributes("#cloud-config", "", ""), "#cloud-config", "", nil, false},
+		{"RawUnchanged", baseAttributes(util.HashString("#cloud-config\n"), "raw", "#cloud-config\n"), "#cloud-config", "raw", nil, false},
+		{"RawChanged", baseAttributes(util.HashString("#cloud-config"), "raw", "#cloud-config"), "#cloud-config\nruncmd: []", "raw", []string{"user_data", "user_data_raw"}, true},
+		{"HashToRaw", baseAttributes(util.HashString("#cloud-config"), "hash", ""), "#cloud-config", "raw", []string{"user_data_store", "user_data_raw"}, false},
+		{"RawToHash", baseAttributes(util.HashString("#cloud-config"), "raw", "#cloud-config"), "#cloud-config", "hash", []string{"user_data_store", "user_data_raw"}, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"name":      "foo",
+				"image":     "ubuntu-22-04-x64",
+				"size":      "s-1vcpu-1gb",
+				"region":    "nyc3",
+				"user_data": tt.userData,
+			}
+			if tt.store != "" {
+				config["user_data_store"] = tt.store
+			}
+
+			state := &terraform.InstanceState{
+				ID:         "1234",
+				Attributes: tt.state,
+				RawConfig:  cty.ObjectVal(map[string]cty.Value{"user_data": cty.StringVal(tt.userData)}),
+			}
+			diff, err := ResourceDigitalOceanDroplet().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff == nil {
+				diff = &terraform.InstanceDiff{}
+			}
+
+			keys := []string{"user_data", "user_data_store", "user_data_raw"}
+			if tt.expectReplace {
+				// Every attribute is in the diff when the Droplet is replaced.
+				keys = []string{"user_data"}
+			}
+			for _, k := range keys {
+				_, changed := diff.Attributes[k]
+				expected := false
+				for _, c := range tt.expectedChanges {
+					expected = expected || c == k
+				}
+				if changed != expected {
+					t.Errorf("expected %s to change: %t, got %t", k, expected, changed)
+				}
+			}
+			if got := diff.RequiresNew(); got != tt.expectReplace {
+				t.Errorf("expected replacement: %t, got %t", tt.expectReplace, got)
+			}
+			if tt.expectReplace {
+				if attr := diff.Attributes["user_data_raw"]; tt.store == "raw" && (attr == nil || attr.New != tt.userData) {
+					t.Errorf("expected user_data_raw to be planned as the new content, got %#v", attr)
+				}
+			}
+			if attr := diff.Attributes["user_data"]; attr != nil && attr.New != util.HashString(tt.userData) {
+				t.Errorf("expected user_data to be planned as a hash, got %q", attr.New)
+			}
+		})
+	}
+}
+
+func TestUserDataRaw(t *testing.T) {
+	if got := userDataRaw("#cloud-config", "hash"); got != "" {
+		t.Errorf("expected no content, got %q", got)
+	}
+	if got := userDataRaw("#cloud-config", "raw"); got != "#cloud-config" {
+		t.Errorf("expected the full content, got %q", got)
+	}
+}
+
+func TestFindIPv6AddrByType(t *testing.T) {
+	droplet := &godo.Droplet{
+		Networks: &godo.Networks{
+			V6: []godo.NetworkV6{
+				{IPAddress: "2604:A880:400:D1::9B:A003", Netmask: 124, Type: "public"},
+				{IPAddress: "fd00::5", Netmask: 64, Type: "private"},
+				{IPAddress: "2604:a880:400:d1::9b:a001", Netmask: 124, Type: "public"},
+				{IPAddress: "not-an-address", Type: "public"},
+				{IPAddress: "2604:a880:400:d1::9b:a002", Netmask: 124, Type: "public"},
+			},
+		},
+	}
+
+	// Every order of the networks yields the same addresses.
+	networks := droplet.Networks.V6
+	for i := range networks {
+		rotated := append(append([]godo.NetworkV6{}, networks[i:]...), networks[:i]...)
+		d := &godo.Droplet{Networks: &godo.Networks{V6: rotated}}
+
+		if got := FindIPv6AddrByType(d, "public"); got != "2604:a880:400:d1::9b:a001" {
+			t.Errorf("expected lowest public address, got %q", got)
+		}
+		if got := FindIPv6AddrByType(d, "private"); got != "fd00::5" {
+			t.Errorf("expected private address, got %q", got)
+		}
+
+		expected := []string{
+			"2604:a880:400:d1::9b:a001",
+			"2604:a880:400:d1::9b:a002",
+			"2604:a880:400:d1::9b:a003",
+			"fd00::5",
+		}
+		if got := FindIPv6Addrs(d); !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+
+	if got := FindIPv6AddrByType(&godo.Droplet{}, "public"); got != "" {
+		t.Errorf("expected no address, got %q", got)
+	}
+}
+
+func TestDropletBackupsChangeInPlace(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"id":                  "1234",
+			"name":                "foo",
+			"image":               "ubuntu-22-04-x64",
+			"size":                "s-1vcpu-1gb",
+			"region":              "nyc3",
+			"backups":             "false",
+			"graceful_shutdown":   "false",
+			"resize_disk":         "true",
+			"ipv6":                "false",
+			"monitoring":          "false",
+			"wait_for_cloud_init": "false",
+			"cloud_init_timeout":  "10m",
+			"user_data_store":     "hash",
+		},
+	}
+
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foo",
+		"image":   "ubuntu-22-04-x64",
+		"size":    "s-1vcpu-1gb",
+		"region":  "nyc3",
+		"backups": true,
+		"backup_policy": []interface{}{
+			map[string]interface{}{"plan": "daily", "hour": 4},
+		},
+	})
+
+	diff, err := ResourceDigitalOceanDroplet().Diff(context.Background(), state, cfg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || diff.Attributes["backups"] == nil {
+		t.Fatal("expected a diff for backups")
+	}
+	if diff.RequiresNew() {
+		t.Error("expected backups to be enabled without replacing the droplet")
+	}
+}
+
+func TestDropletRenameInPlace(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"id":                  "1234",
+			"name":                "foo",
+			"image":               "ubuntu-22-04-x64",
+			"size":                "s-1vcpu-1gb",
+			"region":              "nyc3",
+			"backups":             "false",
+			"graceful_shutdown":   "false",
+			"resize_disk":         "true",
+			"ipv6":                "false",
+			"monitoring":          "false",
+			"wait_for_cloud_init": "false",
+			"cloud_init_timeout":  "10m",
+			"user_data_store":     "hash",
+		},
+	}
+
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "bar",
+		"image":  "ubuntu-22-04-x64",
+		"size":   "s-1vcpu-1gb",
+		"region": "nyc3",
+	})
+
+	diff, err := ResourceDigitalOceanDroplet().Diff(context.Background(), state, cfg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || diff.Attributes["name"] == nil {
+		t.Fatal("expected a diff for name")
+	}
+	if diff.RequiresNew() {
+		t.Error("expected the droplet to be renamed without being replaced")
+	}
+}
+
+func TestDropletReadPendingAction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		actions  string
+		expected []interface{}
+	}{
+		{
+			"Pending",
+			http.StatusOK,
+			`[{"id":12,"status":"in-progress","type":"resize","started_at":"2024-06-01T10:00:00Z"},{"id":11,"status":"completed","type":"power_off"}]`,
+			[]interface{}{map[string]interface{}{"id": 12, "type": "resize", "started_at": "2024-06-01T10:00:00Z"}},
+		},
+		{
+			"Idle",
+			http.StatusOK,
+			`[{"id":11,"status":"completed","type":"power_off"}]`,
+			[]interface{}{},
+		},
+		{
+			// A token without access to the actions can still read the droplet.
+			"Forbidden",
+			http.StatusForbidden,
+			``,
+			[]interface{}{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/v2/droplets/1":
+					fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"active","size_slug":"s-1vcpu-1gb","size":{"slug":"s-1vcpu-1gb"},"region":{"slug":"nyc3"},"networks":{}}}`)
+				case "/v2/droplets/1/actions":
+					if tt.status != http.StatusOK {
+						w.WriteHeader(tt.status)
+						fmt.Fprint(w, `{"id":"forbidden","message":"You are not authorized to perform this operation"}`)
+						return
+					}
+					fmt.Fprintf(w, `{"actions":%s,"links":{}}`, tt.actions)
+				default:
+					t.Errorf("unexpected request: %s", r.URL)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDroplet().Schema, map[string]interface{}{
+				"name":   "web",
+				"size":   "s-1vcpu-1gb",
+				"image":  "ubuntu-22-04-x64",
+				"region": "nyc3",
+			})
+			d.SetId("1")
+
+			if diags := resourceDigitalOceanDropletRead(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if actual := d.Get("pending_action").([]interface{}); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected pending_action %#v, got %#v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateDropletWithRetries(t *testing.T) {
+	defer func(min, max time.Duration) {
+		dropletCreateRetryWaitMin, dropletCreateRetryWaitMax = min, max
+	}(dropletCreateRetryWaitMin, dropletCreateRetryWaitMax)
+	dropletCreateRetryWaitMin, dropletCreateRetryWaitMax = time.Millisecond, 2*time.Millisecond
+
+	testCases := []struct {
+		name             string
+		failures         int
+		failure          string
+		retries          int
+		expectedRequests int
+		expectedErrorMsg string
+	}{
+		{
+			"NoError",
+			0,
+			"",
+			3,
+			1,
+			"",
+		},
+		{
+			"TransientThenSuccess",
+			2,
+			"Droplet create currently unavailable in this region.",
+			3,
+			3,
+			"",
+		},
+		{
+			"TransientRetriesExhausted",
+			5,
+			"Droplet create currently unavailable in this region.",
+			2,
+			3,
+			"currently unavailable",
+		},
+		{
+			"RetriesDisabled",
+			1,
+			"There is not enough capacity in this region.",
+			0,
+			1,
+			"enough capacity",
+		},
+		{
+			"InvalidSize",
+			1,
+			"You specified an invalid size for Droplet creation.",
+			3,
+			1,
+			"invalid size",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v2/droplets" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				if requests <= tc.failures {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprintf(w, `{"id":"unprocessable_entity","message":%q}`, tc.failure)
+					return
+				}
+				w.WriteHeader(http.StatusAccepted)
+				fmt.Fprint(w, `{"droplet":{"id":1234,"name":"foo"}}`)
+			})).GodoClient()
+
+			opts := &godo.DropletCreateRequest{Name: "foo", Region: "nyc3", Size: "s-1vcpu-1gb"}
+			droplet, err := createDropletWithRetries(context.Background(), client, opts, tc.retries)
+
+			if requests != tc.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+			if tc.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErrorMsg) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if droplet.ID != 1234 {
+				t.Errorf("expected droplet 1234, got %d", droplet.ID)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package droplet
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -33,7 +36,25 @@ func migrateDigitalOceanDropletStateV0toV1(is *terraform.InstanceState) (*terraf
 		is.Attributes["monitoring"] = "false"
 	}
 
+	migrateDigitalOceanDropletVolumeIDsToSet(is.Attributes)
+
 	log.Printf("[DEBUG] DigitalOcean Droplet Attributes after State Migration: %#v", is.Attributes)
 
 	return is, nil
 }
+
+// migrateDigitalOceanDropletVolumeIDsToSet rewrites volume_ids stored in the
+// list form, keyed by index, to the set form, keyed by the hash of each ID, so
+// that their order does not matter.
+func migrateDigitalOceanDropletVolumeIDsToSet(attributes map[string]string) {
+	const prefix = "volume_ids."
+
+	for k, v := range attributes {
+		if !strings.HasPrefix(k, prefix) || k == prefix+"#" {
+			continue
+		}
+
+		delete(attributes, k)
+		attributes[prefix+strconv.Itoa(schema.HashString(v))] = v
+	}
+}
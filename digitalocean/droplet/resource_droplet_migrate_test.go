@@ -27,6 +27,24 @@ func TestDigitalOceanDropletMigrateState(t *testing.T) {
 				"monitoring": "false",
 			},
 		},
+		"v0_1_volume_ids_list": {
+			StateVersion: 0,
+			ID:           "id",
+			Attributes: map[string]string{
+				"backups":      "false",
+				"monitoring":   "false",
+				"volume_ids.#": "2",
+				"volume_ids.0": "vol-a",
+				"volume_ids.1": "vol-b",
+			},
+			Expected: map[string]string{
+				"backups":               "false",
+				"monitoring":            "false",
+				"volume_ids.#":          "2",
+				"volume_ids.2292661314": "vol-a",
+				"volume_ids.296644088":  "vol-b",
+			},
+		},
 		"v0_1_without_values": {
 			StateVersion: 0,
 			ID:           "id",
@@ -1,6 +1,7 @@
 package droplet_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -23,6 +25,7 @@ const (
 func TestAccDigitalOceanDroplet_Basic(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -30,10 +33,10 @@ func TestAccDigitalOceanDroplet_Basic(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 					resource.TestCheckResourceAttr(
@@ -45,7 +48,7 @@ func TestAccDigitalOceanDroplet_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "image", defaultImage),
 					resource.TestCheckResourceAttr(
-						"digitalocean_droplet.foobar", "region", "nyc3"),
+						"digitalocean_droplet.foobar", "region", region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "user_data", util.HashString("foobar")),
 					resource.TestCheckResourceAttrSet(
@@ -65,6 +68,7 @@ func TestAccDigitalOceanDroplet_Basic(t *testing.T) {
 func TestAccDigitalOceanDroplet_WithID(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -72,7 +76,7 @@ func TestAccDigitalOceanDroplet_WithID(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_withID(name, defaultImage),
+				Config: testAccCheckDigitalOceanDropletConfig_withID(name, defaultImage, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 				),
@@ -84,6 +88,7 @@ func TestAccDigitalOceanDroplet_WithID(t *testing.T) {
 func TestAccDigitalOceanDroplet_withSSH(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
 	if err != nil {
 		t.Fatalf("Cannot generate test SSH key pair: %s", err)
@@ -95,10 +100,10 @@ func TestAccDigitalOceanDroplet_withSSH(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_withSSH(name, publicKeyMaterial),
+				Config: testAccCheckDigitalOceanDropletConfig_withSSH(name, publicKeyMaterial, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 					resource.TestCheckResourceAttr(
@@ -106,7 +111,7 @@ func TestAccDigitalOceanDroplet_withSSH(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "image", defaultImage),
 					resource.TestCheckResourceAttr(
-						"digitalocean_droplet.foobar", "region", "nyc3"),
+						"digitalocean_droplet.foobar", "region", region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "user_data", util.HashString("foobar")),
 				),
@@ -116,9 +121,10 @@ func TestAccDigitalOceanDroplet_withSSH(t *testing.T) {
 }
 
 func TestAccDigitalOceanDroplet_Update(t *testing.T) {
-	var droplet godo.Droplet
+	var droplet, renamed godo.Droplet
 	name := acceptance.RandomTestName()
 	newName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "s-1vcpu-2gb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -126,20 +132,21 @@ func TestAccDigitalOceanDroplet_Update(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_RenameAndResize(newName),
+				Config: testAccCheckDigitalOceanDropletConfig_RenameAndResize(newName, region),
 				Check: resource.ComposeTestCheckFunc(
-					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletRenamedAndResized(&droplet),
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &renamed),
+					testAccCheckDigitalOceanDropletRenamedAndResized(&renamed),
+					testAccCheckDigitalOceanDropletNotRecreated(t, &droplet, &renamed),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", newName),
 					resource.TestCheckResourceAttr(
@@ -155,6 +162,7 @@ func TestAccDigitalOceanDroplet_Update(t *testing.T) {
 func TestAccDigitalOceanDroplet_ResizeWithOutDisk(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "s-1vcpu-2gb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -162,17 +170,17 @@ func TestAccDigitalOceanDroplet_ResizeWithOutDisk(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_resize_without_disk(name),
+				Config: testAccCheckDigitalOceanDropletConfig_resize_without_disk(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					testAccCheckDigitalOceanDropletResizeWithOutDisk(&droplet),
@@ -191,6 +199,7 @@ func TestAccDigitalOceanDroplet_ResizeWithOutDisk(t *testing.T) {
 func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "s-1vcpu-2gb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -198,17 +207,17 @@ func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
 			},
 			// Test moving to larger plan with resize_disk = false only increases RAM, not disk.
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_resize_without_disk(name),
+				Config: testAccCheckDigitalOceanDropletConfig_resize_without_disk(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					testAccCheckDigitalOceanDropletResizeWithOutDisk(&droplet),
@@ -222,10 +231,10 @@ func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 			},
 			// Test that we can downgrade a Droplet plan as long as the disk remains the same
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 					resource.TestCheckResourceAttr(
@@ -236,7 +245,7 @@ func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 			},
 			// Test that resizing resize_disk = true increases the disk
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_resize(name),
+				Config: testAccCheckDigitalOceanDropletConfig_resize(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					testAccCheckDigitalOceanDropletResizeSmaller(&droplet),
@@ -246,8 +255,16 @@ func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 						"digitalocean_droplet.foobar", "size", "s-1vcpu-2gb"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "disk", "50"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "status", "active"),
 				),
 			},
+			// Test that downsizing to a plan with a smaller disk fails at plan time
+			{
+				Config:      acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("disk is smaller than the droplet's current 50GB disk"),
+			},
 		},
 	})
 }
@@ -255,6 +272,7 @@ func TestAccDigitalOceanDroplet_ResizeSmaller(t *testing.T) {
 func TestAccDigitalOceanDroplet_UpdateUserData(t *testing.T) {
 	var afterCreate, afterUpdate godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -262,17 +280,17 @@ func TestAccDigitalOceanDroplet_UpdateUserData(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
-					testAccCheckDigitalOceanDropletAttributes(&afterCreate),
+					testAccCheckDigitalOceanDropletAttributes(&afterCreate, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_userdata_update(name),
+				Config: testAccCheckDigitalOceanDropletConfig_userdata_update(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
 					resource.TestCheckResourceAttr(
@@ -289,9 +307,51 @@ func TestAccDigitalOceanDroplet_UpdateUserData(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_UserDataStore(t *testing.T) {
+	var afterCreate, afterUpdate godo.Droplet
+	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_userDataStore(name, "raw", region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data_store", "raw"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data", util.HashString("#cloud-config\nruncmd:\n  - touch /tmp/foobar\n")),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data_raw", "#cloud-config\nruncmd:\n  - touch /tmp/foobar\n"),
+				),
+			},
+			{
+				// Changing how user_data is stored does not replace the Droplet.
+				Config: testAccCheckDigitalOceanDropletConfig_userDataStore(name, "hash", region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data_store", "hash"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data", util.HashString("#cloud-config\nruncmd:\n  - touch /tmp/foobar\n")),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data_raw", ""),
+					testAccCheckDigitalOceanDropletNotRecreated(
+						t, &afterCreate, &afterUpdate),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_UpdateTags(t *testing.T) {
 	var afterCreate, afterUpdate godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -299,17 +359,17 @@ func TestAccDigitalOceanDroplet_UpdateTags(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
-					testAccCheckDigitalOceanDropletAttributes(&afterCreate),
+					testAccCheckDigitalOceanDropletAttributes(&afterCreate, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_tag_update(name),
+				Config: testAccCheckDigitalOceanDropletConfig_tag_update(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
 					resource.TestCheckResourceAttr(
@@ -327,6 +387,7 @@ func TestAccDigitalOceanDroplet_UpdateTags(t *testing.T) {
 func TestAccDigitalOceanDroplet_VPCAndIpv6(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "ipv6")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -334,10 +395,10 @@ func TestAccDigitalOceanDroplet_VPCAndIpv6(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_VPCAndIpv6(name),
+				Config: testAccCheckDigitalOceanDropletConfig_VPCAndIpv6(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes_PrivateNetworkingIpv6(&droplet),
+					testAccCheckDigitalOceanDropletAttributes_PrivateNetworkingIpv6(&droplet, region),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_droplet.foobar", "vpc_uuid"),
 					resource.TestCheckResourceAttr(
@@ -348,9 +409,47 @@ func TestAccDigitalOceanDroplet_VPCAndIpv6(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_VPCName(t *testing.T) {
+	var afterCreate, afterRename godo.Droplet
+	name := acceptance.RandomTestName()
+	vpcName := acceptance.RandomTestName("vpc")
+	region := acceptance.TestAccRegion(t, defaultSize)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_VPCName(name, vpcName, region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "vpc_name", vpcName),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_droplet.foobar", "vpc_uuid", "digitalocean_vpc.foobar", "id"),
+				),
+			},
+			// Renaming the VPC does not replace the Droplet.
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_VPCName(name, vpcName+"-renamed", region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterRename),
+					testAccCheckDigitalOceanDropletNotRecreated(t, &afterCreate, &afterRename),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "vpc_name", vpcName+"-renamed"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_droplet.foobar", "vpc_uuid", "digitalocean_vpc.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_UpdatePrivateNetworkingIpv6(t *testing.T) {
 	var afterCreate, afterUpdate godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "ipv6")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -358,10 +457,10 @@ func TestAccDigitalOceanDroplet_UpdatePrivateNetworkingIpv6(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
-					testAccCheckDigitalOceanDropletAttributes(&afterCreate),
+					testAccCheckDigitalOceanDropletAttributes(&afterCreate, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 				),
@@ -369,7 +468,7 @@ func TestAccDigitalOceanDroplet_UpdatePrivateNetworkingIpv6(t *testing.T) {
 			// For "private_networking," this is now a effectively a no-opt only updating state.
 			// All Droplets are assigned to a VPC by default. The API should still respond successfully.
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_PrivateNetworkingIpv6(name),
+				Config: testAccCheckDigitalOceanDropletConfig_PrivateNetworkingIpv6(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
 					resource.TestCheckResourceAttr(
@@ -387,6 +486,7 @@ func TestAccDigitalOceanDroplet_UpdatePrivateNetworkingIpv6(t *testing.T) {
 func TestAccDigitalOceanDroplet_Monitoring(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -394,7 +494,7 @@ func TestAccDigitalOceanDroplet_Monitoring(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_Monitoring(name),
+				Config: testAccCheckDigitalOceanDropletConfig_Monitoring(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -431,7 +531,7 @@ func TestAccDigitalOceanDroplet_conditionalVolumes(t *testing.T) {
 	})
 }
 
-func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
+func TestAccDigitalOceanDroplet_volumeOrdering(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
 
@@ -441,10 +541,44 @@ func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: testAccCheckDigitalOceanDropletConfig_volumeOrdering(name, "myvol-01", "myvol-02"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr("digitalocean_droplet.foobar", "volume_ids.#", "2"),
+				),
+			},
+			{
+				// Neither the order of the volumes, nor the volume attached
+				// by a separate resource, should produce a diff.
+				Config:   testAccCheckDigitalOceanDropletConfig_volumeOrdering(name, "myvol-02", "myvol-01"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_volumeOrdering(name, "myvol-02", "myvol-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_droplet.foobar", "volume_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("digitalocean_volume_attachment.myvol-03", "droplet_id", "digitalocean_droplet.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
+	var droplet, afterEnable, afterDisable godo.Droplet
+	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "backups")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 					resource.TestCheckResourceAttr(
@@ -453,20 +587,74 @@ func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_EnableBackups(name),
+				Config: testAccCheckDigitalOceanDropletConfig_EnableBackups(name, region),
 				Check: resource.ComposeTestCheckFunc(
-					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterEnable),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "backups", "true"),
+					testAccCheckDigitalOceanDropletNotRecreated(t, &droplet, &afterEnable),
 				),
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_DisableBackups(name),
+				Config: testAccCheckDigitalOceanDropletConfig_DisableBackups(name, region),
 				Check: resource.ComposeTestCheckFunc(
-					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterDisable),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "backups", "false"),
+					testAccCheckDigitalOceanDropletNotRecreated(t, &droplet, &afterDisable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_BackupPolicy(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize, "backups")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanDropletConfig_BackupPolicy(name, false, "weekly", "MON", 8, region),
+				ExpectError: regexp.MustCompile("backup_policy can only be set when backups is true"),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_BackupPolicy(name, true, "weekly", "MON", 8, region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backups", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.plan", "weekly"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.weekday", "MON"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.hour", "8"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_BackupPolicy(name, true, "daily", "", 20, region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.plan", "daily"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.hour", "20"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_EnableBackups(name, region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backups", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.#", "0"),
 				),
 			},
 		},
@@ -476,6 +664,7 @@ func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
 func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -483,10 +672,10 @@ func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
-					testAccCheckDigitalOceanDropletAttributes(&droplet),
+					testAccCheckDigitalOceanDropletAttributes(&droplet, region),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "name", name),
 					resource.TestCheckResourceAttr(
@@ -495,7 +684,7 @@ func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_EnableGracefulShutdown(name),
+				Config: testAccCheckDigitalOceanDropletConfig_EnableGracefulShutdown(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -504,7 +693,7 @@ func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 			},
 
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_DisableGracefulShutdown(name),
+				Config: testAccCheckDigitalOceanDropletConfig_DisableGracefulShutdown(name, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -515,12 +704,59 @@ func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_GracefulShutdownOnDestroy(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acceptance.TestAccCheckDigitalOceanDropletDestroy,
+			testAccCheckDigitalOceanDropletWasShutdown(&droplet),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_EnableGracefulShutdown(name, region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "graceful_shutdown", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDigitalOceanDropletWasShutdown checks that a shutdown action was
+// issued for the droplet before it was destroyed.
+func testAccCheckDigitalOceanDropletWasShutdown(droplet *godo.Droplet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		actions, _, err := client.Actions.List(context.Background(), &godo.ListOptions{PerPage: 200})
+		if err != nil {
+			return fmt.Errorf("Error listing actions: %s", err)
+		}
+
+		for _, a := range actions {
+			if a.ResourceID == droplet.ID && a.Type == "shutdown" {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("No shutdown action found for droplet %d", droplet.ID)
+	}
+}
+
 // TestAccDigitalOceanDroplet_withDropletAgentSetTrue tests that no error is returned
 // from the API when creating a Droplet using an OS that supports the agent
 // if the `droplet_agent` field is explicitly set to true.
 func TestAccDigitalOceanDroplet_withDropletAgentSetTrue(t *testing.T) {
-	var droplet godo.Droplet
+	var droplet, afterUpdate godo.Droplet
 	keyName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
 	if err != nil {
 		t.Fatalf("Cannot generate test SSH key pair: %s", err)
@@ -534,7 +770,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentSetTrue(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "ubuntu-20-04-x64", agent),
+				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "ubuntu-20-04-x64", agent, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -545,6 +781,18 @@ func TestAccDigitalOceanDroplet_withDropletAgentSetTrue(t *testing.T) {
 						"digitalocean_droplet.foobar", "image", "ubuntu-20-04-x64"),
 				),
 			},
+			{
+				// Changing the flag on an existing Droplet does not replace
+				// it, and the installed agent is still read back.
+				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "ubuntu-20-04-x64", "droplet_agent = false", region),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
+					testAccCheckDigitalOceanDropletNotRecreated(t, &droplet, &afterUpdate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "droplet_agent", "true"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -557,6 +805,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentSetFalse(t *testing.T) {
 
 	var droplet godo.Droplet
 	keyName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
 	if err != nil {
 		t.Fatalf("Cannot generate test SSH key pair: %s", err)
@@ -570,7 +819,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentSetFalse(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", agent),
+				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", agent, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -593,6 +842,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentNotSet(t *testing.T) {
 
 	var droplet godo.Droplet
 	keyName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
 	if err != nil {
 		t.Fatalf("Cannot generate test SSH key pair: %s", err)
@@ -605,7 +855,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentNotSet(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", ""),
+				Config: testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", "", region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
@@ -615,7 +865,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentNotSet(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "image", "rancheros"),
 					resource.TestCheckResourceAttr(
-						"digitalocean_droplet.foobar", "region", "nyc3"),
+						"digitalocean_droplet.foobar", "region", region),
 				),
 			},
 		},
@@ -629,6 +879,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentExpectError(t *testing.T) {
 	t.Skip("All Droplet OSes currently support the Droplet agent")
 
 	keyName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
 	if err != nil {
 		t.Fatalf("Cannot generate test SSH key pair: %s", err)
@@ -642,7 +893,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentExpectError(t *testing.T) {
 		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", agent),
+				Config:      testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, publicKeyMaterial, dropletName, "rancheros", agent, region),
 				ExpectError: regexp.MustCompile(`is not supported`),
 			},
 		},
@@ -651,6 +902,7 @@ func TestAccDigitalOceanDroplet_withDropletAgentExpectError(t *testing.T) {
 
 func TestAccDigitalOceanDroplet_withTimeout(t *testing.T) {
 	dropletName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, defaultSize)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -662,11 +914,11 @@ func TestAccDigitalOceanDroplet_withTimeout(t *testing.T) {
   name   = "%s"
   size   = "s-1vcpu-1gb"
   image  = "ubuntu-22-04-x64"
-  region = "nyc3"
+  region = "%s"
   timeouts {
     create = "5s"
   }
-}`, dropletName),
+}`, dropletName, region),
 				ExpectError: regexp.MustCompile(`timeout while waiting for state`),
 			},
 		},
@@ -705,7 +957,7 @@ resource "digitalocean_droplet" "foobar" {
 	})
 }
 
-func testAccCheckDigitalOceanDropletAttributes(droplet *godo.Droplet) resource.TestCheckFunc {
+func testAccCheckDigitalOceanDropletAttributes(droplet *godo.Droplet, region string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
 		if droplet.URN() != fmt.Sprintf("do:droplet:%d", droplet.ID) {
@@ -728,7 +980,7 @@ func testAccCheckDigitalOceanDropletAttributes(droplet *godo.Droplet) resource.T
 			return fmt.Errorf("Bad price_monthly: %v", droplet.Size.PriceMonthly)
 		}
 
-		if droplet.Region.Slug != "nyc3" {
+		if droplet.Region.Slug != region {
 			return fmt.Errorf("Bad region_slug: %s", droplet.Region.Slug)
 		}
 
@@ -781,7 +1033,7 @@ func testAccCheckDigitalOceanDropletResizeSmaller(droplet *godo.Droplet) resourc
 	}
 }
 
-func testAccCheckDigitalOceanDropletAttributes_PrivateNetworkingIpv6(d *godo.Droplet) resource.TestCheckFunc {
+func testAccCheckDigitalOceanDropletAttributes_PrivateNetworkingIpv6(d *godo.Droplet, region string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
 		if d.Image.Slug != "ubuntu-22-04-x64" {
@@ -792,7 +1044,7 @@ func testAccCheckDigitalOceanDropletAttributes_PrivateNetworkingIpv6(d *godo.Dro
 			return fmt.Errorf("Bad size_slug: %s", d.Size.Slug)
 		}
 
-		if d.Region.Slug != "nyc3" {
+		if d.Region.Slug != region {
 			return fmt.Errorf("Bad region_slug: %s", d.Region.Slug)
 		}
 
@@ -832,7 +1084,17 @@ func testAccCheckDigitalOceanDropletRecreated(t *testing.T,
 	}
 }
 
-func testAccCheckDigitalOceanDropletConfig_withID(name string, slug string) string {
+func testAccCheckDigitalOceanDropletNotRecreated(t *testing.T,
+	before, after *godo.Droplet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			t.Fatalf("Expected droplet not to be recreated, but ID changed from %v to %v", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDropletConfig_withID(name string, slug, region string) string {
 	return fmt.Sprintf(`
 data "digitalocean_image" "foobar" {
   slug = "%s"
@@ -842,12 +1104,12 @@ resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = data.digitalocean_image.foobar.id
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
-}`, slug, name, defaultSize)
+}`, slug, name, defaultSize, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_withSSH(name string, testAccValidPublicKey string) string {
+func testAccCheckDigitalOceanDropletConfig_withSSH(name string, testAccValidPublicKey, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_ssh_key" "foobar" {
   name       = "%s-key"
@@ -858,13 +1120,13 @@ resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = "%s"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
   ssh_keys  = [digitalocean_ssh_key.foobar.id]
-}`, name, testAccValidPublicKey, name, defaultSize, defaultImage)
+}`, name, testAccValidPublicKey, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_tag_update(name string) string {
+func testAccCheckDigitalOceanDropletConfig_tag_update(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "barbaz" {
   name = "barbaz"
@@ -874,103 +1136,137 @@ resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = "%s"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
   tags      = [digitalocean_tag.barbaz.id]
 }
-`, name, defaultSize, defaultImage)
+`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_userdata_update(name string) string {
+func testAccCheckDigitalOceanDropletConfig_userdata_update(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = "%s"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar foobar"
 }
-`, name, defaultSize, defaultImage)
+`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_RenameAndResize(newName string) string {
+func testAccCheckDigitalOceanDropletConfig_userDataStore(name, store, region string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name            = "%s"
+  size            = "%s"
+  image           = "%s"
+  region          = "%s"
+  user_data_store = "%s"
+  user_data       = <<-EOT
+    #cloud-config
+    runcmd:
+      - touch /tmp/foobar
+  EOT
+}
+`, name, defaultSize, defaultImage, region, store)
+}
+
+func testAccCheckDigitalOceanDropletConfig_RenameAndResize(newName, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name   = "%s"
   size   = "s-1vcpu-2gb"
   image  = "%s"
-  region = "nyc3"
+  region = "%s"
 }
-`, newName, defaultImage)
+`, newName, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_resize_without_disk(name string) string {
+func testAccCheckDigitalOceanDropletConfig_resize_without_disk(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name        = "%s"
   size        = "s-1vcpu-2gb"
   image       = "%s"
-  region      = "nyc3"
+  region      = "%s"
   user_data   = "foobar"
   resize_disk = false
 }
-`, name, defaultImage)
+`, name, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_resize(name string) string {
+func testAccCheckDigitalOceanDropletConfig_resize(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name        = "%s"
   size        = "s-1vcpu-2gb"
   image       = "%s"
-  region      = "nyc3"
+  region      = "%s"
   user_data   = "foobar"
   resize_disk = true
 }
-`, name, defaultImage)
+`, name, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_PrivateNetworkingIpv6(name string) string {
+func testAccCheckDigitalOceanDropletConfig_PrivateNetworkingIpv6(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name               = "%s"
   size               = "%s"
   image              = "%s"
-  region             = "nyc3"
+  region             = "%s"
   ipv6               = true
   private_networking = true
 }
-`, name, defaultSize, defaultImage)
+`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_VPCAndIpv6(name string) string {
+func testAccCheckDigitalOceanDropletConfig_VPCAndIpv6(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_vpc" "foobar" {
   name   = "%s"
-  region = "nyc3"
+  region = "%s"
 }
 
 resource "digitalocean_droplet" "foobar" {
   name     = "%s"
   size     = "%s"
   image    = "%s"
-  region   = "nyc3"
+  region   = "%s"
   ipv6     = true
   vpc_uuid = digitalocean_vpc.foobar.id
 }
-`, acceptance.RandomTestName(), name, defaultSize, defaultImage)
+`, acceptance.RandomTestName(), region, name, defaultSize, defaultImage, region)
+}
+
+func testAccCheckDigitalOceanDropletConfig_VPCName(name, vpcName, region string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_vpc" "foobar" {
+  name   = "%s"
+  region = "%s"
 }
 
-func testAccCheckDigitalOceanDropletConfig_Monitoring(name string) string {
+resource "digitalocean_droplet" "foobar" {
+  name     = "%s"
+  size     = "%s"
+  image    = "%s"
+  region   = "%s"
+  vpc_name = digitalocean_vpc.foobar.name
+}
+`, vpcName, region, name, defaultSize, defaultImage, region)
+}
+
+func testAccCheckDigitalOceanDropletConfig_Monitoring(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name       = "%s"
   size       = "%s"
   image      = "%s"
-  region     = "nyc3"
+  region     = "%s"
   monitoring = true
 }
- `, name, defaultSize, defaultImage)
+ `, name, defaultSize, defaultImage, region)
 }
 
 func testAccCheckDigitalOceanDropletConfig_conditionalVolumes(name string) string {
@@ -1000,31 +1296,88 @@ resource "digitalocean_droplet" "foobar" {
 `, name, name, name, defaultImage, defaultSize)
 }
 
-func testAccCheckDigitalOceanDropletConfig_EnableBackups(name string) string {
+func testAccCheckDigitalOceanDropletConfig_volumeOrdering(name, first, second string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_volume" "myvol-01" {
+  region = "sfo3"
+  name   = "%[1]s-01"
+  size   = 1
+}
+
+resource "digitalocean_volume" "myvol-02" {
+  region = "sfo3"
+  name   = "%[1]s-02"
+  size   = 1
+}
+
+resource "digitalocean_volume" "myvol-03" {
+  region = "sfo3"
+  name   = "%[1]s-03"
+  size   = 1
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name       = "%[1]s"
+  region     = "sfo3"
+  image      = "%[2]s"
+  size       = "%[3]s"
+  volume_ids = [digitalocean_volume.%[4]s.id, digitalocean_volume.%[5]s.id]
+}
+
+resource "digitalocean_volume_attachment" "myvol-03" {
+  droplet_id = digitalocean_droplet.foobar.id
+  volume_id  = digitalocean_volume.myvol-03.id
+}
+`, name, defaultImage, defaultSize, first, second)
+}
+
+func testAccCheckDigitalOceanDropletConfig_EnableBackups(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = "%s"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
   backups   = true
-}`, name, defaultSize, defaultImage)
+}`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_DisableBackups(name string) string {
+func testAccCheckDigitalOceanDropletConfig_DisableBackups(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name      = "%s"
   size      = "%s"
   image     = "%s"
-  region    = "nyc3"
+  region    = "%s"
   user_data = "foobar"
   backups   = false
-}`, name, defaultSize, defaultImage)
+}`, name, defaultSize, defaultImage, region)
+}
+
+func testAccCheckDigitalOceanDropletConfig_BackupPolicy(name string, backups bool, plan, weekday string, hour int, region string) string {
+	if weekday != "" {
+		weekday = fmt.Sprintf("weekday = %q", weekday)
+	}
+
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name      = "%s"
+  size      = "%s"
+  image     = "%s"
+  region    = "%s"
+  user_data = "foobar"
+  backups   = %t
+
+  backup_policy {
+    plan = "%s"
+    hour = %d
+    %s
+  }
+}`, name, defaultSize, defaultImage, region, backups, plan, hour, weekday)
 }
 
-func testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, testAccValidPublicKey, dropletName, image, agent string) string {
+func testAccCheckDigitalOceanDropletConfig_DropletAgent(keyName, testAccValidPublicKey, dropletName, image, agent, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_ssh_key" "foobar" {
   name       = "%s"
@@ -1035,32 +1388,32 @@ resource "digitalocean_droplet" "foobar" {
   name     = "%s"
   size     = "%s"
   image    = "%s"
-  region   = "nyc3"
+  region   = "%s"
   ssh_keys = [digitalocean_ssh_key.foobar.id]
   %s
-}`, keyName, testAccValidPublicKey, dropletName, defaultSize, image, agent)
+}`, keyName, testAccValidPublicKey, dropletName, defaultSize, image, region, agent)
 }
 
-func testAccCheckDigitalOceanDropletConfig_EnableGracefulShutdown(name string) string {
+func testAccCheckDigitalOceanDropletConfig_EnableGracefulShutdown(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name              = "%s"
   size              = "%s"
   image             = "%s"
-  region            = "nyc3"
+  region            = "%s"
   user_data         = "foobar"
   graceful_shutdown = true
-}`, name, defaultSize, defaultImage)
+}`, name, defaultSize, defaultImage, region)
 }
 
-func testAccCheckDigitalOceanDropletConfig_DisableGracefulShutdown(name string) string {
+func testAccCheckDigitalOceanDropletConfig_DisableGracefulShutdown(name, region string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name              = "%s"
   size              = "%s"
   image             = "%s"
-  region            = "nyc3"
+  region            = "%s"
   user_data         = "foobar"
   graceful_shutdown = false
-}`, name, defaultSize, defaultImage)
+}`, name, defaultSize, defaultImage, region)
 }
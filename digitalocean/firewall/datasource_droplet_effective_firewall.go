@@ -0,0 +1,393 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletEffectiveFirewall() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDropletEffectiveFirewallRead,
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"firewall_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the firewalls applying to the Droplet, directly or through its tags",
+			},
+
+			"inbound_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     effectiveFirewallRuleSchema("source"),
+			},
+
+			"outbound_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     effectiveFirewallRuleSchema("destination"),
+			},
+
+			"rules_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func effectiveFirewallRuleSchema(prefix string) *schema.Resource {
+	prefix += "_"
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_range": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			prefix + "addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			prefix + "droplet_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			prefix + "kubernetes_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			prefix + "load_balancer_uids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			prefix + "tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"firewall_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the firewalls contributing to the rule",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDropletEffectiveFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := d.Get("droplet_id").(int)
+	droplet, _, err := client.Droplets.Get(context.Background(), dropletID)
+	if err != nil {
+		return diag.Errorf("Error retrieving droplet (%d): %s", dropletID, err)
+	}
+
+	firewalls, err := listDigitalOceanFirewalls(client)
+	if err != nil {
+		return diag.Errorf("Error retrieving firewalls: %s", err)
+	}
+
+	var applied []godo.Firewall
+	var firewallIDs []string
+	for _, fw := range firewalls {
+		if firewallAppliesToDroplet(fw, droplet.ID, droplet.Tags) {
+			applied = append(applied, fw)
+			firewallIDs = append(firewallIDs, fw.ID)
+		}
+	}
+	sort.Strings(firewallIDs)
+
+	inbound, outbound := mergeFirewallRules(applied)
+
+	rulesJSON, err := flattenEffectiveFirewallRulesJSON(inbound, outbound)
+	if err != nil {
+		return diag.Errorf("Error encoding effective firewall rules: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(droplet.ID))
+
+	if err := d.Set("firewall_ids", firewallIDs); err != nil {
+		return diag.Errorf("Error setting `firewall_ids`: %+v", err)
+	}
+
+	if err := d.Set("inbound_rule", flattenEffectiveFirewallRules(inbound, "source_")); err != nil {
+		return diag.Errorf("Error setting `inbound_rule`: %+v", err)
+	}
+
+	if err := d.Set("outbound_rule", flattenEffectiveFirewallRules(outbound, "destination_")); err != nil {
+		return diag.Errorf("Error setting `outbound_rule`: %+v", err)
+	}
+
+	d.Set("rules_json", rulesJSON)
+
+	return nil
+}
+
+func listDigitalOceanFirewalls(client *godo.Client) ([]godo.Firewall, error) {
+	var firewalls []godo.Firewall
+
+	opt := &godo.ListOptions{PerPage: 200}
+	for {
+		page, resp, err := client.Firewalls.List(context.Background(), opt)
+		if err != nil {
+			return nil, err
+		}
+
+		firewalls = append(firewalls, page...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		current, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opt.Page = current + 1
+	}
+
+	return firewalls, nil
+}
+
+// firewallAppliesToDroplet returns true if the firewall applies to the
+// Droplet, either directly or through one of the Droplet's tags.
+func firewallAppliesToDroplet(fw godo.Firewall, dropletID int, dropletTags []string) bool {
+	for _, id := range fw.DropletIDs {
+		if id == dropletID {
+			return true
+		}
+	}
+
+	for _, fwTag := range fw.Tags {
+		for _, dropletTag := range dropletTags {
+			if fwTag == dropletTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// effectiveFirewallRule is a rule merged from the rules of every firewall
+// applying to a Droplet that share the same protocol and port range. As
+// firewall rules only ever allow traffic, the targets of the merged rule are
+// the union of the targets of those rules.
+type effectiveFirewallRule struct {
+	Protocol    string
+	PortRange   string
+	Targets     *firewallRuleTargetsJSON
+	FirewallIDs []string
+}
+
+type effectiveFirewallRuleTargets struct {
+	addresses        map[string]bool
+	dropletIDs       map[int]bool
+	kubernetesIDs    map[string]bool
+	loadBalancerUIDs map[string]bool
+	tags             map[string]bool
+	firewallIDs      map[string]bool
+}
+
+func newEffectiveFirewallRuleTargets() *effectiveFirewallRuleTargets {
+	return &effectiveFirewallRuleTargets{
+		addresses:        map[string]bool{},
+		dropletIDs:       map[int]bool{},
+		kubernetesIDs:    map[string]bool{},
+		loadBalancerUIDs: map[string]bool{},
+		tags:             map[string]bool{},
+		firewallIDs:      map[string]bool{},
+	}
+}
+
+func (t *effectiveFirewallRuleTargets) add(firewallID string, addresses []string, dropletIDs []int, kubernetesIDs []string, loadBalancerUIDs []string, tags []string) {
+	t.firewallIDs[firewallID] = true
+	for _, v := range addresses {
+		t.addresses[v] = true
+	}
+	for _, v := range dropletIDs {
+		t.dropletIDs[v] = true
+	}
+	for _, v := range kubernetesIDs {
+		t.kubernetesIDs[v] = true
+	}
+	for _, v := range loadBalancerUIDs {
+		t.loadBalancerUIDs[v] = true
+	}
+	for _, v := range tags {
+		t.tags[v] = true
+	}
+}
+
+func firewallStringSetKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// effectiveFirewallRuleMerger merges rules by protocol and normalized port
+// range.
+type effectiveFirewallRuleMerger struct {
+	rules map[[2]string]*effectiveFirewallRuleTargets
+}
+
+func newEffectiveFirewallRuleMerger() *effectiveFirewallRuleMerger {
+	return &effectiveFirewallRuleMerger{rules: map[[2]string]*effectiveFirewallRuleTargets{}}
+}
+
+func (m *effectiveFirewallRuleMerger) add(firewallID string, protocol string, portRange string, addresses []string, dropletIDs []int, kubernetesIDs []string, loadBalancerUIDs []string, tags []string) {
+	key := [2]string{protocol, normalizeFirewallPortRange(protocol, portRange)}
+	targets, ok := m.rules[key]
+	if !ok {
+		targets = newEffectiveFirewallRuleTargets()
+		m.rules[key] = targets
+	}
+	targets.add(firewallID, addresses, dropletIDs, kubernetesIDs, loadBalancerUIDs, tags)
+}
+
+// merged returns the merged rules ordered by protocol and port range, with
+// every list sorted, so that the result does not depend on the order of the
+// firewalls or rules returned by the API.
+func (m *effectiveFirewallRuleMerger) merged() []effectiveFirewallRule {
+	rules := make([]effectiveFirewallRule, 0, len(m.rules))
+	for key, targets := range m.rules {
+		dropletIDs := make([]int, 0, len(targets.dropletIDs))
+		for id := range targets.dropletIDs {
+			dropletIDs = append(dropletIDs, id)
+		}
+
+		rules = append(rules, effectiveFirewallRule{
+			Protocol:  key[0],
+			PortRange: key[1],
+			Targets: canonicalFirewallRuleTargets(
+				firewallStringSetKeys(targets.addresses),
+				dropletIDs,
+				firewallStringSetKeys(targets.kubernetesIDs),
+				firewallStringSetKeys(targets.loadBalancerUIDs),
+				firewallStringSetKeys(targets.tags),
+			),
+			FirewallIDs: canonicalFirewallStrings(firewallStringSetKeys(targets.firewallIDs)),
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Protocol != rules[j].Protocol {
+			return rules[i].Protocol < rules[j].Protocol
+		}
+		return rules[i].PortRange < rules[j].PortRange
+	})
+
+	return rules
+}
+
+// mergeFirewallRules merges the inbound and outbound rules of the firewalls.
+func mergeFirewallRules(firewalls []godo.Firewall) ([]effectiveFirewallRule, []effectiveFirewallRule) {
+	inbound := newEffectiveFirewallRuleMerger()
+	outbound := newEffectiveFirewallRuleMerger()
+
+	for _, fw := range firewalls {
+		for _, rule := range fw.InboundRules {
+			src := rule.Sources
+			if src == nil {
+				src = &godo.Sources{}
+			}
+			inbound.add(fw.ID, rule.Protocol, rule.PortRange, src.Addresses, src.DropletIDs, src.KubernetesIDs, src.LoadBalancerUIDs, src.Tags)
+		}
+
+		for _, rule := range fw.OutboundRules {
+			dest := rule.Destinations
+			if dest == nil {
+				dest = &godo.Destinations{}
+			}
+			outbound.add(fw.ID, rule.Protocol, rule.PortRange, dest.Addresses, dest.DropletIDs, dest.KubernetesIDs, dest.LoadBalancerUIDs, dest.Tags)
+		}
+	}
+
+	return inbound.merged(), outbound.merged()
+}
+
+// flattenEffectiveFirewallRules flattens the merged rules into state, prefixing
+// the targets with "source_" or "destination_".
+func flattenEffectiveFirewallRules(rules []effectiveFirewallRule, prefix string) []interface{} {
+	flattened := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		flattened[i] = map[string]interface{}{
+			"protocol":                    rule.Protocol,
+			"port_range":                  rule.PortRange,
+			prefix + "addresses":          rule.Targets.Addresses,
+			prefix + "droplet_ids":        rule.Targets.DropletIDs,
+			prefix + "kubernetes_ids":     rule.Targets.KubernetesIDs,
+			prefix + "load_balancer_uids": rule.Targets.LoadBalancerUIDs,
+			prefix + "tags":               rule.Targets.Tags,
+			"firewall_ids":                rule.FirewallIDs,
+		}
+	}
+	return flattened
+}
+
+// flattenEffectiveFirewallRulesJSON renders the merged rules in the same
+// canonical form as the `rules_json` attribute of a firewall.
+func flattenEffectiveFirewallRulesJSON(inbound []effectiveFirewallRule, outbound []effectiveFirewallRule) (string, error) {
+	doc := firewallRulesJSON{
+		InboundRules:  make([]firewallRuleJSON, 0, len(inbound)),
+		OutboundRules: make([]firewallRuleJSON, 0, len(outbound)),
+	}
+
+	for _, rule := range inbound {
+		doc.InboundRules = append(doc.InboundRules, firewallRuleJSON{
+			Protocol:  rule.Protocol,
+			PortRange: rule.PortRange,
+			Sources:   rule.Targets,
+		})
+	}
+
+	for _, rule := range outbound {
+		doc.OutboundRules = append(doc.OutboundRules, firewallRuleJSON{
+			Protocol:     rule.Protocol,
+			PortRange:    rule.PortRange,
+			Destinations: rule.Targets,
+		})
+	}
+
+	if err := sortFirewallRulesJSON(doc.InboundRules); err != nil {
+		return "", err
+	}
+	if err := sortFirewallRulesJSON(doc.OutboundRules); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
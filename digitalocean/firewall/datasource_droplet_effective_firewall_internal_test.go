@@ -0,0 +1,173 @@
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestFirewallAppliesToDroplet(t *testing.T) {
+	cases := []struct {
+		name     string
+		firewall godo.Firewall
+		expected bool
+	}{
+		{"DropletID", godo.Firewall{DropletIDs: []int{1, 1234}}, true},
+		{"Tag", godo.Firewall{Tags: []string{"db", "web"}}, true},
+		{"OtherDroplet", godo.Firewall{DropletIDs: []int{1}, Tags: []string{"db"}}, false},
+		{"Empty", godo.Firewall{}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := firewallAppliesToDroplet(tc.firewall, 1234, []string{"web"}); actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMergeFirewallRules(t *testing.T) {
+	firewalls := []godo.Firewall{
+		{
+			ID: "fw-b",
+			InboundRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"10.0.0.0/8"}, DropletIDs: []int{2}}},
+				{Protocol: "tcp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"internal"}}},
+			},
+			OutboundRules: []godo.OutboundRule{
+				{Protocol: "udp", PortRange: "53", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+			},
+		},
+		{
+			ID: "fw-a",
+			InboundRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.168.0.0/16", "10.0.0.0/8"}, DropletIDs: []int{1}}},
+				{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{LoadBalancerUIDs: []string{"lb"}}},
+				{Protocol: "icmp"},
+			},
+		},
+	}
+
+	inbound, outbound := mergeFirewallRules(firewalls)
+
+	expectedInbound := []effectiveFirewallRule{
+		{
+			Protocol:    "icmp",
+			PortRange:   "",
+			Targets:     canonicalFirewallRuleTargets(nil, nil, nil, nil, nil),
+			FirewallIDs: []string{"fw-a"},
+		},
+		{
+			Protocol:    "tcp",
+			PortRange:   "22",
+			Targets:     canonicalFirewallRuleTargets([]string{"10.0.0.0/8", "192.168.0.0/16"}, []int{1, 2}, nil, nil, nil),
+			FirewallIDs: []string{"fw-a", "fw-b"},
+		},
+		{
+			Protocol:    "tcp",
+			PortRange:   "all",
+			Targets:     canonicalFirewallRuleTargets(nil, nil, nil, []string{"lb"}, []string{"internal"}),
+			FirewallIDs: []string{"fw-a", "fw-b"},
+		},
+	}
+	if !reflect.DeepEqual(inbound, expectedInbound) {
+		t.Errorf("unexpected inbound rules:\n%s", spewEffectiveFirewallRules(inbound))
+	}
+
+	expectedOutbound := []effectiveFirewallRule{
+		{
+			Protocol:    "udp",
+			PortRange:   "53",
+			Targets:     canonicalFirewallRuleTargets([]string{"0.0.0.0/0"}, nil, nil, nil, nil),
+			FirewallIDs: []string{"fw-b"},
+		},
+	}
+	if !reflect.DeepEqual(outbound, expectedOutbound) {
+		t.Errorf("unexpected outbound rules:\n%s", spewEffectiveFirewallRules(outbound))
+	}
+
+	// The merged rules must not depend on the order of the firewalls.
+	reversedInbound, reversedOutbound := mergeFirewallRules([]godo.Firewall{firewalls[1], firewalls[0]})
+	if !reflect.DeepEqual(inbound, reversedInbound) || !reflect.DeepEqual(outbound, reversedOutbound) {
+		t.Error("expected the merged rules to be independent of firewall order")
+	}
+}
+
+func TestFlattenEffectiveFirewallRulesJSON(t *testing.T) {
+	inbound, outbound := mergeFirewallRules([]godo.Firewall{
+		{
+			ID: "fw-a",
+			InboundRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"10.0.0.0/8"}}},
+			},
+		},
+		{
+			ID: "fw-b",
+			InboundRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{DropletIDs: []int{1}}},
+			},
+		},
+	})
+
+	got, err := flattenEffectiveFirewallRulesJSON(inbound, outbound)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"inbound_rules":[{"port_range":"22","protocol":"tcp","sources":{"addresses":["10.0.0.0/8"],"droplet_ids":[1],"kubernetes_ids":[],"load_balancer_uids":[],"tags":[]}}],"outbound_rules":[]}`
+	if got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestListDigitalOceanFirewalls(t *testing.T) {
+	const pages = 3
+
+	requests := 0
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+
+		// godo determines the current page from the previous page link.
+		links := map[string]string{}
+		if page > 1 {
+			links["prev"] = fmt.Sprintf("http://example.com/v2/firewalls?page=%d", page-1)
+		}
+		if page < pages {
+			links["next"] = fmt.Sprintf("http://example.com/v2/firewalls?page=%d", page+1)
+		}
+		body, _ := json.Marshal(map[string]interface{}{
+			"firewalls": []map[string]string{{"id": fmt.Sprintf("fw-%d", page)}},
+			"links":     map[string]interface{}{"pages": links},
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})).GodoClient()
+
+	firewalls, err := listDigitalOceanFirewalls(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requests != pages {
+		t.Errorf("expected %d requests, got %d", pages, requests)
+	}
+	if len(firewalls) != pages || firewalls[0].ID != "fw-1" || firewalls[pages-1].ID != "fw-3" {
+		t.Errorf("unexpected firewalls: %+v", firewalls)
+	}
+}
+
+func spewEffectiveFirewallRules(rules []effectiveFirewallRule) string {
+	b, _ := json.MarshalIndent(rules, "", "  ")
+	return string(b)
+}
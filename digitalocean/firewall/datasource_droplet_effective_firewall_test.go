@@ -0,0 +1,102 @@
+package firewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletEffectiveFirewall_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanDropletEffectiveFirewallConfig(name),
+			},
+			{
+				Config: testAccCheckDataSourceDigitalOceanDropletEffectiveFirewallConfig(name) + `
+data "digitalocean_droplet_effective_firewall" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_effective_firewall.foobar", "id",
+						"digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "firewall_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.digitalocean_droplet_effective_firewall.foobar", "firewall_ids.*",
+						"digitalocean_firewall.by_id", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.digitalocean_droplet_effective_firewall.foobar", "firewall_ids.*",
+						"digitalocean_firewall.by_tag", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.port_range", "22"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.source_addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.source_addresses.0", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.source_addresses.1", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.0.firewall_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.1.port_range", "80"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.1.firewall_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_effective_firewall.foobar", "inbound_rule.1.firewall_ids.0",
+						"digitalocean_firewall.by_tag", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "outbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_effective_firewall.foobar", "outbound_rule.0.protocol", "udp"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_effective_firewall.foobar", "rules_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDigitalOceanDropletEffectiveFirewallConfig(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_tag" "foobar" {
+  name = "%[1]s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%[1]s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  tags   = [digitalocean_tag.foobar.id]
+}
+
+resource "digitalocean_firewall" "by_id" {
+  name        = "%[1]s-id"
+  droplet_ids = [digitalocean_droplet.foobar.id]
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "22"
+    source_addresses = ["10.0.0.0/8"]
+  }
+}
+
+resource "digitalocean_firewall" "by_tag" {
+  name = "%[1]s-tag"
+  tags = [digitalocean_tag.foobar.id]
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "22"
+    source_addresses = ["192.168.0.0/16"]
+  }
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "80"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+
+  outbound_rule {
+    protocol              = "udp"
+    port_range            = "53"
+    destination_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+`, name)
+}
@@ -51,6 +51,8 @@ data "digitalocean_firewall" "foobar" {
 						"data.digitalocean_firewall.foobar", "pending_changes"),
 					resource.TestCheckResourceAttrPair("digitalocean_firewall.foobar", "tags",
 						"data.digitalocean_firewall.foobar", "tags"),
+					resource.TestCheckResourceAttrPair("digitalocean_firewall.foobar", "urn",
+						"data.digitalocean_firewall.foobar", "urn"),
 					resource.TestCheckResourceAttr("data.digitalocean_firewall.foobar", "inbound_rule.0.protocol", "tcp"),
 					resource.TestCheckResourceAttr("data.digitalocean_firewall.foobar", "inbound_rule.0.port_range", "22"),
 					resource.TestCheckResourceAttr("data.digitalocean_firewall.foobar", "inbound_rule.0.source_addresses.0", "0.0.0.0/0"),
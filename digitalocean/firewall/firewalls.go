@@ -1,6 +1,14 @@
 package firewall
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,6 +73,17 @@ func firewallSchema() map[string]*schema.Schema {
 		},
 
 		"tags": tag.TagsSchema(),
+
+		"rules_json": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"urn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The uniform resource name (URN) for the firewall",
+		},
 	}
 }
 
@@ -197,6 +216,54 @@ func expandFirewallOutboundRules(rules []interface{}) []godo.OutboundRule {
 	return expandedRules
 }
 
+// firewallMissingTagsError returns an error naming the tags referenced by a
+// firewall request which do not exist, as the API rejects such a request
+// without saying which tag is missing. It returns nil if all of the tags
+// exist, or if they can not be looked up.
+func firewallMissingTagsError(ctx context.Context, client *godo.Client, opts *godo.FirewallRequest) error {
+	var names []string
+	references := make(map[string]string)
+	reference := func(tags []string, attribute string) {
+		for _, name := range tags {
+			if _, ok := references[name]; !ok {
+				names = append(names, name)
+				references[name] = attribute
+			}
+		}
+	}
+
+	for _, rule := range opts.InboundRules {
+		if rule.Sources != nil {
+			reference(rule.Sources.Tags, "source_tags of an inbound_rule")
+		}
+	}
+	for _, rule := range opts.OutboundRules {
+		if rule.Destinations != nil {
+			reference(rule.Destinations.Tags, "destination_tags of an outbound_rule")
+		}
+	}
+	reference(opts.Tags, "tags")
+
+	var missing []string
+	for _, name := range names {
+		_, resp, err := client.Tags.Get(ctx, name)
+		if err == nil {
+			continue
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil
+		}
+
+		missing = append(missing, fmt.Sprintf("tag %q referenced by the %s does not exist", name, references[name]))
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(missing, "; "))
+}
+
 func firewallPendingChanges(d *schema.ResourceData, firewall *godo.Firewall) []interface{} {
 	remote := make([]interface{}, 0, len(firewall.PendingChanges))
 	for _, change := range firewall.PendingChanges {
@@ -210,6 +277,20 @@ func firewallPendingChanges(d *schema.ResourceData, firewall *godo.Firewall) []i
 	return remote
 }
 
+// normalizeFirewallPortRange returns the port range as it is represented in
+// state. The API returns 0 when the port range was specified as all. If
+// protocol is `icmp` the API returns 0 for when port was not specified.
+func normalizeFirewallPortRange(protocol string, portRange string) string {
+	if portRange == "0" {
+		if protocol != "icmp" {
+			return "all"
+		}
+		return ""
+	}
+
+	return portRange
+}
+
 func flattenFirewallDropletIds(droplets []int) *schema.Set {
 	if droplets == nil {
 		return nil
@@ -247,15 +328,8 @@ func flattenFirewallInboundRules(rules []godo.InboundRule) []interface{} {
 			"protocol": protocol,
 		}
 
-		// The API returns 0 when the port range was specified as all.
-		// If protocol is `icmp` the API returns 0 for when port was
-		// not specified.
-		if portRange == "0" {
-			if protocol != "icmp" {
-				rawRule["port_range"] = "all"
-			}
-		} else {
-			rawRule["port_range"] = portRange
+		if pr := normalizeFirewallPortRange(protocol, portRange); pr != "" {
+			rawRule["port_range"] = pr
 		}
 
 		if sources.Tags != nil {
@@ -299,15 +373,8 @@ func flattenFirewallOutboundRules(rules []godo.OutboundRule) []interface{} {
 			"protocol": protocol,
 		}
 
-		// The API returns 0 when the port range was specified as all.
-		// If protocol is `icmp` the API returns 0 for when port was
-		// not specified.
-		if portRange == "0" {
-			if protocol != "icmp" {
-				rawRule["port_range"] = "all"
-			}
-		} else {
-			rawRule["port_range"] = portRange
+		if pr := normalizeFirewallPortRange(protocol, portRange); pr != "" {
+			rawRule["port_range"] = pr
 		}
 
 		if destinations.Tags != nil {
@@ -335,3 +402,129 @@ func flattenFirewallOutboundRules(rules []godo.OutboundRule) []interface{} {
 
 	return flattenedRules
 }
+
+// firewallRuleTargetsJSON is the canonical representation of the sources or
+// destinations of a firewall rule. Fields are declared in alphabetical order
+// and every list is sorted so that the encoded JSON is stable.
+type firewallRuleTargetsJSON struct {
+	Addresses        []string `json:"addresses"`
+	DropletIDs       []int    `json:"droplet_ids"`
+	KubernetesIDs    []string `json:"kubernetes_ids"`
+	LoadBalancerUIDs []string `json:"load_balancer_uids"`
+	Tags             []string `json:"tags"`
+}
+
+// firewallRuleJSON is the canonical representation of a single firewall rule.
+type firewallRuleJSON struct {
+	Destinations *firewallRuleTargetsJSON `json:"destinations,omitempty"`
+	PortRange    string                   `json:"port_range"`
+	Protocol     string                   `json:"protocol"`
+	Sources      *firewallRuleTargetsJSON `json:"sources,omitempty"`
+}
+
+// firewallRulesJSON is the document exported through the `rules_json`
+// attribute.
+type firewallRulesJSON struct {
+	InboundRules  []firewallRuleJSON `json:"inbound_rules"`
+	OutboundRules []firewallRuleJSON `json:"outbound_rules"`
+}
+
+func canonicalFirewallStrings(strs []string) []string {
+	canonical := make([]string, len(strs))
+	copy(canonical, strs)
+	sort.Strings(canonical)
+	return canonical
+}
+
+func canonicalFirewallRuleTargets(addresses []string, dropletIDs []int, kubernetesIDs []string, loadBalancerUIDs []string, tags []string) *firewallRuleTargetsJSON {
+	ids := make([]int, len(dropletIDs))
+	copy(ids, dropletIDs)
+	sort.Ints(ids)
+
+	return &firewallRuleTargetsJSON{
+		Addresses:        canonicalFirewallStrings(addresses),
+		DropletIDs:       ids,
+		KubernetesIDs:    canonicalFirewallStrings(kubernetesIDs),
+		LoadBalancerUIDs: canonicalFirewallStrings(loadBalancerUIDs),
+		Tags:             canonicalFirewallStrings(tags),
+	}
+}
+
+// sortFirewallRulesJSON orders rules by their encoded form so that the
+// resulting list does not depend on the order returned by the API.
+func sortFirewallRulesJSON(rules []firewallRuleJSON) error {
+	keys := make([]string, len(rules))
+	for i, rule := range rules {
+		b, err := json.Marshal(rule)
+		if err != nil {
+			return err
+		}
+		keys[i] = string(b)
+	}
+
+	sort.Sort(firewallRulesByKey{rules: rules, keys: keys})
+
+	return nil
+}
+
+type firewallRulesByKey struct {
+	rules []firewallRuleJSON
+	keys  []string
+}
+
+func (r firewallRulesByKey) Len() int           { return len(r.rules) }
+func (r firewallRulesByKey) Less(i, j int) bool { return r.keys[i] < r.keys[j] }
+func (r firewallRulesByKey) Swap(i, j int) {
+	r.rules[i], r.rules[j] = r.rules[j], r.rules[i]
+	r.keys[i], r.keys[j] = r.keys[j], r.keys[i]
+}
+
+// flattenFirewallRulesJSON renders the firewall's rules as canonical JSON. It
+// applies the same port range normalization used when flattening the rules
+// into state so that both representations always agree.
+func flattenFirewallRulesJSON(inbound []godo.InboundRule, outbound []godo.OutboundRule) (string, error) {
+	doc := firewallRulesJSON{
+		InboundRules:  make([]firewallRuleJSON, 0, len(inbound)),
+		OutboundRules: make([]firewallRuleJSON, 0, len(outbound)),
+	}
+
+	for _, rule := range inbound {
+		src := rule.Sources
+		if src == nil {
+			src = &godo.Sources{}
+		}
+
+		doc.InboundRules = append(doc.InboundRules, firewallRuleJSON{
+			Protocol:  rule.Protocol,
+			PortRange: normalizeFirewallPortRange(rule.Protocol, rule.PortRange),
+			Sources:   canonicalFirewallRuleTargets(src.Addresses, src.DropletIDs, src.KubernetesIDs, src.LoadBalancerUIDs, src.Tags),
+		})
+	}
+
+	for _, rule := range outbound {
+		dest := rule.Destinations
+		if dest == nil {
+			dest = &godo.Destinations{}
+		}
+
+		doc.OutboundRules = append(doc.OutboundRules, firewallRuleJSON{
+			Protocol:     rule.Protocol,
+			PortRange:    normalizeFirewallPortRange(rule.Protocol, rule.PortRange),
+			Destinations: canonicalFirewallRuleTargets(dest.Addresses, dest.DropletIDs, dest.KubernetesIDs, dest.LoadBalancerUIDs, dest.Tags),
+		})
+	}
+
+	if err := sortFirewallRulesJSON(doc.InboundRules); err != nil {
+		return "", err
+	}
+	if err := sortFirewallRulesJSON(doc.OutboundRules); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
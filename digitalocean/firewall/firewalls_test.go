@@ -0,0 +1,126 @@
+package firewall
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestFlattenFirewallRulesJSON(t *testing.T) {
+	inbound := []godo.InboundRule{
+		{
+			Protocol:  "tcp",
+			PortRange: "443",
+			Sources: &godo.Sources{
+				Addresses: []string{"::/0", "0.0.0.0/0"},
+			},
+		},
+		{
+			Protocol:  "tcp",
+			PortRange: "22",
+			Sources: &godo.Sources{
+				Addresses:  []string{"192.168.1.0/24", "10.0.0.0/8"},
+				DropletIDs: []int{3, 1, 2},
+				Tags:       []string{"web", "admin"},
+			},
+		},
+		{
+			Protocol:  "icmp",
+			PortRange: "0",
+			Sources: &godo.Sources{
+				LoadBalancerUIDs: []string{"lb-b", "lb-a"},
+			},
+		},
+	}
+	outbound := []godo.OutboundRule{
+		{
+			Protocol:  "udp",
+			PortRange: "0",
+			Destinations: &godo.Destinations{
+				Addresses:     []string{"0.0.0.0/0"},
+				KubernetesIDs: []string{"k8s-2", "k8s-1"},
+			},
+		},
+		{
+			Protocol:  "tcp",
+			PortRange: "1-65535",
+			Destinations: &godo.Destinations{
+				Addresses: []string{"::/0", "0.0.0.0/0"},
+			},
+		},
+	}
+
+	got, err := flattenFirewallRulesJSON(inbound, outbound)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(got), "", "  "); err != nil {
+		t.Fatalf("invalid JSON: %s", err)
+	}
+	indented.WriteString("\n")
+
+	golden := filepath.Join("testdata", "rules_json.golden")
+	if *updateGolden {
+		if err := os.WriteFile(golden, indented.Bytes(), 0644); err != nil {
+			t.Fatalf("unable to update golden file: %s", err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("unable to read golden file: %s", err)
+	}
+
+	if !bytes.Equal(indented.Bytes(), expected) {
+		t.Errorf("rules_json does not match %s:\n%s", golden, indented.String())
+	}
+
+	// Reordering the rules and their contents must not change the output.
+	reversedInbound := []godo.InboundRule{inbound[2], inbound[1], inbound[0]}
+	reversedOutbound := []godo.OutboundRule{outbound[1], outbound[0]}
+	again, err := flattenFirewallRulesJSON(reversedInbound, reversedOutbound)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if again != got {
+		t.Errorf("expected output to be independent of rule order:\n%s\n%s", got, again)
+	}
+}
+
+func TestFlattenFirewallRulesJSON_empty(t *testing.T) {
+	got, err := flattenFirewallRulesJSON(nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"inbound_rules":[],"outbound_rules":[]}`
+	if got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
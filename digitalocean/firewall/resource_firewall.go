@@ -69,8 +69,13 @@ func resourceDigitalOceanFirewallCreate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[DEBUG] Firewall create configuration: %#v", opts)
 
-	firewall, _, err := client.Firewalls.Create(context.Background(), opts)
+	firewall, resp, err := client.Firewalls.Create(context.Background(), opts)
 	if err != nil {
+		if resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			if tagsErr := firewallMissingTagsError(ctx, client, opts); tagsErr != nil {
+				return diag.Errorf("Error creating firewall: %s", tagsErr)
+			}
+		}
 		return diag.Errorf("Error creating firewall: %s", err)
 	}
 
@@ -102,6 +107,7 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("created_at", firewall.Created)
 	d.Set("pending_changes", firewallPendingChanges(d, firewall))
 	d.Set("name", firewall.Name)
+	d.Set("urn", firewall.URN())
 
 	if err := d.Set("droplet_ids", flattenFirewallDropletIds(firewall.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting `droplet_ids`: %+v", err)
@@ -119,6 +125,12 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("[DEBUG] Error setting `tags`: %+v", err)
 	}
 
+	rulesJSON, err := flattenFirewallRulesJSON(firewall.InboundRules, firewall.OutboundRules)
+	if err != nil {
+		return diag.Errorf("Error encoding firewall rules: %s", err)
+	}
+	d.Set("rules_json", rulesJSON)
+
 	return nil
 }
 
@@ -132,8 +144,15 @@ func resourceDigitalOceanFirewallUpdate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[DEBUG] Firewall update configuration: %#v", opts)
 
-	_, _, err = client.Firewalls.Update(context.Background(), d.Id(), opts)
+	// The whole set of rules is sent in a single request, so that the
+	// firewall never has only some of the rules applied.
+	_, resp, err := client.Firewalls.Update(context.Background(), d.Id(), opts)
 	if err != nil {
+		if resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			if tagsErr := firewallMissingTagsError(ctx, client, opts); tagsErr != nil {
+				return diag.Errorf("Error updating firewall: %s", tagsErr)
+			}
+		}
 		return diag.Errorf("Error updating firewall: %s", err)
 	}
 
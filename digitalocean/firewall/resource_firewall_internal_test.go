@@ -0,0 +1,77 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFirewallMissingTagsError(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/tags/web", "/v2/tags/frontend":
+			fmt.Fprintf(w, `{"tag":{"name":%q}}`, strings.TrimPrefix(r.URL.Path, "/v2/tags/"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	})).GodoClient()
+
+	opts := &godo.FirewallRequest{
+		InboundRules: []godo.InboundRule{
+			{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Tags: []string{"web", "bastion"}}},
+			{Protocol: "tcp", PortRange: "80", Sources: &godo.Sources{Tags: []string{"bastion"}}},
+		},
+		OutboundRules: []godo.OutboundRule{
+			{Protocol: "tcp", PortRange: "5432", Destinations: &godo.Destinations{Tags: []string{"db"}}},
+		},
+		Tags: []string{"frontend"},
+	}
+
+	err := firewallMissingTagsError(context.Background(), client, opts)
+	expected := `tag "bastion" referenced by the source_tags of an inbound_rule does not exist; ` +
+		`tag "db" referenced by the destination_tags of an outbound_rule does not exist`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got: %v", expected, err)
+	}
+
+	opts.InboundRules = opts.InboundRules[:0]
+	opts.OutboundRules = nil
+	if err := firewallMissingTagsError(context.Background(), client, opts); err != nil {
+		t.Errorf("expected no error when the tags exist, got: %s", err)
+	}
+}
+
+func TestFirewallRulesReorderedNoDiff(t *testing.T) {
+	r := ResourceDigitalOceanFirewall()
+
+	d := r.Data(&terraform.InstanceState{ID: "fw-id"})
+	d.Set("name", "web")
+	d.Set("inbound_rule", flattenFirewallInboundRules([]godo.InboundRule{
+		{Protocol: "tcp", PortRange: "443", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0"}}},
+		{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Tags: []string{"bastion"}}},
+	}))
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "web",
+		"inbound_rule": []interface{}{
+			map[string]interface{}{"protocol": "tcp", "port_range": "22", "source_tags": []interface{}{"bastion"}},
+			map[string]interface{}{"protocol": "tcp", "port_range": "443", "source_addresses": []interface{}{"0.0.0.0/0"}},
+		},
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, attr := range diff.Attributes {
+		if strings.HasPrefix(k, "inbound_rule") {
+			t.Errorf("expected no diff for reordered rules, got %s: %#v", k, attr)
+		}
+	}
+}
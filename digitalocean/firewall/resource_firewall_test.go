@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -26,6 +27,8 @@ func TestAccDigitalOceanFirewall_AllowOnlyInbound(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
 					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_firewall.foobar", "rules_json"),
+					resource.TestMatchResourceAttr("digitalocean_firewall.foobar", "urn", regexp.MustCompile(`^do:firewall:[0-9a-f-]+$`)),
 				),
 			},
 		},
@@ -0,0 +1,91 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+)
+
+// The API can not create a custom image from a snapshot. A Droplet snapshot is
+// however already a private image which can be used to create Droplets and be
+// transferred to other regions, so it is used as the custom image as is.
+// Backups are first converted to snapshots. Volume snapshots can not be used
+// to create Droplets at all.
+
+// prepareSnapshotImage returns the image of the Droplet snapshot or backup
+// with the given ID, converting a backup to a snapshot. An error is returned
+// if the ID is not that of a Droplet snapshot or backup, or if the image is
+// already available in regions which are not in the given regions, as images
+// can not be removed from a region. The conversion of a backup is waited on
+// for up to the given timeout.
+func prepareSnapshotImage(ctx context.Context, client *godo.Client, snapshotID string, regions []string, timeout time.Duration) (*godo.Image, error) {
+	id, err := strconv.Atoi(snapshotID)
+	if err != nil {
+		snapshot, _, snapshotErr := client.Snapshots.Get(ctx, snapshotID)
+		if snapshotErr == nil && snapshot.ResourceType == "volume" {
+			return nil, fmt.Errorf("snapshot %s is a volume snapshot, only Droplet snapshots can be used as custom images", snapshotID)
+		}
+		return nil, fmt.Errorf("%q is not the ID of a Droplet snapshot", snapshotID)
+	}
+
+	image, _, err := client.Images.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving snapshot %s: %s", snapshotID, err)
+	}
+
+	switch image.Type {
+	case "snapshot":
+	case "backup":
+		log.Printf("[INFO] Converting backup (%d) to a snapshot", id)
+		action, _, err := client.ImageActions.Convert(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("Error converting backup %s to a snapshot: %s", snapshotID, err)
+		}
+		if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+			return nil, fmt.Errorf("Error waiting for backup %s to be converted to a snapshot: %s", snapshotID, err)
+		}
+	default:
+		return nil, fmt.Errorf("image %s is of type %q, only Droplet snapshots and backups can be used as custom images", snapshotID, image.Type)
+	}
+
+	configured := map[string]bool{}
+	for _, region := range regions {
+		configured[region] = true
+	}
+
+	var missing []string
+	for _, region := range image.Regions {
+		if !configured[region] {
+			missing = append(missing, region)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("snapshot %s is already available in %s, which must be included in regions", snapshotID, strings.Join(missing, ", "))
+	}
+
+	return image, nil
+}
+
+// missingImageRegions returns the regions the image is not yet available in.
+func missingImageRegions(image *godo.Image, regions []string) []interface{} {
+	available := map[string]bool{}
+	for _, region := range image.Regions {
+		available[region] = true
+	}
+
+	missing := []interface{}{}
+	for _, region := range regions {
+		if !available[region] {
+			missing = append(missing, region)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,164 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestPrepareSnapshotImage(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/images/1":
+			fmt.Fprint(w, `{"image":{"id":1,"type":"snapshot","regions":["nyc3"]}}`)
+		case "/v2/images/2":
+			fmt.Fprint(w, `{"image":{"id":2,"type":"custom","regions":["nyc3"]}}`)
+		case "/v2/images/3":
+			fmt.Fprint(w, `{"image":{"id":3,"type":"snapshot","regions":["nyc3","ams3","sfo3"]}}`)
+		case "/v2/snapshots/fbe36bfa-4c6a-4c8a-b1a1-4ad5b0b2dc2c":
+			fmt.Fprint(w, `{"snapshot":{"id":"fbe36bfa-4c6a-4c8a-b1a1-4ad5b0b2dc2c","resource_type":"volume"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	})).GodoClient()
+
+	cases := []struct {
+		name             string
+		snapshotID       string
+		expectedErrorMsg string
+	}{
+		{"Snapshot", "1", ""},
+		{"CustomImage", "2", `is of type "custom"`},
+		{"OtherRegions", "3", "already available in ams3, sfo3"},
+		{"VolumeSnapshot", "fbe36bfa-4c6a-4c8a-b1a1-4ad5b0b2dc2c", "is a volume snapshot"},
+		{"Unknown", "foo", "is not the ID of a Droplet snapshot"},
+		{"NotFound", "4", "Error retrieving snapshot 4"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			image, err := prepareSnapshotImage(context.Background(), client, tc.snapshotID, []string{"nyc3", "lon1"}, time.Minute)
+			if tc.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErrorMsg) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if image.ID != 1 {
+				t.Errorf("expected image 1, got %d", image.ID)
+			}
+		})
+	}
+}
+
+func TestMissingImageRegions(t *testing.T) {
+	image := &godo.Image{Regions: []string{"nyc3", "ams3"}}
+
+	missing := missingImageRegions(image, []string{"ams3", "lon1", "nyc3", "sfo3"})
+	expected := []interface{}{"lon1", "sfo3"}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("expected %v, got %v", expected, missing)
+	}
+}
+
+func TestCustomImageTimeoutsDefaults(t *testing.T) {
+	d := ResourceDigitalOceanCustomImage().Data(nil)
+
+	expected := map[string]time.Duration{
+		schema.TimeoutCreate: 60 * time.Minute,
+		schema.TimeoutUpdate: 60 * time.Minute,
+		schema.TimeoutDelete: 20 * time.Minute,
+	}
+	for key, timeout := range expected {
+		if got := d.Timeout(key); got != timeout {
+			t.Errorf("%s: expected %s, got %s", key, timeout, got)
+		}
+	}
+}
+
+func TestCustomImageTimeoutsHonored(t *testing.T) {
+	// The image never becomes available and transfers never complete.
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/images/1":
+			fmt.Fprint(w, `{"image":{"id":1,"type":"custom","status":"pending","regions":["nyc3"]}}`)
+		case "/v2/images/1/actions":
+			fmt.Fprint(w, `{"action":{"id":2,"status":"in-progress","type":"transfer"}}`)
+		case "/v2/actions/2":
+			fmt.Fprint(w, `{"action":{"id":2,"status":"in-progress","type":"transfer"}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	client := meta.GodoClient()
+
+	r := ResourceDigitalOceanCustomImage()
+	r.Timeouts = &schema.ResourceTimeout{
+		Create: godo.PtrTo(100 * time.Millisecond),
+		Update: godo.PtrTo(100 * time.Millisecond),
+	}
+	d := r.Data(&terraform.InstanceState{ID: "1"})
+
+	cases := []struct {
+		name string
+		wait func() error
+	}{
+		{
+			name: "create",
+			wait: func() error {
+				_, err := waitForImage(context.Background(), d, ImageAvailableStatus, imagePendingStatuses(), "status", meta)
+				return err
+			},
+		},
+		{
+			name: "update",
+			wait: func() error {
+				return distributeImageToRegions(context.Background(), client, 1, []interface{}{"lon1"}, d.Timeout(schema.TimeoutUpdate))
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			start := time.Now()
+			err := c.wait()
+			if err == nil || !strings.Contains(err.Error(), "timeout while waiting for state to become") {
+				t.Fatalf("expected a timeout error, got: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the configured timeout to be honored, waited %s", elapsed)
+			}
+		})
+	}
+}
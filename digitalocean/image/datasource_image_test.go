@@ -15,6 +15,7 @@ func TestAccDigitalOceanImage_Basic(t *testing.T) {
 	var snapshotsId []int
 	snapName := acceptance.RandomTestName()
 	dropletName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "s-1vcpu-1gb")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -24,7 +25,7 @@ func TestAccDigitalOceanImage_Basic(t *testing.T) {
 			// Creates a Droplet and takes multiple snapshots of it.
 			// One will have the suffix -1 and two will have -0
 			{
-				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(dropletName),
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(dropletName, region),
 				Check: resource.ComposeTestCheckFunc(
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					acceptance.TakeSnapshotsOfDroplet(snapName, &droplet, &snapshotsId),
@@ -39,9 +39,17 @@ func ResourceDigitalOceanCustomImage() *schema.Resource {
 			},
 			"url": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"url", "source_snapshot_id"},
+			},
+			"source_snapshot_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"url", "source_snapshot_id"},
 			},
 			"regions": {
 				Type:     schema.TypeSet,
@@ -98,6 +106,8 @@ func ResourceDigitalOceanCustomImage() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
 		// Images can not currently be removed from a region.
@@ -111,6 +121,10 @@ func ResourceDigitalOceanCustomImage() *schema.Resource {
 func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if snapshotID, ok := d.GetOk("source_snapshot_id"); ok {
+		return resourceDigitalOceanCustomImageCreateFromSnapshot(ctx, d, meta, snapshotID.(string))
+	}
+
 	// We import the image to the first region. We can distribute it to others once it is available.
 	regions := d.Get("regions").(*schema.Set).List()
 	region := regions[0].(string)
@@ -152,7 +166,47 @@ func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.Resour
 		regions[len(regions)-1] = ""
 		regions = regions[:len(regions)-1]
 		log.Printf("[INFO] Image available in: %s Distributing to: %v", region, regions)
-		err = distributeImageToRegions(client, imageResponse.ID, regions)
+		err = distributeImageToRegions(ctx, client, imageResponse.ID, regions, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanCustomImageRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanCustomImageCreateFromSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}, snapshotID string) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var regions []string
+	for _, region := range d.Get("regions").(*schema.Set).List() {
+		regions = append(regions, region.(string))
+	}
+
+	image, err := prepareSnapshotImage(ctx, client, snapshotID, regions, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error creating custom image from snapshot: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(image.ID))
+
+	imageName := d.Get("name").(string)
+	imageUpdateRequest := &godo.ImageUpdateRequest{
+		Name:         imageName,
+		Distribution: d.Get("distribution").(string),
+		Description:  d.Get("description").(string),
+	}
+	if _, _, err := client.Images.Update(ctx, image.ID, imageUpdateRequest); err != nil {
+		return diag.Errorf("Error updating image %s, name %s: %s", d.Id(), imageName, err)
+	}
+
+	if err := tag.SetTags(client, d, godo.ImageResourceType); err != nil {
+		return diag.Errorf("Error setting tags of image %s: %s", d.Id(), err)
+	}
+
+	if missing := missingImageRegions(image, regions); len(missing) > 0 {
+		log.Printf("[INFO] Image available in: %v Distributing to: %v", image.Regions, missing)
+		err = distributeImageToRegions(ctx, client, image.ID, missing, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -222,10 +276,16 @@ func resourceDigitalOceanCustomImageUpdate(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("tags") {
+		if err := tag.SetTags(client, d, godo.ImageResourceType); err != nil {
+			return diag.Errorf("Error updating tags of image %s: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("regions") {
 		old, new := d.GetChange("regions")
 		_, add := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
-		err = distributeImageToRegions(client, id, add.List())
+		err = distributeImageToRegions(ctx, client, id, add.List(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -293,7 +353,7 @@ func imageStateRefreshFunc(ctx context.Context, d *schema.ResourceData, state st
 	}
 }
 
-func distributeImageToRegions(client *godo.Client, imageId int, regions []interface{}) (err error) {
+func distributeImageToRegions(ctx context.Context, client *godo.Client, imageId int, regions []interface{}, timeout time.Duration) (err error) {
 	for _, region := range regions {
 		transferRequest := &godo.ActionRequest{
 			"type":   "transfer",
@@ -301,12 +361,12 @@ func distributeImageToRegions(client *godo.Client, imageId int, regions []interf
 		}
 
 		log.Printf("[INFO] Transferring image (%d) to: %s", imageId, region)
-		action, _, err := client.ImageActions.Transfer(context.TODO(), imageId, transferRequest)
+		action, _, err := client.ImageActions.Transfer(ctx, imageId, transferRequest)
 		if err != nil {
 			return err
 		}
 
-		err = util.WaitForAction(client, action)
+		err = util.WaitForActionContext(ctx, client, action, timeout)
 		if err != nil {
 			return err
 		}
@@ -95,6 +95,62 @@ func TestAccDigitalOceanCustomImageMultiRegion(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCustomImage_FromSnapshot(t *testing.T) {
+	rString := acceptance.RandomTestName()
+	name := "digitalocean_custom_image.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanCustomImageConfig_FromSnapshot(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rString+"-image"),
+					resource.TestCheckResourceAttr(name, "description", "golden image"),
+					resource.TestCheckResourceAttr(name, "distribution", "Ubuntu"),
+					resource.TestCheckResourceAttr(name, "type", "snapshot"),
+					resource.TestCheckResourceAttr(name, "status", "available"),
+					resource.TestCheckResourceAttr(name, "slug", ""),
+					resource.TestCheckTypeSetElemAttr(name, "regions.*", "nyc3"),
+					resource.TestCheckTypeSetElemAttr(name, "regions.*", "sfo3"),
+					resource.TestCheckResourceAttrPair(name, "id", "digitalocean_droplet_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrSet(name, "image_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanCustomImageConfig_FromSnapshot(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%[1]s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+  name       = "%[1]s-snapshot"
+
+  lifecycle {
+    ignore_changes = [name, regions]
+  }
+}
+
+resource "digitalocean_custom_image" "foobar" {
+  name               = "%[1]s-image"
+  source_snapshot_id = digitalocean_droplet_snapshot.foobar.id
+  regions            = ["nyc3", "sfo3"]
+  description        = "golden image"
+  distribution       = "Ubuntu"
+}
+`, name)
+}
+
 func testAccCheckDigitalOceanCustomImageConfig(rName string, name string, regions string, distro string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_custom_image" "%s" {
@@ -0,0 +1,28 @@
+package inventory
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanResources() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        inventorySchema(),
+		ResultAttributeName: "resources",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inventoryTypes(), false),
+				},
+			},
+		},
+		GetRecords:    getDigitalOceanInventory,
+		FlattenRecord: flattenDigitalOceanInventoryResource,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,63 @@
+package inventory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanResources_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName("tag")
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_volume" "foo" {
+  region = "nyc3"
+  name   = "%s"
+  size   = 10
+  tags   = [digitalocean_tag.foo.id]
+}
+`, tagName, name)
+
+	datasourceConfig := `
+data "digitalocean_resources" "result" {
+  types = ["volume", "droplet"]
+
+  filter {
+    key    = "tags"
+    values = [digitalocean_tag.foo.id]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_resources.result", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_resources.result", "resources.0.name", name),
+					resource.TestCheckResourceAttr("data.digitalocean_resources.result", "resources.0.type", "volume"),
+					resource.TestCheckResourceAttr("data.digitalocean_resources.result", "resources.0.region", "nyc3"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_resources.result", "resources.0.urn", "digitalocean_volume.foo", "urn"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_resources.result", "resources.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_resources.result", "resources.0.project_id"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
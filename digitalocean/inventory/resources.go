@@ -0,0 +1,449 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxInventoryConcurrency bounds the number of resource types listed at once
+// to avoid exhausting the API rate limit.
+const maxInventoryConcurrency = 4
+
+// inventoryResource is the uniform representation of a resource returned by
+// an inventory adapter.
+type inventoryResource struct {
+	URN       string
+	Name      string
+	Type      string
+	Region    string
+	CreatedAt string
+	ProjectID string
+	Tags      []string
+}
+
+// inventoryAdapter lists all resources of a single type. projectURNs maps
+// every URN assigned to a project to that project's ID.
+type inventoryAdapter func(client *godo.Client, projectURNs map[string]string) ([]inventoryResource, error)
+
+// inventoryAdapters holds the adapter for each supported resource type.
+var inventoryAdapters = map[string]inventoryAdapter{
+	"app":          listInventoryApps,
+	"database":     listInventoryDatabases,
+	"domain":       listInventoryDomains,
+	"droplet":      listInventoryDroplets,
+	"kubernetes":   listInventoryKubernetesClusters,
+	"loadbalancer": listInventoryLoadBalancers,
+	"space":        listInventorySpaces,
+	"volume":       listInventoryVolumes,
+}
+
+// inventoryTypes returns the sorted names of the supported resource types.
+func inventoryTypes() []string {
+	types := make([]string, 0, len(inventoryAdapters))
+	for t := range inventoryAdapters {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+func inventorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the resource",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the resource",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of the resource",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region the resource is located in, if any",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date for the resource, if known",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the project the resource is assigned to, if known",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getDigitalOceanInventory(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	types := inventoryTypes()
+	if v, ok := extra["types"]; ok && v.(*schema.Set).Len() > 0 {
+		types = []string{}
+		for _, t := range v.(*schema.Set).List() {
+			types = append(types, t.(string))
+		}
+		sort.Strings(types)
+	}
+
+	projectURNs, err := loadProjectURNs(client)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := fanOutInventory(client, projectURNs, types, inventoryAdapters)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]interface{}, 0, len(resources))
+	for _, r := range resources {
+		records = append(records, r)
+	}
+
+	return records, nil
+}
+
+// fanOutInventory runs the adapters for the requested types with bounded
+// concurrency. Results are returned grouped by type in the order the types
+// were given so that the output is stable.
+func fanOutInventory(client *godo.Client, projectURNs map[string]string, types []string, adapters map[string]inventoryAdapter) ([]inventoryResource, error) {
+	results := make([][]inventoryResource, len(types))
+	errs := make([]error, len(types))
+
+	sem := make(chan struct{}, maxInventoryConcurrency)
+	var wg sync.WaitGroup
+
+	for i, t := range types {
+		adapter, ok := adapters[t]
+		if !ok {
+			return nil, fmt.Errorf("Unsupported resource type: %s", t)
+		}
+
+		wg.Add(1)
+		go func(i int, t string, adapter inventoryAdapter) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			resources, err := adapter(client, projectURNs)
+			if err != nil {
+				errs[i] = fmt.Errorf("Error listing %s resources: %s", t, err)
+				return
+			}
+
+			for j := range resources {
+				resources[j].Type = t
+				if resources[j].ProjectID == "" {
+					resources[j].ProjectID = projectURNs[resources[j].URN]
+				}
+			}
+			results[i] = resources
+		}(i, t, adapter)
+	}
+
+	wg.Wait()
+
+	var all []inventoryResource
+	for i := range types {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		all = append(all, results[i]...)
+	}
+
+	return all, nil
+}
+
+func flattenDigitalOceanInventoryResource(rawResource, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	r := rawResource.(inventoryResource)
+
+	flattenedResource := map[string]interface{}{
+		"urn":        r.URN,
+		"name":       r.Name,
+		"type":       r.Type,
+		"region":     r.Region,
+		"created_at": r.CreatedAt,
+		"project_id": r.ProjectID,
+		"tags":       tag.FlattenTags(r.Tags),
+	}
+
+	return flattenedResource, nil
+}
+
+// listAllPages calls list until the last page of results has been retrieved.
+func listAllPages(list func(opts *godo.ListOptions) (*godo.Response, error)) error {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		resp, err := list(opts)
+		if err != nil {
+			return err
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return err
+		}
+
+		opts.Page = page + 1
+	}
+}
+
+func formatInventoryTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func loadProjectURNs(client *godo.Client) (map[string]string, error) {
+	var projects []godo.Project
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		page, resp, err := client.Projects.List(context.Background(), opts)
+		projects = append(projects, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving projects: %s", err)
+	}
+
+	projectURNs := map[string]string{}
+	for _, p := range projects {
+		err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+			page, resp, err := client.Projects.ListResources(context.Background(), p.ID, opts)
+			for _, r := range page {
+				projectURNs[r.URN] = p.ID
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error loading resources for project %s: %s", p.ID, err)
+		}
+	}
+
+	return projectURNs, nil
+}
+
+func inventoryFromDroplet(d godo.Droplet) inventoryResource {
+	r := inventoryResource{
+		URN:       d.URN(),
+		Name:      d.Name,
+		CreatedAt: d.Created,
+		Tags:      d.Tags,
+	}
+	if d.Region != nil {
+		r.Region = d.Region.Slug
+	}
+
+	return r
+}
+
+func listInventoryDroplets(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		droplets, resp, err := client.Droplets.List(context.Background(), opts)
+		for _, d := range droplets {
+			resources = append(resources, inventoryFromDroplet(d))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromVolume(v godo.Volume) inventoryResource {
+	r := inventoryResource{
+		URN:       v.URN(),
+		Name:      v.Name,
+		CreatedAt: formatInventoryTime(v.CreatedAt),
+		Tags:      v.Tags,
+	}
+	if v.Region != nil {
+		r.Region = v.Region.Slug
+	}
+
+	return r
+}
+
+func listInventoryVolumes(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		volumes, resp, err := client.Storage.ListVolumes(context.Background(), &godo.ListVolumeParams{ListOptions: opts})
+		for _, v := range volumes {
+			resources = append(resources, inventoryFromVolume(v))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromLoadBalancer(lb godo.LoadBalancer) inventoryResource {
+	r := inventoryResource{
+		URN:       lb.URN(),
+		Name:      lb.Name,
+		CreatedAt: lb.Created,
+		Tags:      lb.Tags,
+	}
+	if lb.Region != nil {
+		r.Region = lb.Region.Slug
+	}
+
+	return r
+}
+
+func listInventoryLoadBalancers(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		lbs, resp, err := client.LoadBalancers.List(context.Background(), opts)
+		for _, lb := range lbs {
+			resources = append(resources, inventoryFromLoadBalancer(lb))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromDatabase(db godo.Database) inventoryResource {
+	return inventoryResource{
+		URN:       db.URN(),
+		Name:      db.Name,
+		Region:    db.RegionSlug,
+		CreatedAt: formatInventoryTime(db.CreatedAt),
+		ProjectID: db.ProjectID,
+		Tags:      db.Tags,
+	}
+}
+
+func listInventoryDatabases(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		dbs, resp, err := client.Databases.List(context.Background(), opts)
+		for _, db := range dbs {
+			resources = append(resources, inventoryFromDatabase(db))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromDomain(d godo.Domain) inventoryResource {
+	return inventoryResource{
+		URN:  d.URN(),
+		Name: d.Name,
+	}
+}
+
+func listInventoryDomains(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		domains, resp, err := client.Domains.List(context.Background(), opts)
+		for _, d := range domains {
+			resources = append(resources, inventoryFromDomain(d))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromKubernetesCluster(c *godo.KubernetesCluster) inventoryResource {
+	return inventoryResource{
+		URN:       c.URN(),
+		Name:      c.Name,
+		Region:    c.RegionSlug,
+		CreatedAt: formatInventoryTime(c.CreatedAt),
+		Tags:      c.Tags,
+	}
+}
+
+func listInventoryKubernetesClusters(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		clusters, resp, err := client.Kubernetes.List(context.Background(), opts)
+		for _, c := range clusters {
+			resources = append(resources, inventoryFromKubernetesCluster(c))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+func inventoryFromApp(a *godo.App) inventoryResource {
+	r := inventoryResource{
+		URN:       a.URN(),
+		CreatedAt: formatInventoryTime(a.CreatedAt),
+		ProjectID: a.ProjectID,
+	}
+	if a.Spec != nil {
+		r.Name = a.Spec.Name
+	}
+	if a.Region != nil {
+		r.Region = a.Region.Slug
+	}
+
+	return r
+}
+
+func listInventoryApps(client *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	err := listAllPages(func(opts *godo.ListOptions) (*godo.Response, error) {
+		apps, resp, err := client.Apps.List(context.Background(), opts)
+		for _, a := range apps {
+			resources = append(resources, inventoryFromApp(a))
+		}
+		return resp, err
+	})
+
+	return resources, err
+}
+
+// listInventorySpaces derives Spaces buckets from project assignments. The
+// DigitalOcean API does not offer a bucket listing endpoint, but every bucket
+// is assigned to a project.
+func listInventorySpaces(_ *godo.Client, projectURNs map[string]string) ([]inventoryResource, error) {
+	var resources []inventoryResource
+	for resourceURN, projectID := range projectURNs {
+		resourceType, name, err := urn.Parse(resourceURN)
+		if err != nil || resourceType != urn.Space {
+			continue
+		}
+
+		resources = append(resources, inventoryResource{
+			URN:       resourceURN,
+			Name:      name,
+			ProjectID: projectID,
+		})
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].URN < resources[j].URN
+	})
+
+	return resources, nil
+}
@@ -0,0 +1,206 @@
+package inventory
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestInventoryAdapterConversions(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	region := &godo.Region{Slug: "nyc3"}
+
+	cases := []struct {
+		name     string
+		got      inventoryResource
+		expected inventoryResource
+	}{
+		{
+			name: "droplet",
+			got: inventoryFromDroplet(godo.Droplet{
+				ID: 123, Name: "web-1", Region: region, Created: "2024-03-01T12:30:00Z", Tags: []string{"web"},
+			}),
+			expected: inventoryResource{
+				URN: "do:droplet:123", Name: "web-1", Region: "nyc3", CreatedAt: "2024-03-01T12:30:00Z", Tags: []string{"web"},
+			},
+		},
+		{
+			name: "droplet without region",
+			got:  inventoryFromDroplet(godo.Droplet{ID: 124, Name: "web-2"}),
+			expected: inventoryResource{
+				URN: "do:droplet:124", Name: "web-2",
+			},
+		},
+		{
+			name: "volume",
+			got: inventoryFromVolume(godo.Volume{
+				ID: "vol-1", Name: "data", Region: region, CreatedAt: created, Tags: []string{"db"},
+			}),
+			expected: inventoryResource{
+				URN: "do:volume:vol-1", Name: "data", Region: "nyc3", CreatedAt: "2024-03-01T12:30:00Z", Tags: []string{"db"},
+			},
+		},
+		{
+			name: "load balancer",
+			got: inventoryFromLoadBalancer(godo.LoadBalancer{
+				ID: "lb-1", Name: "front", Region: region, Created: "2024-03-01T12:30:00Z",
+			}),
+			expected: inventoryResource{
+				URN: "do:loadbalancer:lb-1", Name: "front", Region: "nyc3", CreatedAt: "2024-03-01T12:30:00Z",
+			},
+		},
+		{
+			name: "database",
+			got: inventoryFromDatabase(godo.Database{
+				ID: "db-1", Name: "pg", RegionSlug: "nyc1", CreatedAt: created, ProjectID: "proj-1", Tags: []string{"prod"},
+			}),
+			expected: inventoryResource{
+				URN: "do:dbaas:db-1", Name: "pg", Region: "nyc1", CreatedAt: "2024-03-01T12:30:00Z", ProjectID: "proj-1", Tags: []string{"prod"},
+			},
+		},
+		{
+			name: "domain",
+			got:  inventoryFromDomain(godo.Domain{Name: "example.com"}),
+			expected: inventoryResource{
+				URN: "do:domain:example.com", Name: "example.com",
+			},
+		},
+		{
+			name: "kubernetes",
+			got: inventoryFromKubernetesCluster(&godo.KubernetesCluster{
+				ID: "k8s-1", Name: "cluster", RegionSlug: "sfo3", CreatedAt: created,
+			}),
+			expected: inventoryResource{
+				URN: "do:kubernetes:k8s-1", Name: "cluster", Region: "sfo3", CreatedAt: "2024-03-01T12:30:00Z",
+			},
+		},
+		{
+			name: "app",
+			got: inventoryFromApp(&godo.App{
+				ID: "app-1", Spec: &godo.AppSpec{Name: "site"}, Region: &godo.AppRegion{Slug: "ams"}, CreatedAt: created, ProjectID: "proj-2",
+			}),
+			expected: inventoryResource{
+				URN: "do:app:app-1", Name: "site", Region: "ams", CreatedAt: "2024-03-01T12:30:00Z", ProjectID: "proj-2",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestListInventorySpaces(t *testing.T) {
+	projectURNs := map[string]string{
+		"do:droplet:1":     "proj-1",
+		"do:space:beta":    "proj-2",
+		"do:space:alpha":   "proj-1",
+		"do:domain:foo.io": "proj-1",
+	}
+
+	got, err := listInventorySpaces(nil, projectURNs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []inventoryResource{
+		{URN: "do:space:alpha", Name: "alpha", ProjectID: "proj-1"},
+		{URN: "do:space:beta", Name: "beta", ProjectID: "proj-2"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFanOutInventory(t *testing.T) {
+	projectURNs := map[string]string{
+		"do:droplet:1": "proj-1",
+		"do:dbaas:a":   "proj-2",
+	}
+
+	adapters := map[string]inventoryAdapter{
+		"droplet": func(_ *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+			return []inventoryResource{{URN: "do:droplet:1"}, {URN: "do:droplet:2"}}, nil
+		},
+		"database": func(_ *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+			return []inventoryResource{{URN: "do:dbaas:a", ProjectID: "proj-3"}}, nil
+		},
+		"volume": func(_ *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+			t.Error("volume adapter should not be called")
+			return nil, nil
+		},
+	}
+
+	got, err := fanOutInventory(nil, projectURNs, []string{"database", "droplet"}, adapters)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []inventoryResource{
+		{URN: "do:dbaas:a", Type: "database", ProjectID: "proj-3"},
+		{URN: "do:droplet:1", Type: "droplet", ProjectID: "proj-1"},
+		{URN: "do:droplet:2", Type: "droplet"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFanOutInventory_errors(t *testing.T) {
+	adapters := map[string]inventoryAdapter{
+		"droplet": func(_ *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+			return nil, fmt.Errorf("boom")
+		},
+	}
+
+	if _, err := fanOutInventory(nil, nil, []string{"droplet"}, adapters); err == nil {
+		t.Error("expected adapter error to be returned")
+	}
+
+	if _, err := fanOutInventory(nil, nil, []string{"unknown"}, adapters); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+}
+
+func TestFanOutInventory_concurrencyIsBounded(t *testing.T) {
+	var types []string
+	adapters := map[string]inventoryAdapter{}
+	var mu sync.Mutex
+	running, maxSeen := 0, 0
+
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("type-%d", i)
+		types = append(types, name)
+		adapters[name] = func(_ *godo.Client, _ map[string]string) ([]inventoryResource, error) {
+			mu.Lock()
+			running++
+			if running > maxSeen {
+				maxSeen = running
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil, nil
+		}
+	}
+
+	if _, err := fanOutInventory(nil, nil, types, adapters); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if maxSeen > maxInventoryConcurrency {
+		t.Errorf("expected at most %d concurrent adapters, saw %d", maxInventoryConcurrency, maxSeen)
+	}
+}
@@ -0,0 +1,194 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+const kubernetesClustersPath = "/v2/kubernetes/clusters"
+
+// kubernetesClusterRoot wraps the cluster in API requests and responses.
+type kubernetesClusterRoot struct {
+	Cluster *godo.KubernetesCluster `json:"kubernetes_cluster,omitempty"`
+}
+
+// kubernetesClusterRawRoot retains every attribute of the cluster returned by
+// the API, including those godo does not model.
+type kubernetesClusterRawRoot struct {
+	Cluster map[string]json.RawMessage `json:"kubernetes_cluster"`
+}
+
+// modeledKubernetesClusterOptions returns the JSON keys of the cluster create
+// request that are already modeled by godo, or otherwise by the schema.
+func modeledKubernetesClusterOptions() map[string]bool {
+	keys := map[string]bool{
+		clusterAutoscalerConfigurationKey: true,
+		routingAgentKey:                   true,
+	}
+	t := reflect.TypeOf(godo.KubernetesClusterCreateRequest{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}
+
+func validateAdditionalOptions(v interface{}, k string) (ws []string, errors []error) {
+	modeled := modeledKubernetesClusterOptions()
+
+	var keys []string
+	for key := range v.(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if modeled[key] {
+			errors = append(errors, fmt.Errorf("%q can not be set in %q as it is already supported by the resource, use the corresponding argument instead", key, k))
+		}
+	}
+	return
+}
+
+// expandAdditionalOption converts an option to its JSON representation.
+// Booleans and JSON encoded objects and arrays are sent as such, and anything
+// else as a string.
+func expandAdditionalOption(value string) interface{} {
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+
+	if trimmed := strings.TrimSpace(value); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded
+		}
+	}
+
+	return value
+}
+
+// flattenAdditionalOption converts an option returned by the API to the string
+// stored in state. Values that are neither booleans nor strings are stored as
+// their JSON encoding, which expandAdditionalOption decodes again.
+func flattenAdditionalOption(raw json.RawMessage) string {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return strconv.FormatBool(b)
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	return string(raw)
+}
+
+// createKubernetesClusterWithOptions creates a cluster like
+// client.Kubernetes.Create, additionally sending the options, the autoscaler
+// configuration and the routing agent, if any, in the request. Options can not
+// override the attributes of the create request.
+func createKubernetesClusterWithOptions(ctx context.Context, client *godo.Client, opts *godo.KubernetesClusterCreateRequest, options map[string]interface{}, autoscaler *kubernetesClusterAutoscalerConfiguration, routingAgent *kubernetesRoutingAgent) (*godo.KubernetesCluster, *godo.Response, error) {
+	encoded, err := json.Marshal(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		return nil, nil, err
+	}
+
+	for key, value := range options {
+		if _, ok := body[key]; ok {
+			return nil, nil, fmt.Errorf("additional option %q conflicts with an attribute of the create request", key)
+		}
+		body[key] = expandAdditionalOption(value.(string))
+	}
+
+	if autoscaler != nil {
+		body[clusterAutoscalerConfigurationKey] = autoscaler
+	}
+
+	if routingAgent != nil {
+		body[routingAgentKey] = routingAgent
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, kubernetesClustersPath, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(kubernetesClusterRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Cluster, resp, nil
+}
+
+// getKubernetesClusterRaw returns the cluster like client.Kubernetes.Get,
+// along with every attribute of the cluster returned by the API, which are
+// decoded from the same response.
+func getKubernetesClusterRaw(ctx context.Context, client *godo.Client, id string) (*godo.KubernetesCluster, map[string]json.RawMessage, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", kubernetesClustersPath, id), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var body json.RawMessage
+	resp, err := client.Do(ctx, req, &body)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	root := new(kubernetesClusterRoot)
+	if err := json.Unmarshal(body, root); err != nil {
+		return nil, nil, resp, err
+	}
+
+	rawRoot := new(kubernetesClusterRawRoot)
+	if err := json.Unmarshal(body, rawRoot); err != nil {
+		return nil, nil, resp, err
+	}
+
+	return root.Cluster, rawRoot.Cluster, resp, nil
+}
+
+// flattenAdditionalOptions returns the current values of the configured
+// options. Only the configured keys are read so that options which were not
+// set never produce a diff. Options the API does not return, or which only
+// differ in their JSON formatting, keep their configured value.
+func flattenAdditionalOptions(cluster map[string]json.RawMessage, configured map[string]interface{}) map[string]interface{} {
+	options := map[string]interface{}{}
+	for key, value := range configured {
+		if raw, ok := cluster[key]; ok && string(raw) != "null" && !additionalOptionMatches(value.(string), raw) {
+			options[key] = flattenAdditionalOption(raw)
+		} else {
+			options[key] = value
+		}
+	}
+	return options
+}
+
+// additionalOptionMatches reports whether the configured value of an option
+// is sent as the value returned by the API.
+func additionalOptionMatches(value string, raw json.RawMessage) bool {
+	var current interface{}
+	if err := json.Unmarshal(raw, &current); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(expandAdditionalOption(value), current)
+}
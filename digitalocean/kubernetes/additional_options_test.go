@@ -0,0 +1,166 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestCreateKubernetesClusterWithOptions(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != kubernetesClustersPath {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"8d91899c","name":"foo"}}`)
+	})).GodoClient()
+
+	opts := &godo.KubernetesClusterCreateRequest{
+		Name:        "foo",
+		RegionSlug:  "nyc1",
+		VersionSlug: "1.29.1-do.0",
+	}
+	options := map[string]interface{}{
+		"confidential_compute": "true",
+		"etcd_encryption":      "false",
+		"cni":                  "cilium",
+	}
+
+	cluster, _, err := createKubernetesClusterWithOptions(context.Background(), client, opts, options, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cluster.ID != "8d91899c" {
+		t.Errorf("expected cluster ID 8d91899c, got %s", cluster.ID)
+	}
+
+	expected := map[string]interface{}{
+		"name":                 "foo",
+		"region":               "nyc1",
+		"version":              "1.29.1-do.0",
+		"confidential_compute": true,
+		"etcd_encryption":      false,
+		"cni":                  "cilium",
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(body[key], value) {
+			t.Errorf("expected %q to be sent as %#v, got %#v", key, value, body[key])
+		}
+	}
+}
+
+func TestCreateKubernetesClusterWithOptionsConflict(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})).GodoClient()
+
+	opts := &godo.KubernetesClusterCreateRequest{Name: "foo"}
+	options := map[string]interface{}{"name": "bar"}
+
+	_, _, err := createKubernetesClusterWithOptions(context.Background(), client, opts, options, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), `"name"`) {
+		t.Fatalf("expected an error about the conflicting option, got %v", err)
+	}
+}
+
+func TestReadAdditionalOptions(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != kubernetesClustersPath+"/8d91899c" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"8d91899c","confidential_compute":true,"cni":"cilium","limits":{"nodes":10},"etcd_encryption":null,"unset_option":true}}`)
+	})).GodoClient()
+
+	configured := map[string]interface{}{
+		"confidential_compute": "true",
+		"cni":                  "calico",
+		"limits":               "{}",
+		"etcd_encryption":      "true",
+		"write_only":           "false",
+	}
+
+	decoded, cluster, _, err := getKubernetesClusterRaw(context.Background(), client, "8d91899c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if decoded.ID != "8d91899c" {
+		t.Errorf("expected the cluster to be decoded from the same response, got ID %q", decoded.ID)
+	}
+
+	options := flattenAdditionalOptions(cluster, configured)
+	expected := map[string]interface{}{
+		"confidential_compute": "true",
+		"cni":                  "cilium",
+		"limits":               `{"nodes":10}`,
+		"etcd_encryption":      "true",
+		"write_only":           "false",
+	}
+	if !reflect.DeepEqual(options, expected) {
+		t.Errorf("expected %#v, got %#v", expected, options)
+	}
+
+	options = flattenAdditionalOptions(cluster, map[string]interface{}{})
+	if len(options) != 0 {
+		t.Errorf("expected no options, got %#v", options)
+	}
+}
+
+func TestAdditionalOptionRoundTrip(t *testing.T) {
+	for _, raw := range []string{`true`, `false`, `"cilium"`, `{"nodes":10,"pools":["a","b"]}`, `[1,"two"]`} {
+		flattened := flattenAdditionalOption(json.RawMessage(raw))
+
+		sent, err := json.Marshal(expandAdditionalOption(flattened))
+		if err != nil {
+			t.Fatalf("unable to encode %s: %s", raw, err)
+		}
+		if string(sent) != raw {
+			t.Errorf("expected %s to be sent back as is, got %s", raw, sent)
+		}
+
+		if !additionalOptionMatches(flattened, json.RawMessage(raw)) {
+			t.Errorf("expected %q to match %s", flattened, raw)
+		}
+	}
+
+	// The configured formatting of an object is kept.
+	cluster := map[string]json.RawMessage{"limits": json.RawMessage(`{"nodes":10}`)}
+	options := flattenAdditionalOptions(cluster, map[string]interface{}{"limits": `{ "nodes": 10 }`})
+	if options["limits"] != `{ "nodes": 10 }` {
+		t.Errorf("expected the configured value to be kept, got %q", options["limits"])
+	}
+}
+
+func TestValidateAdditionalOptions(t *testing.T) {
+	_, errs := validateAdditionalOptions(map[string]interface{}{
+		"confidential_compute": "true",
+	}, "additional_options")
+	if len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	_, errs = validateAdditionalOptions(map[string]interface{}{
+		"ha":                               "true",
+		"surge_upgrade":                    "false",
+		"confidential_compute":             "true",
+		"cluster_autoscaler_configuration": "{}",
+	}, "additional_options")
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), `"cluster_autoscaler_configuration"`) || !strings.Contains(errs[1].Error(), `"ha"`) || !strings.Contains(errs[2].Error(), `"surge_upgrade"`) {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const clusterAutoscalerConfigurationKey = "cluster_autoscaler_configuration"
+
+// kubernetesClusterAutoscalerConfiguration is the cluster autoscaler
+// configuration of a cluster, which is missing from godo's cluster requests.
+// Unset fields are omitted so that the API defaults apply.
+type kubernetesClusterAutoscalerConfiguration struct {
+	ScaleDownUtilizationThreshold *float64 `json:"scale_down_utilization_threshold,omitempty"`
+	ScaleDownUnneededTime         *string  `json:"scale_down_unneeded_time,omitempty"`
+	Expanders                     []string `json:"expanders,omitempty"`
+}
+
+// Ref: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/FAQ.md#what-are-expanders
+func validClusterAutoscalerExpanders() []string {
+	return []string{
+		"random",
+		"most-pods",
+		"least-waste",
+		"price",
+		"priority",
+		"grpc",
+	}
+}
+
+func clusterAutoscalerConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scale_down_utilization_threshold": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.FloatBetween(0, 1),
+				},
+				"scale_down_unneeded_time": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateFunc:     validateKubernetesDuration,
+					DiffSuppressFunc: suppressEquivalentKubernetesDuration,
+				},
+				"expanders": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(validClusterAutoscalerExpanders(), false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandClusterAutoscalerConfiguration returns nil if the block is not set.
+// Only the fields that are set are sent.
+func expandClusterAutoscalerConfiguration(config []interface{}) *kubernetesClusterAutoscalerConfiguration {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	configMap := config[0].(map[string]interface{})
+	autoscaler := &kubernetesClusterAutoscalerConfiguration{}
+
+	if v, ok := configMap["scale_down_utilization_threshold"].(float64); ok && v != 0 {
+		autoscaler.ScaleDownUtilizationThreshold = godo.PtrTo(v)
+	}
+
+	if v, ok := configMap["scale_down_unneeded_time"].(string); ok && v != "" {
+		autoscaler.ScaleDownUnneededTime = godo.PtrTo(v)
+	}
+
+	if v, ok := configMap["expanders"].([]interface{}); ok {
+		for _, expander := range v {
+			autoscaler.Expanders = append(autoscaler.Expanders, expander.(string))
+		}
+	}
+
+	return autoscaler
+}
+
+// flattenClusterAutoscalerConfiguration flattens the configuration returned
+// by the API, which may be missing or null.
+func flattenClusterAutoscalerConfiguration(raw json.RawMessage) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0)
+	if len(raw) == 0 || string(raw) == "null" {
+		return result, nil
+	}
+
+	autoscaler := &kubernetesClusterAutoscalerConfiguration{}
+	if err := json.Unmarshal(raw, autoscaler); err != nil {
+		return nil, err
+	}
+
+	expanders := autoscaler.Expanders
+	if expanders == nil {
+		expanders = []string{}
+	}
+
+	item := map[string]interface{}{
+		"expanders": expanders,
+	}
+	if autoscaler.ScaleDownUtilizationThreshold != nil {
+		item["scale_down_utilization_threshold"] = *autoscaler.ScaleDownUtilizationThreshold
+	}
+	if autoscaler.ScaleDownUnneededTime != nil {
+		item["scale_down_unneeded_time"] = *autoscaler.ScaleDownUnneededTime
+	}
+	result = append(result, item)
+
+	return result, nil
+}
+
+// updateKubernetesCluster updates a cluster like client.Kubernetes.Update,
+// additionally sending the autoscaler configuration and the routing agent, if
+// any. surge_upgrade is always sent, as godo omits it when false, which would
+// never disable it.
+func updateKubernetesCluster(ctx context.Context, client *godo.Client, id string, opts *godo.KubernetesClusterUpdateRequest, autoscaler *kubernetesClusterAutoscalerConfiguration, routingAgent *kubernetesRoutingAgent) (*godo.KubernetesCluster, *godo.Response, error) {
+	encoded, err := json.Marshal(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		return nil, nil, err
+	}
+
+	body["surge_upgrade"] = opts.SurgeUpgrade
+	if autoscaler != nil {
+		body[clusterAutoscalerConfigurationKey] = autoscaler
+	}
+	if routingAgent != nil {
+		body[routingAgentKey] = routingAgent
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%s", kubernetesClustersPath, id), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(kubernetesClusterRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Cluster, resp, nil
+}
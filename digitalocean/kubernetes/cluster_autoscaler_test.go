@@ -0,0 +1,145 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestExpandClusterAutoscalerConfiguration(t *testing.T) {
+	if autoscaler := expandClusterAutoscalerConfiguration([]interface{}{}); autoscaler != nil {
+		t.Errorf("expected no configuration when the block is not set, got %+v", autoscaler)
+	}
+
+	autoscaler := expandClusterAutoscalerConfiguration([]interface{}{map[string]interface{}{
+		"scale_down_utilization_threshold": 0.5,
+		"scale_down_unneeded_time":         "",
+		"expanders":                        []interface{}{"priority", "random"},
+	}})
+
+	b, err := json.Marshal(autoscaler)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Unset fields are omitted so that the API defaults apply.
+	expected := `{"scale_down_utilization_threshold":0.5,"expanders":["priority","random"]}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestFlattenClusterAutoscalerConfiguration(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      string
+		expected []map[string]interface{}
+	}{
+		{"Missing", "", []map[string]interface{}{}},
+		{"Null", "null", []map[string]interface{}{}},
+		{
+			"Set",
+			`{"scale_down_utilization_threshold":0.65,"scale_down_unneeded_time":"1m0s","expanders":["priority"]}`,
+			[]map[string]interface{}{{
+				"scale_down_utilization_threshold": 0.65,
+				"scale_down_unneeded_time":         "1m0s",
+				"expanders":                        []string{"priority"},
+			}},
+		},
+		{
+			"NoExpanders",
+			`{"scale_down_utilization_threshold":0.65,"scale_down_unneeded_time":"10m"}`,
+			[]map[string]interface{}{{
+				"scale_down_utilization_threshold": 0.65,
+				"scale_down_unneeded_time":         "10m",
+				"expanders":                        []string{},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := flattenClusterAutoscalerConfiguration(json.RawMessage(tc.raw))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateKubernetesClusterWithAutoscalerConfiguration(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"8d91899c","name":"foo"}}`)
+	})).GodoClient()
+
+	opts := &godo.KubernetesClusterCreateRequest{Name: "foo"}
+	autoscaler := &kubernetesClusterAutoscalerConfiguration{ScaleDownUnneededTime: godo.PtrTo("5m")}
+
+	if _, _, err := createKubernetesClusterWithOptions(context.Background(), client, opts, nil, autoscaler, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{"scale_down_unneeded_time": "5m"}
+	if !reflect.DeepEqual(body[clusterAutoscalerConfigurationKey], expected) {
+		t.Errorf("expected the autoscaler configuration to be sent as %#v, got %#v", expected, body[clusterAutoscalerConfigurationKey])
+	}
+}
+
+func TestUpdateKubernetesCluster(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != kubernetesClustersPath+"/8d91899c" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"8d91899c","name":"foo"}}`)
+	})).GodoClient()
+
+	opts := &godo.KubernetesClusterUpdateRequest{Name: "foo", AutoUpgrade: godo.PtrTo(true)}
+	autoscaler := &kubernetesClusterAutoscalerConfiguration{
+		ScaleDownUtilizationThreshold: godo.PtrTo(0.4),
+		Expanders:                     []string{"least-waste"},
+	}
+
+	cluster, _, err := updateKubernetesCluster(context.Background(), client, "8d91899c", opts, autoscaler, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cluster.ID != "8d91899c" {
+		t.Errorf("expected cluster ID 8d91899c, got %s", cluster.ID)
+	}
+
+	expected := map[string]interface{}{
+		"name":          "foo",
+		"auto_upgrade":  true,
+		"surge_upgrade": false,
+		clusterAutoscalerConfigurationKey: map[string]interface{}{
+			"scale_down_utilization_threshold": 0.4,
+			"expanders":                        []interface{}{"least-waste"},
+		},
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(body[key], value) {
+			t.Errorf("expected %q to be sent as %#v, got %#v", key, value, body[key])
+		}
+	}
+}
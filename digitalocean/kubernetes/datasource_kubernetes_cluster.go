@@ -98,6 +98,37 @@ func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"control_plane_firewall": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allowed_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"routing_agent": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"node_pool": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -123,11 +154,18 @@ func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"kubeconfig_expire_seconds": kubeconfigExpireSecondsSchema(),
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 
+			"registry_integration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"urn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -153,6 +191,15 @@ func dataSourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.
 		if c.Name == d.Get("name").(string) {
 			d.SetId(c.ID)
 
+			_, raw, _, err := getKubernetesClusterRaw(context.Background(), client, c.ID)
+			if err != nil {
+				return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+			}
+
+			if err := setKubernetesClusterRoutingAgent(d, raw); err != nil {
+				return diag.FromErr(err)
+			}
+
 			return digitaloceanKubernetesClusterRead(client, c, d)
 		}
 	}
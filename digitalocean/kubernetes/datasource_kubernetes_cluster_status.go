@@ -0,0 +1,179 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultKubernetesClusterStatusTimeout = 30 * time.Minute
+
+// kubernetesClusterFailedStates are the states from which a cluster does not
+// reach another state by itself, so waiting for another state fails early.
+var kubernetesClusterFailedStates = []string{
+	string(godo.KubernetesClusterStatusError),
+	string(godo.KubernetesClusterStatusDeleted),
+	string(godo.KubernetesClusterStatusInvalid),
+}
+
+func DataSourceDigitalOceanKubernetesClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesClusterStatusRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of the Kubernetes cluster",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"cluster_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the Kubernetes cluster",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"cluster_id", "name"},
+			},
+			"wait_for_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The status to wait for the Kubernetes cluster to reach, such as running",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultKubernetesClusterStatusTimeout.String(),
+				Description:  "How long to wait for the Kubernetes cluster to reach wait_for_status",
+				ValidateFunc: validateKubernetesDuration,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the Kubernetes cluster",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message describing the status of the Kubernetes cluster",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the Kubernetes cluster was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the Kubernetes cluster was last updated",
+			},
+			"checked_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the status of the Kubernetes cluster was last read",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesClusterStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	id := d.Get("cluster_id").(string)
+	if id == "" {
+		var err error
+		id, err = findKubernetesClusterIDByName(ctx, client, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var cluster *godo.KubernetesCluster
+	refresh := func(ctx context.Context) (string, error) {
+		c, _, err := client.Kubernetes.Get(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving Kubernetes cluster (%s): %s", id, err)
+		}
+		cluster = c
+		if c.Status == nil {
+			return "", nil
+		}
+		return string(c.Status.State), nil
+	}
+
+	// The data source only blocks when a status to wait for is configured.
+	if target, ok := d.GetOk("wait_for_status"); ok {
+		timeout, err := time.ParseDuration(d.Get("timeout").(string))
+		if err != nil {
+			return diag.Errorf("Invalid timeout: %s", err)
+		}
+
+		var failed []string
+		for _, state := range kubernetesClusterFailedStates {
+			if state != target.(string) {
+				failed = append(failed, state)
+			}
+		}
+
+		if _, err := util.WaitForStatus(ctx, refresh, target.(string), failed, timeout); err != nil {
+			return diag.Errorf("Error waiting for Kubernetes cluster (%s) to be %s: %s", id, target, err)
+		}
+	} else if _, err := refresh(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("cluster_id", cluster.ID)
+	d.Set("name", cluster.Name)
+	if cluster.Status != nil {
+		d.Set("status", string(cluster.Status.State))
+		d.Set("status_message", cluster.Status.Message)
+	}
+	d.Set("created_at", cluster.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("updated_at", cluster.UpdatedAt.UTC().Format(time.RFC3339))
+	d.Set("checked_at", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func findKubernetesClusterIDByName(ctx context.Context, client *godo.Client, name string) (string, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		clusters, resp, err := client.Kubernetes.List(ctx, opts)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		for _, cluster := range clusters {
+			if cluster.Name == name {
+				return cluster.ID, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return "", fmt.Errorf("Unable to find cluster with name: %s", name)
+}
@@ -0,0 +1,95 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanKubernetesClusterStatusRead(t *testing.T) {
+	cases := []struct {
+		name           string
+		raw            map[string]interface{}
+		state          string
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:           "NoWait",
+			raw:            map[string]interface{}{"cluster_id": "k1"},
+			state:          "provisioning",
+			expectedStatus: "provisioning",
+		},
+		{
+			name:           "ByNameReached",
+			raw:            map[string]interface{}{"name": "example", "wait_for_status": "running"},
+			state:          "running",
+			expectedStatus: "running",
+		},
+		{
+			name:          "Failed",
+			raw:           map[string]interface{}{"cluster_id": "k1", "wait_for_status": "running"},
+			state:         "error",
+			expectedError: `status "error" reached while waiting for status "running"`,
+		},
+		{
+			// The cluster never converges, so the read fails once the
+			// timeout expires.
+			name:          "Timeout",
+			raw:           map[string]interface{}{"cluster_id": "k1", "wait_for_status": "running", "timeout": "200ms"},
+			state:         "provisioning",
+			expectedError: `timeout after 200ms waiting for status "running", last status was "provisioning"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := fmt.Sprintf(`{"id":"k1","name":"example","status":{"state":%q,"message":"Cluster is %s"},"created_at":"2024-06-01T10:00:00Z","updated_at":"2024-06-01T10:05:00Z"}`, tc.state, tc.state)
+
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/v2/kubernetes/clusters":
+					fmt.Fprintf(w, `{"kubernetes_clusters":[{"id":"k2","name":"other"},%s],"links":{}}`, cluster)
+				case "/v2/kubernetes/clusters/k1":
+					fmt.Fprintf(w, `{"kubernetes_cluster":%s}`, cluster)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			r := DataSourceDigitalOceanKubernetesClusterStatus()
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.raw)
+
+			diags := r.ReadContext(context.Background(), d, meta)
+			if tc.expectedError != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.expectedError) {
+					t.Fatalf("expected error %q, got: %#v", tc.expectedError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if d.Id() != "k1" || d.Get("name").(string) != "example" {
+				t.Errorf("expected cluster k1 named example, got ID %q and name %q", d.Id(), d.Get("name"))
+			}
+			if v := d.Get("status").(string); v != tc.expectedStatus {
+				t.Errorf("expected status %q, got %q", tc.expectedStatus, v)
+			}
+			if v := d.Get("status_message").(string); v != "Cluster is "+tc.expectedStatus {
+				t.Errorf("expected status_message %q, got %q", "Cluster is "+tc.expectedStatus, v)
+			}
+			if v := d.Get("updated_at").(string); v != "2024-06-01T10:05:00Z" {
+				t.Errorf("expected updated_at 2024-06-01T10:05:00Z, got %q", v)
+			}
+		})
+	}
+}
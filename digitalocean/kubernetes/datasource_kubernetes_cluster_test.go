@@ -16,8 +16,9 @@ import (
 func TestAccDataSourceDigitalOceanKubernetesCluster_Basic(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 	expectedURNRegEx, _ := regexp.Compile(`do:kubernetes:[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12}`)
-	resourceConfig := testAccDigitalOceanKubernetesConfigForDataSource(testClusterVersionLatest, rName)
+	resourceConfig := testAccDigitalOceanKubernetesConfigForDataSource(testClusterVersionLatest, rName, region)
 	dataSourceConfig := `
 data "digitalocean_kubernetes_cluster" "foobar" {
   name = digitalocean_kubernetes_cluster.foo.name
@@ -42,7 +43,7 @@ data "digitalocean_kubernetes_cluster" "foobar" {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceDigitalOceanKubernetesClusterExists("data.digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "name", rName),
-					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "region", "lon1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "region", region),
 					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
 					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "node_pool.0.labels.priority", "high"),
 					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster.foobar", "vpc_uuid"),
@@ -51,18 +52,21 @@ data "digitalocean_kubernetes_cluster" "foobar" {
 					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "maintenance_policy.0.day", "monday"),
 					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "maintenance_policy.0.start_time", "00:00"),
 					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster.foobar", "maintenance_policy.0.duration"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.0", "10.0.0.0/8"),
 				),
 			},
 		},
 	})
 }
 
-func testAccDigitalOceanKubernetesConfigForDataSource(version string, rName string) string {
+func testAccDigitalOceanKubernetesConfigForDataSource(version string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foo" {
   name         = "%s"
-  region       = "lon1"
+  region       = "%s"
   version      = data.digitalocean_kubernetes_versions.test.latest_version
   tags         = ["foo", "bar"]
   auto_upgrade = true
@@ -80,7 +84,11 @@ resource "digitalocean_kubernetes_cluster" "foo" {
     day        = "monday"
     start_time = "00:00"
   }
-}`, version, rName)
+  control_plane_firewall {
+    enabled           = true
+    allowed_addresses = ["10.0.0.0/8"]
+  }
+}`, version, rName, region)
 }
 
 func testAccCheckDataSourceDigitalOceanKubernetesClusterExists(n string, cluster *godo.KubernetesCluster) resource.TestCheckFunc {
@@ -0,0 +1,17 @@
+package kubernetes
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanKubernetesClusters() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        kubernetesClusterSchema(),
+		ResultAttributeName: "clusters",
+		GetRecords:          getDigitalOceanKubernetesClusters,
+		FlattenRecord:       flattenDigitalOceanKubernetesCluster,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,55 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesClusters_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "kubernetes")
+	resourceConfig := testAccDigitalOceanKubernetesConfigForDataSource(testClusterVersionLatest, rName, region)
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_kubernetes_clusters" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}`, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_clusters.result", "clusters.0.id", "digitalocean_kubernetes_cluster.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.name", rName),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.region", region),
+					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_clusters.result", "clusters.0.version", "digitalocean_kubernetes_cluster.foo", "version"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.status", "running"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_clusters.result", "clusters.0.vpc_uuid", "digitalocean_kubernetes_cluster.foo", "vpc_uuid"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.node_pool.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.node_pool.0.name", "default"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.node_pool.0.node_count", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.node_pool.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.node_pool.0.labels.priority", "high"),
+					resource.TestCheckNoResourceAttr("data.digitalocean_kubernetes_clusters.result", "clusters.0.kube_config.#"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
@@ -2,12 +2,16 @@ package kubernetes
 
 import (
 	"context"
+	"sort"
 	"strings"
 
+	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceDigitalOceanKubernetesVersions() *schema.Resource {
@@ -18,6 +22,15 @@ func DataSourceDigitalOceanKubernetesVersions() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"latest_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -34,15 +47,31 @@ func DataSourceDigitalOceanKubernetesVersions() *schema.Resource {
 func dataSourceDigitalOceanKubernetesVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	k8sOptions, _, err := client.Kubernetes.GetOptions(context.Background())
-	if err != nil {
-		return diag.Errorf("Error retrieving Kubernetes options: %s", err)
+	var versions []*godo.KubernetesVersion
+	if clusterID, ok := d.GetOk("cluster_id"); ok {
+		cluster, _, err := client.Kubernetes.Get(context.Background(), clusterID.(string))
+		if err != nil {
+			return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+		}
+		d.Set("current_version", cluster.VersionSlug)
+
+		versions, _, err = client.Kubernetes.GetUpgrades(context.Background(), cluster.ID)
+		if err != nil {
+			return diag.Errorf("Error retrieving Kubernetes cluster upgrades: %s", err)
+		}
+		sortKubernetesVersionsDescending(versions)
+	} else {
+		k8sOptions, _, err := client.Kubernetes.GetOptions(context.Background())
+		if err != nil {
+			return diag.Errorf("Error retrieving Kubernetes options: %s", err)
+		}
+		versions = k8sOptions.Versions
 	}
 
 	d.SetId(resource.UniqueId())
 
 	validVersions := make([]string, 0)
-	for _, v := range k8sOptions.Versions {
+	for _, v := range versions {
 		if strings.HasPrefix(v.Slug, d.Get("version_prefix").(string)) {
 			validVersions = append(validVersions, v.Slug)
 		}
@@ -55,3 +84,17 @@ func dataSourceDigitalOceanKubernetesVersionsRead(ctx context.Context, d *schema
 
 	return nil
 }
+
+// sortKubernetesVersionsDescending sorts versions from the most recent one, as
+// the versions of the options are, since the order of the upgrades returned
+// by the API is not documented. Slugs which are not versions are kept last.
+func sortKubernetesVersionsDescending(versions []*godo.KubernetesVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, errI := version.NewVersion(versions[i].Slug)
+		vj, errJ := version.NewVersion(versions[j].Slug)
+		if errI != nil || errJ != nil {
+			return errI == nil && errJ != nil
+		}
+		return vi.GreaterThan(vj)
+	})
+}
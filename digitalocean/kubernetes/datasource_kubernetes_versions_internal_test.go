@@ -0,0 +1,80 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDataSourceDigitalOceanKubernetesVersionsUpgrades(t *testing.T) {
+	cases := []struct {
+		name            string
+		prefix          string
+		upgrades        string
+		expected        []string
+		expectedLatest  string
+		expectedCurrent string
+	}{
+		{
+			name:            "upgrades",
+			upgrades:        `[{"slug":"1.29.8-do.0"},{"slug":"1.30.4-do.0"},{"slug":"1.29.10-do.0"}]`,
+			expected:        []string{"1.30.4-do.0", "1.29.10-do.0", "1.29.8-do.0"},
+			expectedLatest:  "1.30.4-do.0",
+			expectedCurrent: "1.29.1-do.0",
+		},
+		{
+			name:            "prefix",
+			prefix:          "1.29.",
+			upgrades:        `[{"slug":"1.29.8-do.0"},{"slug":"1.30.4-do.0"},{"slug":"1.29.10-do.0"}]`,
+			expected:        []string{"1.29.10-do.0", "1.29.8-do.0"},
+			expectedLatest:  "1.29.10-do.0",
+			expectedCurrent: "1.29.1-do.0",
+		},
+		{
+			name:            "no upgrades",
+			upgrades:        `null`,
+			expected:        []string{},
+			expectedCurrent: "1.29.1-do.0",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case kubernetesClustersPath + "/cluster-id":
+					fmt.Fprint(w, `{"kubernetes_cluster":{"id":"cluster-id","version":"1.29.1-do.0"}}`)
+				case kubernetesClustersPath + "/cluster-id/upgrades":
+					fmt.Fprintf(w, `{"available_upgrade_versions":%s}`, c.upgrades)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+
+			d := DataSourceDigitalOceanKubernetesVersions().TestResourceData()
+			d.Set("cluster_id", "cluster-id")
+			d.Set("version_prefix", c.prefix)
+
+			if diags := dataSourceDigitalOceanKubernetesVersionsRead(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			versions := []string{}
+			for _, v := range d.Get("valid_versions").([]interface{}) {
+				versions = append(versions, v.(string))
+			}
+			if !reflect.DeepEqual(versions, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, versions)
+			}
+			if latest := d.Get("latest_version").(string); latest != c.expectedLatest {
+				t.Errorf("expected latest version %q, got %q", c.expectedLatest, latest)
+			}
+			if current := d.Get("current_version").(string); current != c.expectedCurrent {
+				t.Errorf("expected current version %q, got %q", c.expectedCurrent, current)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ func TestAccDataSourceDigitalOceanKubernetesVersions_Filtered(t *testing.T) {
 func TestAccDataSourceDigitalOceanKubernetesVersions_CreateCluster(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -51,7 +52,7 @@ func TestAccDataSourceDigitalOceanKubernetesVersions_CreateCluster(t *testing.T)
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanKubernetesVersionsConfig_create, rName),
+				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanKubernetesVersionsConfig_create, rName, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_kubernetes_versions.foobar", "latest_version"),
@@ -79,7 +80,7 @@ data "digitalocean_kubernetes_versions" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.foobar.latest_version
 
   node_pool {
@@ -25,6 +25,7 @@ var (
 
 func TestAccDigitalOceanKubernetesCluster_ImportBasic(t *testing.T) {
 	clusterName := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -32,7 +33,7 @@ func TestAccDigitalOceanKubernetesCluster_ImportBasic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, clusterName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, clusterName, region),
 				// Remove the default node pool tag so that the import code which infers
 				// the need to add the tag gets triggered.
 				Check: testAccDigitalOceanKubernetesRemoveDefaultNodePoolTag(clusterName),
@@ -50,8 +51,9 @@ func TestAccDigitalOceanKubernetesCluster_ImportBasic(t *testing.T) {
 func TestAccDigitalOceanKubernetesCluster_ImportErrorNonDefaultNodePool(t *testing.T) {
 	testName1 := acceptance.RandomTestName()
 	testName2 := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
-	config := fmt.Sprintf(testAccDigitalOceanKubernetesCusterWithMultipleNodePools, testClusterVersionLatest, testName1, testName2)
+	config := fmt.Sprintf(testAccDigitalOceanKubernetesCusterWithMultipleNodePools, testClusterVersionLatest, testName1, region, testName2)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -77,8 +79,9 @@ func TestAccDigitalOceanKubernetesCluster_ImportErrorNonDefaultNodePool(t *testi
 func TestAccDigitalOceanKubernetesCluster_ImportNonDefaultNodePool(t *testing.T) {
 	testName1 := acceptance.RandomTestName()
 	testName2 := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
-	config := fmt.Sprintf(testAccDigitalOceanKubernetesCusterWithMultipleNodePools, testClusterVersionLatest, testName1, testName2)
+	config := fmt.Sprintf(testAccDigitalOceanKubernetesCusterWithMultipleNodePools, testClusterVersionLatest, testName1, region, testName2)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -157,7 +160,7 @@ const testAccDigitalOceanKubernetesCusterWithMultipleNodePools = `%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
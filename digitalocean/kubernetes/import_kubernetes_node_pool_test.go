@@ -12,12 +12,13 @@ import (
 func TestAccDigitalOceanKubernetesNodePool_Import(t *testing.T) {
 	testName1 := acceptance.RandomTestName()
 	testName2 := acceptance.RandomTestName()
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	config := fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -33,7 +34,7 @@ resource "digitalocean_kubernetes_node_pool" "barfoo" {
   size       = "s-1vcpu-2gb"
   node_count = 1
 }
-`, testClusterVersionLatest, testName1, testName2)
+`, testClusterVersionLatest, testName1, region, testName2)
 	resourceName := "digitalocean_kubernetes_node_pool.barfoo"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1,7 +1,9 @@
 package kubernetes
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
@@ -9,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// kubernetesPollInterval is the interval at which clusters and node pools are
+// polled while waiting for them to be created, updated, or deleted.
+var kubernetesPollInterval = 10 * time.Second
+
 func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"id": {
@@ -33,6 +39,16 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Computed: true,
 		},
 
+		"running_node_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+
+		"last_node_created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
 		"node_count": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -89,6 +105,7 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
+			ValidateFunc: validateNodePoolLabels,
 		},
 
 		"nodes": nodeSchema(),
@@ -121,8 +138,9 @@ func nodePoolTaintSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateNodePoolTaintKey,
 			},
 			"value": {
 				Type:     schema.TypeString,
@@ -197,28 +215,24 @@ func flattenLabels(labels map[string]string) map[string]interface{} {
 	return flattenedLabels
 }
 
-func expandNodePools(nodePools []interface{}) []*godo.KubernetesNodePool {
-	expandedNodePools := make([]*godo.KubernetesNodePool, 0, len(nodePools))
-	for _, rawPool := range nodePools {
-		pool := rawPool.(map[string]interface{})
-		cr := &godo.KubernetesNodePool{
-			ID:        pool["id"].(string),
-			Name:      pool["name"].(string),
-			Size:      pool["size"].(string),
-			Count:     pool["node_count"].(int),
-			AutoScale: pool["auto_scale"].(bool),
-			MinNodes:  pool["min_nodes"].(int),
-			MaxNodes:  pool["max_nodes"].(int),
-			Tags:      tag.ExpandTags(pool["tags"].(*schema.Set).List()),
-			Labels:    expandLabels(pool["labels"].(map[string]interface{})),
-			Nodes:     expandNodes(pool["nodes"].([]interface{})),
-			Taints:    expandNodePoolTaints(pool["taint"].(*schema.Set).List()),
-		}
-
-		expandedNodePools = append(expandedNodePools, cr)
+// expandNodePoolCreateRequest builds the request used to create a node pool,
+// either as the default pool of a cluster or with the node pool resource.
+func expandNodePoolCreateRequest(pool map[string]interface{}, customTags ...string) *godo.KubernetesNodePoolCreateRequest {
+	// append any custom tags
+	tags := tag.ExpandTags(pool["tags"].(*schema.Set).List())
+	tags = append(tags, customTags...)
+
+	return &godo.KubernetesNodePoolCreateRequest{
+		Name:      pool["name"].(string),
+		Size:      pool["size"].(string),
+		Count:     pool["node_count"].(int),
+		Tags:      tags,
+		Labels:    expandLabels(pool["labels"].(map[string]interface{})),
+		AutoScale: pool["auto_scale"].(bool),
+		MinNodes:  pool["min_nodes"].(int),
+		MaxNodes:  pool["max_nodes"].(int),
+		Taints:    expandNodePoolTaints(pool["taint"].(*schema.Set).List()),
 	}
-
-	return expandedNodePools
 }
 
 func expandMaintPolicyOpts(config []interface{}) (*godo.KubernetesMaintenancePolicy, error) {
@@ -252,6 +266,55 @@ func flattenMaintPolicyOpts(opts *godo.KubernetesMaintenancePolicy) []map[string
 	return result
 }
 
+func expandControlPlaneFirewallOpts(config []interface{}) *godo.KubernetesControlPlaneFirewall {
+	// Removing the block disables the firewall.
+	if len(config) == 0 || config[0] == nil {
+		return &godo.KubernetesControlPlaneFirewall{
+			Enabled:          godo.PtrTo(false),
+			AllowedAddresses: []string{},
+		}
+	}
+
+	configMap := config[0].(map[string]interface{})
+
+	addresses := []string{}
+	for _, v := range configMap["allowed_addresses"].([]interface{}) {
+		addresses = append(addresses, v.(string))
+	}
+
+	return &godo.KubernetesControlPlaneFirewall{
+		Enabled:          godo.PtrTo(configMap["enabled"].(bool)),
+		AllowedAddresses: addresses,
+	}
+}
+
+// flattenControlPlaneFirewallOpts flattens the control plane firewall of a
+// cluster. A disabled firewall is only flattened if the block is configured,
+// as removing the block disables the firewall rather than deleting it.
+func flattenControlPlaneFirewallOpts(fw *godo.KubernetesControlPlaneFirewall, configured bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if fw == nil {
+		return result
+	}
+
+	enabled := fw.Enabled != nil && *fw.Enabled
+	if !enabled && !configured {
+		return result
+	}
+
+	addresses := fw.AllowedAddresses
+	if addresses == nil {
+		addresses = []string{}
+	}
+
+	result = append(result, map[string]interface{}{
+		"enabled":           enabled,
+		"allowed_addresses": addresses,
+	})
+
+	return result
+}
+
 func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.KubernetesNodePool, parentTags ...string) []interface{} {
 	rawPool := map[string]interface{}{
 		"id":                pool.ID,
@@ -261,7 +324,7 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 		"auto_scale":        pool.AutoScale,
 		"min_nodes":         pool.MinNodes,
 		"max_nodes":         pool.MaxNodes,
-		"taint":             pool.Taints,
+		"taint":             flattenNodePoolTaints(filterSystemTaints(pool.Taints)),
 	}
 
 	if pool.Tags != nil {
@@ -269,15 +332,13 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 	}
 
 	if pool.Labels != nil {
-		rawPool["labels"] = flattenLabels(pool.Labels)
+		rawPool["labels"] = flattenLabels(filterSystemLabels(pool.Labels))
 	}
 
 	if pool.Nodes != nil {
 		rawPool["nodes"] = flattenNodes(pool.Nodes)
-	}
-
-	if pool.Taints != nil {
-		rawPool["taint"] = flattenNodePoolTaints(pool.Taints)
+		rawPool["running_node_count"] = countRunningNodes(pool.Nodes)
+		rawPool["last_node_created_at"] = lastNodeCreatedAt(pool.Nodes)
 	}
 
 	// Assign a node_count only if it's been set explicitly, since it's
@@ -289,21 +350,6 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 	return []interface{}{rawPool}
 }
 
-func expandNodes(nodes []interface{}) []*godo.KubernetesNode {
-	expandedNodes := make([]*godo.KubernetesNode, 0, len(nodes))
-	for _, rawNode := range nodes {
-		node := rawNode.(map[string]interface{})
-		n := &godo.KubernetesNode{
-			ID:   node["id"].(string),
-			Name: node["name"].(string),
-		}
-
-		expandedNodes = append(expandedNodes, n)
-	}
-
-	return expandedNodes
-}
-
 func flattenNodes(nodes []*godo.KubernetesNode) []interface{} {
 	flattenedNodes := make([]interface{}, 0)
 	if nodes == nil {
@@ -314,18 +360,53 @@ func flattenNodes(nodes []*godo.KubernetesNode) []interface{} {
 		rawNode := map[string]interface{}{
 			"id":         node.ID,
 			"name":       node.Name,
-			"status":     node.Status.State,
 			"droplet_id": node.DropletID,
 			"created_at": node.CreatedAt.UTC().String(),
 			"updated_at": node.UpdatedAt.UTC().String(),
 		}
 
+		if node.Status != nil {
+			rawNode["status"] = node.Status.State
+		}
+
 		flattenedNodes = append(flattenedNodes, rawNode)
 	}
 
 	return flattenedNodes
 }
 
+// countRunningNodes returns the number of nodes in a pool which have reached
+// the running state. Comparing it with the pool's node count and autoscaling
+// bounds shows whether a pool is still scaling.
+func countRunningNodes(nodes []*godo.KubernetesNode) int {
+	running := 0
+	for _, node := range nodes {
+		if node.Status != nil && node.Status.State == "running" {
+			running++
+		}
+	}
+
+	return running
+}
+
+// lastNodeCreatedAt returns the creation time of the newest node in a pool.
+// The API does not expose scaling events, so this is the closest signal to
+// when a pool last grew.
+func lastNodeCreatedAt(nodes []*godo.KubernetesNode) string {
+	var last time.Time
+	for _, node := range nodes {
+		if node.CreatedAt.After(last) {
+			last = node.CreatedAt
+		}
+	}
+
+	if last.IsZero() {
+		return ""
+	}
+
+	return last.UTC().String()
+}
+
 func expandNodePoolTaints(taints []interface{}) []godo.Taint {
 	expandedTaints := make([]godo.Taint, 0, len(taints))
 	for _, rawTaint := range taints {
@@ -361,6 +442,131 @@ func flattenNodePoolTaints(taints []godo.Taint) []interface{} {
 	return flattenedTaints
 }
 
+// systemTaintDomains are the domains reserved for taints added to nodes by
+// Kubernetes and DOKS rather than configured by users, e.g.
+// "node.kubernetes.io/unschedulable".
+var systemTaintDomains = []string{
+	"kubernetes.io",
+	"k8s.io",
+	"digitalocean.com",
+}
+
+// hasDomainPrefix returns whether the prefix of the key is one of the domains
+// or a subdomain of one.
+func hasDomainPrefix(key string, domains []string) bool {
+	i := strings.Index(key, "/")
+	if i < 0 {
+		return false
+	}
+
+	prefix := key[:i]
+	for _, domain := range domains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSystemTaintKey returns whether the prefix of the key is one of the
+// systemTaintDomains or a subdomain of one.
+func isSystemTaintKey(key string) bool {
+	return hasDomainPrefix(key, systemTaintDomains)
+}
+
+func validateNodePoolTaintKey(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if isSystemTaintKey(value) {
+		errors = append(errors, fmt.Errorf("%q must not use a domain reserved for system taints (%s), got %q", k, strings.Join(systemTaintDomains, ", "), value))
+	}
+	return
+}
+
+// filterSystemTaints filters taints to remove any automatically added by
+// Kubernetes or DOKS to avoid state problems.
+func filterSystemTaints(taints []godo.Taint) []godo.Taint {
+	filteredTaints := make([]godo.Taint, 0)
+	for _, t := range taints {
+		if !isSystemTaintKey(t.Key) {
+			filteredTaints = append(filteredTaints, t)
+		}
+	}
+
+	return filteredTaints
+}
+
+// systemTaints returns the taints automatically added by Kubernetes or DOKS,
+// which must be preserved when the taints of a node pool are updated.
+func systemTaints(taints []godo.Taint) []godo.Taint {
+	result := make([]godo.Taint, 0)
+	for _, t := range taints {
+		if isSystemTaintKey(t.Key) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// systemLabelDomains are the domains reserved for labels added to node pools
+// by DOKS rather than configured by users, e.g.
+// "doks.digitalocean.com/node-pool".
+var systemLabelDomains = []string{
+	"digitalocean.com",
+}
+
+// isSystemLabelKey returns whether the prefix of the key is one of the
+// systemLabelDomains or a subdomain of one.
+func isSystemLabelKey(key string) bool {
+	return hasDomainPrefix(key, systemLabelDomains)
+}
+
+func validateNodePoolLabels(v interface{}, k string) (ws []string, errors []error) {
+	labels, ok := v.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be map", k))
+		return
+	}
+
+	for key := range labels {
+		if isSystemLabelKey(key) {
+			errors = append(errors, fmt.Errorf("%q must not use a domain reserved for system labels (%s), got %q", k, strings.Join(systemLabelDomains, ", "), key))
+		}
+	}
+	return
+}
+
+// filterSystemLabels filters labels to remove any automatically added by DOKS
+// to avoid state problems.
+func filterSystemLabels(labels map[string]string) map[string]string {
+	filteredLabels := make(map[string]string)
+	for key, value := range labels {
+		if !isSystemLabelKey(key) {
+			filteredLabels[key] = value
+		}
+	}
+
+	return filteredLabels
+}
+
+// systemLabels returns the labels added to a node pool by DOKS.
+func systemLabels(labels map[string]string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range labels {
+		if isSystemLabelKey(key) {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
 // FilterTags filters tags to remove any automatically added to avoid state problems,
 // these are tags starting with "k8s:" or named "k8s"
 func FilterTags(tags []string) []string {
@@ -375,3 +581,35 @@ func FilterTags(tags []string) []string {
 
 	return filteredTags
 }
+
+func validateKubernetesDuration(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as \"10m\" or \"1h30m\", got %q", k, value))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %q", k, value))
+	}
+	return
+}
+
+// suppressEquivalentKubernetesDuration suppresses the diff between
+// equivalent durations, e.g. "10m" and "10m0s".
+func suppressEquivalentKubernetesDuration(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
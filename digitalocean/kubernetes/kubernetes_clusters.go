@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func kubernetesClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the Kubernetes cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the Kubernetes cluster",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the Kubernetes cluster is deployed in",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "the version slug of Kubernetes run by the cluster",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the Kubernetes cluster",
+		},
+		"vpc_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the VPC in which the Kubernetes cluster is located",
+		},
+		"ha": {
+			Type:        schema.TypeBool,
+			Description: "whether the Kubernetes cluster runs a highly available control plane",
+		},
+		"auto_upgrade": {
+			Type:        schema.TypeBool,
+			Description: "whether the Kubernetes cluster is upgraded automatically",
+		},
+		"registry_integration": {
+			Type:        schema.TypeBool,
+			Description: "whether the container registry is integrated with the Kubernetes cluster",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the Kubernetes cluster",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the Kubernetes cluster was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the Kubernetes cluster was last updated",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+		"node_pool": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "the node pools of the Kubernetes cluster",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "id of the node pool",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the node pool",
+					},
+					"size": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "the size slug of the node pool's nodes",
+					},
+					"node_count": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "the number of nodes in the node pool",
+					},
+					"auto_scale": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "whether the node pool is scaled automatically",
+					},
+					"min_nodes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "the minimum number of nodes of the node pool when scaled automatically",
+					},
+					"max_nodes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "the maximum number of nodes of the node pool when scaled automatically",
+					},
+					"tags": tag.TagsDataSourceSchema(),
+					"labels": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "the labels applied to the nodes of the node pool",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDigitalOceanKubernetesClusters(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var clusterList []interface{}
+
+	for {
+		clusters, resp, err := client.Kubernetes.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		for _, cluster := range clusters {
+			clusterList = append(clusterList, cluster)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return clusterList, nil
+}
+
+// flattenDigitalOceanKubernetesCluster intentionally omits the cluster's
+// kubeconfig and credentials so that they are not spread across state.
+func flattenDigitalOceanKubernetesCluster(rawCluster, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cluster := rawCluster.(*godo.KubernetesCluster)
+
+	status := ""
+	if cluster.Status != nil {
+		status = string(cluster.Status.State)
+	}
+
+	nodePools := make([]interface{}, 0, len(cluster.NodePools))
+	for _, pool := range cluster.NodePools {
+		nodePools = append(nodePools, map[string]interface{}{
+			"id":         pool.ID,
+			"name":       pool.Name,
+			"size":       pool.Size,
+			"node_count": pool.Count,
+			"auto_scale": pool.AutoScale,
+			"min_nodes":  pool.MinNodes,
+			"max_nodes":  pool.MaxNodes,
+			"tags":       tag.FlattenTags(FilterTags(pool.Tags)),
+			"labels":     flattenLabels(filterSystemLabels(pool.Labels)),
+		})
+	}
+
+	flattenedCluster := map[string]interface{}{
+		"id":                   cluster.ID,
+		"name":                 cluster.Name,
+		"region":               cluster.RegionSlug,
+		"version":              cluster.VersionSlug,
+		"status":               status,
+		"vpc_uuid":             cluster.VPCUUID,
+		"ha":                   cluster.HA,
+		"auto_upgrade":         cluster.AutoUpgrade,
+		"registry_integration": cluster.RegistryEnabled,
+		"urn":                  cluster.URN(),
+		"created_at":           cluster.CreatedAt.UTC().String(),
+		"updated_at":           cluster.UpdatedAt.UTC().String(),
+		"tags":                 tag.FlattenTags(FilterTags(cluster.Tags)),
+		"node_pool":            nodePools,
+	}
+
+	return flattenedCluster, nil
+}
@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetDigitalOceanKubernetesClusters(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != kubernetesClustersPath {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"kubernetes_clusters":[{"id":"cluster-2","name":"bar","region":"nyc3","status":{"state":"provisioning"}}],"links":{},"meta":{"total":2}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"kubernetes_clusters":[{"id":"cluster-1","name":"foo","region":"nyc1","version":"1.31.1-do.0","vpc_uuid":"vpc-1",
+			"tags":["k8s","k8s:cluster-1","production"],"status":{"state":"running"},
+			"node_pools":[{"id":"pool-1","name":"default","size":"s-1vcpu-2gb","count":2,"tags":["k8s","k8s-worker","web"],
+				"labels":{"priority":"high","doks.digitalocean.com/node-pool":"default"}}]}],
+			"links":{"pages":{"next":"%[1]s/v2/kubernetes/clusters?page=2","last":"%[1]s/v2/kubernetes/clusters?page=2"}},"meta":{"total":2}}`, "http://"+r.Host)
+	}))
+
+	clusters, err := getDigitalOceanKubernetesClusters(meta, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(clusters) != 2 {
+		t.Fatalf("expected 2 clusters, got %d", len(clusters))
+	}
+
+	flattened, err := flattenDigitalOceanKubernetesCluster(clusters[0], meta, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"id":       "cluster-1",
+		"name":     "foo",
+		"region":   "nyc1",
+		"version":  "1.31.1-do.0",
+		"status":   "running",
+		"vpc_uuid": "vpc-1",
+	}
+	for key, value := range expected {
+		if flattened[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, flattened[key])
+		}
+	}
+
+	for key := range flattened {
+		if _, ok := kubernetesClusterSchema()[key]; !ok {
+			t.Errorf("unexpected attribute: %s", key)
+		}
+	}
+	if _, ok := flattened["kube_config"]; ok {
+		t.Error("expected the kubeconfig not to be exported")
+	}
+
+	if tags := flattened["tags"].(*schema.Set); tags.Len() != 1 || !tags.Contains("production") {
+		t.Errorf("expected only the production tag, got %v", tags.List())
+	}
+
+	pool := flattened["node_pool"].([]interface{})[0].(map[string]interface{})
+	if labels := pool["labels"].(map[string]interface{}); !reflect.DeepEqual(labels, map[string]interface{}{"priority": "high"}) {
+		t.Errorf("expected the system labels to be filtered, got %v", labels)
+	}
+
+	flattened, err = flattenDigitalOceanKubernetesCluster(clusters[1], meta, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if flattened["status"] != "provisioning" {
+		t.Errorf("expected status provisioning, got %v", flattened["status"])
+	}
+}
@@ -0,0 +1,294 @@
+package kubernetes
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestExpandControlPlaneFirewallOpts(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   []interface{}
+		expected *godo.KubernetesControlPlaneFirewall
+	}{
+		{
+			name: "Enabled",
+			config: []interface{}{map[string]interface{}{
+				"enabled":           true,
+				"allowed_addresses": []interface{}{"1.2.3.4/32", "10.0.0.0/8"},
+			}},
+			expected: &godo.KubernetesControlPlaneFirewall{
+				Enabled:          godo.PtrTo(true),
+				AllowedAddresses: []string{"1.2.3.4/32", "10.0.0.0/8"},
+			},
+		},
+		{
+			name: "NoAddresses",
+			config: []interface{}{map[string]interface{}{
+				"enabled":           false,
+				"allowed_addresses": []interface{}{},
+			}},
+			expected: &godo.KubernetesControlPlaneFirewall{
+				Enabled:          godo.PtrTo(false),
+				AllowedAddresses: []string{},
+			},
+		},
+		{
+			name:   "Removed",
+			config: []interface{}{},
+			expected: &godo.KubernetesControlPlaneFirewall{
+				Enabled:          godo.PtrTo(false),
+				AllowedAddresses: []string{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := expandControlPlaneFirewallOpts(tc.config)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenControlPlaneFirewallOpts(t *testing.T) {
+	enabled := &godo.KubernetesControlPlaneFirewall{
+		Enabled:          godo.PtrTo(true),
+		AllowedAddresses: []string{"1.2.3.4/32"},
+	}
+	disabled := &godo.KubernetesControlPlaneFirewall{
+		Enabled:          godo.PtrTo(false),
+		AllowedAddresses: []string{"1.2.3.4/32"},
+	}
+
+	cases := []struct {
+		name       string
+		fw         *godo.KubernetesControlPlaneFirewall
+		configured bool
+		expected   []map[string]interface{}
+	}{
+		{"Nil", nil, true, []map[string]interface{}{}},
+		{"Enabled", enabled, false, []map[string]interface{}{{"enabled": true, "allowed_addresses": []string{"1.2.3.4/32"}}}},
+		{"DisabledConfigured", disabled, true, []map[string]interface{}{{"enabled": false, "allowed_addresses": []string{"1.2.3.4/32"}}}},
+		{"DisabledNotConfigured", disabled, false, []map[string]interface{}{}},
+		{"NoEnabled", &godo.KubernetesControlPlaneFirewall{}, true, []map[string]interface{}{{"enabled": false, "allowed_addresses": []string{}}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := flattenControlPlaneFirewallOpts(tc.fw, tc.configured)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// newNeverReadyKubernetesTestClient returns a client for an API in which the
+// cluster is never running, the node pool never has running nodes, and the
+// node pool is never deleted.
+func newNeverReadyKubernetesTestClient(t *testing.T) *godo.Client {
+	interval := kubernetesPollInterval
+	t.Cleanup(func() { kubernetesPollInterval = interval })
+	kubernetesPollInterval = 10 * time.Millisecond
+
+	return newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case kubernetesClustersPath + "/cluster-id":
+			fmt.Fprint(w, `{"kubernetes_cluster":{"id":"cluster-id","status":{"state":"provisioning"}}}`)
+		case kubernetesClustersPath + "/cluster-id/node_pools/pool-id":
+			fmt.Fprint(w, `{"node_pool":{"id":"pool-id","count":1,"nodes":[{"id":"node-id","status":{"state":"provisioning"}}]}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})).GodoClient()
+}
+
+func TestFilterSystemTaints(t *testing.T) {
+	taints := []godo.Taint{
+		{Key: "dedicated", Value: "db", Effect: "NoSchedule"},
+		{Key: "node.kubernetes.io/unschedulable", Effect: "NoSchedule"},
+		{Key: "example.com/dedicated", Value: "db", Effect: "NoExecute"},
+		{Key: "doks.digitalocean.com/gpu", Value: "true", Effect: "NoSchedule"},
+		{Key: "notkubernetes.io/dedicated", Value: "db", Effect: "NoSchedule"},
+	}
+
+	expectedFiltered := []godo.Taint{taints[0], taints[2], taints[4]}
+	if filtered := filterSystemTaints(taints); !reflect.DeepEqual(filtered, expectedFiltered) {
+		t.Errorf("expected %+v, got %+v", expectedFiltered, filtered)
+	}
+
+	expectedSystem := []godo.Taint{taints[1], taints[3]}
+	if system := systemTaints(taints); !reflect.DeepEqual(system, expectedSystem) {
+		t.Errorf("expected %+v, got %+v", expectedSystem, system)
+	}
+
+	if filtered := filterSystemTaints(nil); filtered == nil || len(filtered) != 0 {
+		t.Errorf("expected an empty list, got %+v", filtered)
+	}
+}
+
+func TestValidateNodePoolTaintKey(t *testing.T) {
+	cases := []struct {
+		key   string
+		valid bool
+	}{
+		{"dedicated", true},
+		{"example.com/dedicated", true},
+		{"kubernetes.io/dedicated", false},
+		{"node.kubernetes.io/unschedulable", false},
+		{"node.k8s.io/dedicated", false},
+		{"doks.digitalocean.com/gpu", false},
+	}
+
+	for _, c := range cases {
+		_, errs := validateNodePoolTaintKey(c.key, "key")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("%s: expected valid to be %t, got errors: %v", c.key, c.valid, errs)
+		}
+	}
+}
+
+func TestValidateNodePoolLabels(t *testing.T) {
+	cases := []struct {
+		key   string
+		valid bool
+	}{
+		{"priority", true},
+		{"example.com/priority", true},
+		{"digitalocean.com/priority", false},
+		{"doks.digitalocean.com/node-pool", false},
+		{"notdigitalocean.com/priority", true},
+	}
+
+	for _, c := range cases {
+		_, errs := validateNodePoolLabels(map[string]interface{}{c.key: "high"}, "labels")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("%s: expected valid to be %t, got errors: %v", c.key, c.valid, errs)
+		}
+	}
+}
+
+func TestValidateKubernetesDuration(t *testing.T) {
+	for _, v := range []string{"10m", "1h30m", "90s"} {
+		if _, errs := validateKubernetesDuration(v, "scale_down_unneeded_time"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "10", "ten minutes", "-1m", "0s"} {
+		if _, errs := validateKubernetesDuration(v, "scale_down_unneeded_time"); len(errs) != 1 {
+			t.Errorf("expected a single error for %q, got %v", v, errs)
+		}
+	}
+}
+
+func TestSuppressEquivalentKubernetesDuration(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"10m0s", "10m", true},
+		{"1h0m0s", "60m", true},
+		{"10m", "11m", false},
+		{"", "10m", false},
+	}
+
+	for _, tc := range cases {
+		if actual := suppressEquivalentKubernetesDuration("", tc.old, tc.new, nil); actual != tc.expected {
+			t.Errorf("expected %t comparing %q and %q, got %t", tc.expected, tc.old, tc.new, actual)
+		}
+	}
+}
+
+func TestCountRunningNodes(t *testing.T) {
+	cases := []struct {
+		name     string
+		nodes    []*godo.KubernetesNode
+		expected int
+	}{
+		{
+			name:     "NoNodes",
+			expected: 0,
+		},
+		{
+			name: "Mixed",
+			nodes: []*godo.KubernetesNode{
+				{ID: "node-1", Status: &godo.KubernetesNodeStatus{State: "running"}},
+				{ID: "node-2", Status: &godo.KubernetesNodeStatus{State: "provisioning"}},
+				{ID: "node-3", Status: &godo.KubernetesNodeStatus{State: "running"}},
+				{ID: "node-4"},
+			},
+			expected: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := countRunningNodes(tc.nodes); actual != tc.expected {
+				t.Errorf("expected %d running nodes, got %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLastNodeCreatedAt(t *testing.T) {
+	cases := []struct {
+		name     string
+		nodes    []*godo.KubernetesNode
+		expected string
+	}{
+		{
+			name:     "NoNodes",
+			expected: "",
+		},
+		{
+			name: "Newest",
+			nodes: []*godo.KubernetesNode{
+				{ID: "node-1", CreatedAt: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)},
+				{ID: "node-2", CreatedAt: time.Date(2024, 6, 3, 8, 30, 0, 0, time.FixedZone("EST", -5*60*60))},
+				{ID: "node-3", CreatedAt: time.Date(2024, 6, 2, 10, 0, 0, 0, time.UTC)},
+			},
+			expected: "2024-06-03 13:30:00 +0000 UTC",
+		},
+		{
+			// Nodes without a creation time are ignored.
+			name:     "NotCreated",
+			nodes:    []*godo.KubernetesNode{{ID: "node-1"}},
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := lastNodeCreatedAt(tc.nodes); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpc"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -84,6 +86,8 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"vpc_name": vpc.VPCNameSchema("vpc_uuid"),
+
 			"cluster_subnet": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -132,6 +136,32 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"control_plane_firewall": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"allowed_addresses": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+					},
+				},
+			},
+
+			"cluster_autoscaler_configuration": clusterAutoscalerConfigurationSchema(),
+
+			"routing_agent": routingAgentSchema(),
+
 			"node_pool": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -159,6 +189,8 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"kubeconfig_expire_seconds": kubeconfigExpireSecondsSchema(),
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -174,10 +206,20 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"additional_options": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAdditionalOptions,
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -199,10 +241,34 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				}
 				return false
 			}),
+			customdiff.ValidateChange("ha", validateKubernetesClusterHAChange),
+			vpc.CustomizeDiffVPCName("vpc_uuid"),
+			// New credentials are fetched when their expiry is changed.
+			customdiff.ComputedIf("kube_config", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("kubeconfig_expire_seconds")
+			}),
 		),
 	}
 }
 
+// validateKubernetesClusterHAChange returns an error if ha is disabled on an
+// existing cluster, as the control plane of a cluster can be made highly
+// available in place, but not the other way around.
+func validateKubernetesClusterHAChange(ctx context.Context, old, new, meta interface{}) error {
+	if old.(bool) && !new.(bool) {
+		return fmt.Errorf("ha can not be disabled on an existing Kubernetes cluster, the cluster must be replaced instead")
+	}
+	return nil
+}
+
+func kubeconfigExpireSecondsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
 func kubernetesConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:      schema.TypeList,
@@ -252,21 +318,10 @@ func kubernetesConfigSchema() *schema.Schema {
 func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	pools := expandNodePools(d.Get("node_pool").([]interface{}))
+	pools := d.Get("node_pool").([]interface{})
 	poolCreateRequests := make([]*godo.KubernetesNodePoolCreateRequest, len(pools))
 	for i, pool := range pools {
-		tags := append(pool.Tags, DigitaloceanKubernetesDefaultNodePoolTag)
-		poolCreateRequests[i] = &godo.KubernetesNodePoolCreateRequest{
-			Name:      pool.Name,
-			Size:      pool.Size,
-			Tags:      tags,
-			Labels:    pool.Labels,
-			Count:     pool.Count,
-			AutoScale: pool.AutoScale,
-			MinNodes:  pool.MinNodes,
-			MaxNodes:  pool.MaxNodes,
-			Taints:    pool.Taints,
-		}
+		poolCreateRequests[i] = expandNodePoolCreateRequest(pool.(map[string]interface{}), DigitaloceanKubernetesDefaultNodePoolTag)
 	}
 
 	opts := &godo.KubernetesClusterCreateRequest{
@@ -287,15 +342,29 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 		opts.MaintenancePolicy = maintPolicy
 	}
 
-	if vpc, ok := d.GetOk("vpc_uuid"); ok {
-		opts.VPCUUID = vpc.(string)
+	if fw, ok := d.GetOk("control_plane_firewall"); ok {
+		opts.ControlPlaneFirewall = expandControlPlaneFirewallOpts(fw.([]interface{}))
+	}
+
+	vpcID, err := vpc.ExpandVPCID(ctx, d, meta, "vpc_uuid")
+	if err != nil {
+		return diag.Errorf("Error resolving vpc_name: %s", err)
 	}
+	opts.VPCUUID = vpcID
 
 	if autoUpgrade, ok := d.GetOk("auto_upgrade"); ok {
 		opts.AutoUpgrade = autoUpgrade.(bool)
 	}
 
-	cluster, _, err := client.Kubernetes.Create(context.Background(), opts)
+	var cluster *godo.KubernetesCluster
+	options := d.Get("additional_options").(map[string]interface{})
+	autoscaler := expandClusterAutoscalerConfiguration(d.Get("cluster_autoscaler_configuration").([]interface{}))
+	routingAgent := expandRoutingAgent(d.Get("routing_agent").([]interface{}))
+	if len(options) > 0 || autoscaler != nil || routingAgent != nil {
+		cluster, _, err = createKubernetesClusterWithOptions(ctx, client, opts, options, autoscaler, routingAgent)
+	} else {
+		cluster, _, err = client.Kubernetes.Create(ctx, opts)
+	}
 	if err != nil {
 		return diag.Errorf("Error creating Kubernetes cluster: %s", err)
 	}
@@ -304,14 +373,14 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 	d.SetId(cluster.ID)
 
 	// wait for completion
-	_, err = waitForKubernetesClusterCreate(client, d)
+	_, err = waitForKubernetesClusterCreate(ctx, client, d)
 	if err != nil {
 		d.SetId("")
 		return diag.Errorf("Error creating Kubernetes cluster: %s", err)
 	}
 
 	if d.Get("registry_integration") == true {
-		err = enableRegistryIntegration(client, cluster.ID)
+		err = enableRegistryIntegration(ctx, client, cluster.ID)
 		if err != nil {
 			return diag.Errorf("Error enabling registry integration: %s", err)
 		}
@@ -323,7 +392,7 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	cluster, resp, err := client.Kubernetes.Get(context.Background(), d.Id())
+	cluster, raw, resp, err := getKubernetesClusterRaw(context.Background(), client, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -333,6 +402,20 @@ func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
+	d.Set("additional_options", flattenAdditionalOptions(raw, d.Get("additional_options").(map[string]interface{})))
+
+	autoscaler, err := flattenClusterAutoscalerConfiguration(raw[clusterAutoscalerConfigurationKey])
+	if err != nil {
+		return diag.Errorf("Error reading Kubernetes cluster autoscaler configuration: %s", err)
+	}
+	if err := d.Set("cluster_autoscaler_configuration", autoscaler); err != nil {
+		return diag.Errorf("[DEBUG] Error setting cluster_autoscaler_configuration - error: %#v", err)
+	}
+
+	if err := setKubernetesClusterRoutingAgent(d, raw); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return digitaloceanKubernetesClusterRead(client, cluster, d)
 }
 
@@ -356,12 +439,18 @@ func digitaloceanKubernetesClusterRead(
 	d.Set("updated_at", cluster.UpdatedAt.UTC().String())
 	d.Set("vpc_uuid", cluster.VPCUUID)
 	d.Set("auto_upgrade", cluster.AutoUpgrade)
+	d.Set("registry_integration", cluster.RegistryEnabled)
 	d.Set("urn", cluster.URN())
 
 	if err := d.Set("maintenance_policy", flattenMaintPolicyOpts(cluster.MaintenancePolicy)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting maintenance_policy - error: %#v", err)
 	}
 
+	configured := len(d.Get("control_plane_firewall").([]interface{})) > 0
+	if err := d.Set("control_plane_firewall", flattenControlPlaneFirewallOpts(cluster.ControlPlaneFirewall, configured)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting control_plane_firewall - error: %#v", err)
+	}
+
 	// find the default node pool from all the pools in the cluster
 	// the default node pool has a custom tag terraform:default-node-pool
 	foundDefaultNodePool := false
@@ -385,7 +474,8 @@ func digitaloceanKubernetesClusterRead(
 		log.Printf("[WARN] No default node pool was found. The default node pool must have the `%s` tag if created with Terraform.", DigitaloceanKubernetesDefaultNodePoolTag)
 	}
 
-	// fetch cluster credentials and update the resource if the credentials are expired.
+	// fetch cluster credentials and update the resource if the credentials are
+	// missing, expired, or about to expire.
 	var creds map[string]interface{}
 	if d.Get("kube_config") != nil && len(d.Get("kube_config").([]interface{})) > 0 {
 		creds = d.Get("kube_config").([]interface{})[0].(map[string]interface{})
@@ -398,8 +488,8 @@ func digitaloceanKubernetesClusterRead(
 			return diag.Errorf("Unable to parse Kubernetes credentials expiry: %s", err)
 		}
 	}
-	if expiresAt.IsZero() || expiresAt.Before(time.Now()) {
-		creds, _, err := client.Kubernetes.GetCredentials(context.Background(), cluster.ID, &godo.KubernetesClusterCredentialsGetRequest{})
+	if kubernetesCredentialsExpiring(expiresAt, time.Now()) {
+		creds, _, err := client.Kubernetes.GetCredentials(context.Background(), cluster.ID, expandCredentialsGetRequest(d))
 		if err != nil {
 			return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
 		}
@@ -412,15 +502,23 @@ func digitaloceanKubernetesClusterRead(
 func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if err := vpc.CheckVPCNameChange(ctx, d, meta, "vpc_uuid"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Figure out the changes and then call the appropriate API methods
-	if d.HasChanges("name", "tags", "auto_upgrade", "surge_upgrade", "maintenance_policy", "ha") {
+	if d.HasChanges("name", "tags", "auto_upgrade", "surge_upgrade", "maintenance_policy", "ha", "control_plane_firewall", "cluster_autoscaler_configuration", "routing_agent") {
 
 		opts := &godo.KubernetesClusterUpdateRequest{
 			Name:         d.Get("name").(string),
 			Tags:         tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
 			AutoUpgrade:  godo.PtrTo(d.Get("auto_upgrade").(bool)),
 			SurgeUpgrade: d.Get("surge_upgrade").(bool),
-			HA:           godo.PtrTo(d.Get("ha").(bool)),
+		}
+
+		// HA can only be enabled, which is checked when planning.
+		if d.HasChange("ha") {
+			opts.HA = godo.PtrTo(d.Get("ha").(bool))
 		}
 
 		if maint, ok := d.GetOk("maintenance_policy"); ok {
@@ -431,7 +529,21 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 			opts.MaintenancePolicy = maintPolicy
 		}
 
-		_, resp, err := client.Kubernetes.Update(context.Background(), d.Id(), opts)
+		if d.HasChange("control_plane_firewall") {
+			opts.ControlPlaneFirewall = expandControlPlaneFirewallOpts(d.Get("control_plane_firewall").([]interface{}))
+		}
+
+		var autoscaler *kubernetesClusterAutoscalerConfiguration
+		if d.HasChange("cluster_autoscaler_configuration") {
+			autoscaler = expandClusterAutoscalerConfiguration(d.Get("cluster_autoscaler_configuration").([]interface{}))
+		}
+
+		var routingAgent *kubernetesRoutingAgent
+		if d.HasChange("routing_agent") {
+			routingAgent = expandRoutingAgent(d.Get("routing_agent").([]interface{}))
+		}
+
+		_, resp, err := updateKubernetesCluster(ctx, client, d.Id(), opts, autoscaler, routingAgent)
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				d.SetId("")
@@ -454,8 +566,8 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 		}
 
 		// update the existing default pool
-		timeout := d.Timeout(schema.TimeoutCreate)
-		_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		_, err := digitaloceanKubernetesNodePoolUpdate(ctx, client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -466,20 +578,25 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 			VersionSlug: d.Get("version").(string),
 		}
 
-		_, err := client.Kubernetes.Upgrade(context.Background(), d.Id(), opts)
+		_, err := client.Kubernetes.Upgrade(ctx, d.Id(), opts)
 		if err != nil {
 			return diag.Errorf("Unable to upgrade cluster version: %s", err)
 		}
 	}
 
+	// Drop the credentials so that they are fetched again with the new expiry.
+	if d.HasChange("kubeconfig_expire_seconds") {
+		d.Set("kube_config", nil)
+	}
+
 	if d.HasChanges("registry_integration") {
 		if d.Get("registry_integration") == true {
-			err := enableRegistryIntegration(client, d.Id())
+			err := enableRegistryIntegration(ctx, client, d.Id())
 			if err != nil {
 				return diag.Errorf("Error enabling registry integration: %s", err)
 			}
 		} else {
-			err := disableRegistryIntegration(client, d.Id())
+			err := disableRegistryIntegration(ctx, client, d.Id())
 			if err != nil {
 				return diag.Errorf("Error disabling registry integration: %s", err)
 			}
@@ -588,27 +705,37 @@ func resourceDigitalOceanKubernetesClusterImportState(ctx context.Context, d *sc
 	return []*schema.ResourceData{d}, nil
 }
 
-func enableRegistryIntegration(client *godo.Client, clusterUUID string) error {
-	_, err := client.Kubernetes.AddRegistry(context.Background(), &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
+// enableRegistryIntegration adds the credentials of the container registry of
+// the account to the cluster. As the API error does not say so, the registry
+// is looked up on failure to report a missing registry clearly.
+func enableRegistryIntegration(ctx context.Context, client *godo.Client, clusterUUID string) error {
+	_, err := client.Kubernetes.AddRegistry(ctx, &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
+	if err == nil {
+		return nil
+	}
+
+	if _, resp, registryErr := client.Registry.Get(ctx); registryErr != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("the account has no container registry, create one, e.g. using the digitalocean_container_registry resource, before enabling the integration")
+	}
+
 	return err
 }
 
-func disableRegistryIntegration(client *godo.Client, clusterUUID string) error {
-	_, err := client.Kubernetes.RemoveRegistry(context.Background(), &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
+func disableRegistryIntegration(ctx context.Context, client *godo.Client, clusterUUID string) error {
+	_, err := client.Kubernetes.RemoveRegistry(ctx, &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
 	return err
 }
 
-func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
+func waitForKubernetesClusterCreate(ctx context.Context, client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
 	var (
-		tickerInterval = 10 * time.Second
 		timeoutSeconds = d.Timeout(schema.TimeoutCreate).Seconds()
-		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		timeout        = int(timeoutSeconds / kubernetesPollInterval.Seconds())
 		n              = 0
-		ticker         = time.NewTicker(tickerInterval)
+		ticker         = time.NewTicker(kubernetesPollInterval)
 	)
 
 	for range ticker.C {
-		cluster, _, err := client.Kubernetes.Get(context.Background(), d.Id())
+		cluster, _, err := client.Kubernetes.Get(ctx, d.Id())
 		if err != nil {
 			ticker.Stop()
 			return nil, fmt.Errorf("Error trying to read cluster state: %s", err)
@@ -673,6 +800,25 @@ type kubernetesConfigUserData struct {
 	Token                 string `yaml:"token"`
 }
 
+// kubernetesCredentialsRenewalThreshold is how long before they expire the
+// credentials of a cluster are fetched again when reading it, so that the
+// state does not hold credentials which are about to stop working.
+const kubernetesCredentialsRenewalThreshold = 5 * time.Minute
+
+// kubernetesCredentialsExpiring returns whether credentials expiring at the
+// given time, which is zero if there are none, need to be fetched again.
+func kubernetesCredentialsExpiring(expiresAt time.Time, now time.Time) bool {
+	return expiresAt.IsZero() || !now.Add(kubernetesCredentialsRenewalThreshold).Before(expiresAt)
+}
+
+func expandCredentialsGetRequest(d *schema.ResourceData) *godo.KubernetesClusterCredentialsGetRequest {
+	req := &godo.KubernetesClusterCredentialsGetRequest{}
+	if v, ok := d.GetOk("kubeconfig_expire_seconds"); ok {
+		req.ExpirySeconds = godo.PtrTo(v.(int))
+	}
+	return req
+}
+
 func flattenCredentials(name string, region string, creds *godo.KubernetesClusterCredentials) []interface{} {
 	if creds == nil {
 		return nil
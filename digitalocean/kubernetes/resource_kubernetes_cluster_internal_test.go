@@ -0,0 +1,276 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestControlPlaneFirewallValidation(t *testing.T) {
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foo",
+		"region":  "nyc1",
+		"version": "1.31.1-do.0",
+		"node_pool": []interface{}{map[string]interface{}{
+			"name":       "default",
+			"size":       "s-1vcpu-2gb",
+			"node_count": 1,
+		}},
+		"control_plane_firewall": []interface{}{map[string]interface{}{
+			"enabled":           true,
+			"allowed_addresses": []interface{}{"10.0.0.0/8", "1.2.3.4"},
+		}},
+	})
+
+	diags := ResourceDigitalOceanKubernetesCluster().Validate(cfg)
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "CIDR") {
+		t.Fatalf("expected a single CIDR validation error, got %+v", diags)
+	}
+}
+
+func TestKubernetesClusterTimeoutsDefaults(t *testing.T) {
+	expected := map[string]time.Duration{
+		schema.TimeoutCreate: 30 * time.Minute,
+		schema.TimeoutUpdate: 30 * time.Minute,
+		schema.TimeoutDelete: 20 * time.Minute,
+	}
+
+	d := ResourceDigitalOceanKubernetesCluster().Data(nil)
+	for key, timeout := range expected {
+		if got := d.Timeout(key); got != timeout {
+			t.Errorf("%s: expected %s, got %s", key, timeout, got)
+		}
+	}
+}
+
+func TestKubernetesClusterTimeoutsHonored(t *testing.T) {
+	client := newNeverReadyKubernetesTestClient(t)
+
+	cluster := ResourceDigitalOceanKubernetesCluster()
+	cluster.Timeouts = &schema.ResourceTimeout{Create: godo.PtrTo(100 * time.Millisecond)}
+	d := cluster.Data(&terraform.InstanceState{ID: "cluster-id"})
+
+	start := time.Now()
+	_, err := waitForKubernetesClusterCreate(context.Background(), client, d)
+	if err == nil || err.Error() != "Timeout waiting to create cluster" {
+		t.Fatalf("expected %q, got: %v", "Timeout waiting to create cluster", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the configured timeout to be honored, waited %s", elapsed)
+	}
+}
+
+func TestKubernetesCredentialsExpiring(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name      string
+		expiresAt time.Time
+		expected  bool
+	}{
+		{name: "none", expiresAt: time.Time{}, expected: true},
+		{name: "expired", expiresAt: now.Add(-time.Minute), expected: true},
+		{name: "within threshold", expiresAt: now.Add(kubernetesCredentialsRenewalThreshold - time.Second), expected: true},
+		{name: "valid", expiresAt: now.Add(time.Hour), expected: false},
+	}
+
+	for _, c := range cases {
+		if expiring := kubernetesCredentialsExpiring(c.expiresAt, now); expiring != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, expiring)
+		}
+	}
+}
+
+func TestKubernetesClusterHAChange(t *testing.T) {
+	cases := []struct {
+		name        string
+		old         string
+		new         bool
+		expectError bool
+	}{
+		{name: "Enable", old: "false", new: true},
+		{name: "Unchanged", old: "true", new: true},
+		{name: "Disable", old: "true", new: false, expectError: true},
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "cluster-id",
+				Attributes: map[string]string{
+					"name":                   "foo",
+					"region":                 "nyc1",
+					"version":                "1.30.1-do.0",
+					"ha":                     c.old,
+					"surge_upgrade":          "true",
+					"node_pool.#":            "1",
+					"node_pool.0.name":       "default",
+					"node_pool.0.size":       "s-1vcpu-2gb",
+					"node_pool.0.node_count": "1",
+				},
+			}
+			cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":    "foo",
+				"region":  "nyc1",
+				"version": "1.30.1-do.0",
+				"ha":      c.new,
+				"node_pool": []interface{}{map[string]interface{}{
+					"name":       "default",
+					"size":       "s-1vcpu-2gb",
+					"node_count": 1,
+				}},
+			})
+
+			diff, err := r.Diff(context.Background(), state, cfg, nil)
+			if c.expectError {
+				if err == nil || !strings.Contains(err.Error(), "ha can not be disabled") {
+					t.Fatalf("expected an error disabling ha, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff != nil && diff.RequiresNew() {
+				t.Errorf("expected ha to be updated in place, got diff: %#v", diff)
+			}
+		})
+	}
+}
+
+func TestDigitaloceanKubernetesClusterReadCredentials(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	cases := []struct {
+		name           string
+		raw            map[string]interface{}
+		storedExpiry   time.Time
+		expectedQuery  string
+		expectedFetch  bool
+		expectedExpiry time.Time
+	}{
+		{
+			name:           "default expiry",
+			raw:            map[string]interface{}{"name": "foo"},
+			expectedQuery:  "",
+			expectedFetch:  true,
+			expectedExpiry: expiresAt,
+		},
+		{
+			name:           "configured expiry",
+			raw:            map[string]interface{}{"name": "foo", "kubeconfig_expire_seconds": 3600},
+			expectedQuery:  "expiry_seconds=3600",
+			expectedFetch:  true,
+			expectedExpiry: expiresAt,
+		},
+		{
+			name:           "about to expire",
+			raw:            map[string]interface{}{"name": "foo"},
+			storedExpiry:   time.Now().Add(time.Minute).UTC().Truncate(time.Second),
+			expectedFetch:  true,
+			expectedExpiry: expiresAt,
+		},
+		{
+			name:           "still valid",
+			raw:            map[string]interface{}{"name": "foo"},
+			storedExpiry:   time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second),
+			expectedFetch:  false,
+			expectedExpiry: time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fetched := false
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != kubernetesClustersPath+"/cluster-id/credentials" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				fetched = true
+				if r.URL.RawQuery != c.expectedQuery {
+					t.Errorf("expected query %q, got %q", c.expectedQuery, r.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"server":"https://cluster-id.k8s.ondigitalocean.com","token":"token","expires_at":%q}`, expiresAt.Format(time.RFC3339))
+			})).GodoClient()
+
+			d := DataSourceDigitalOceanKubernetesCluster().TestResourceData()
+			for k, v := range c.raw {
+				d.Set(k, v)
+			}
+			if !c.storedExpiry.IsZero() {
+				d.Set("kube_config", []interface{}{map[string]interface{}{"expires_at": c.storedExpiry.Format(time.RFC3339)}})
+			}
+
+			cluster := &godo.KubernetesCluster{ID: "cluster-id", Name: "foo", RegionSlug: "nyc3", Status: &godo.KubernetesClusterStatus{}, MaintenancePolicy: &godo.KubernetesMaintenancePolicy{}}
+			if diags := digitaloceanKubernetesClusterRead(client, cluster, d); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if fetched != c.expectedFetch {
+				t.Errorf("expected the credentials to be fetched to be %t, got %t", c.expectedFetch, fetched)
+			}
+			if got := d.Get("kube_config.0.expires_at").(string); got != c.expectedExpiry.Format(time.RFC3339) {
+				t.Errorf("expected expires_at %q, got %q", c.expectedExpiry.Format(time.RFC3339), got)
+			}
+		})
+	}
+}
+
+func TestEnableRegistryIntegration(t *testing.T) {
+	cases := []struct {
+		name             string
+		registry         bool
+		added            bool
+		expectedErrorMsg string
+	}{
+		{name: "added", registry: true, added: true},
+		{name: "no registry", registry: false, added: false, expectedErrorMsg: "the account has no container registry"},
+		{name: "other error", registry: true, added: false, expectedErrorMsg: "cluster is not running"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/kubernetes/registry":
+					if c.added {
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprint(w, `{"id":"unprocessable_entity","message":"cluster is not running"}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/registry":
+					if !c.registry {
+						w.WriteHeader(http.StatusNotFound)
+						fmt.Fprint(w, `{"id":"not_found","message":"registry not found"}`)
+						return
+					}
+					fmt.Fprint(w, `{"registry":{"name":"example"}}`)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			})).GodoClient()
+
+			err := enableRegistryIntegration(context.Background(), client, "cluster-id")
+			if c.expectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+				t.Errorf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+}
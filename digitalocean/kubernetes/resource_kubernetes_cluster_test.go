@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ data "digitalocean_kubernetes_versions" "test" {
 func TestAccDigitalOceanKubernetesCluster_Basic(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 	expectedURNRegEx, _ := regexp.Compile(`do:kubernetes:[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12}`)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,11 +51,11 @@ func TestAccDigitalOceanKubernetesCluster_Basic(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
-					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", region),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "surge_upgrade", "true"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "ha", "false"),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
@@ -70,6 +72,8 @@ func TestAccDigitalOceanKubernetesCluster_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.size", "s-1vcpu-2gb"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.node_count", "1"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.actual_node_count", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.running_node_count", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "node_pool.0.last_node_created_at"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.tags.#", "2"),
 					resource.TestCheckTypeSetElemAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.tags.*", "one"),
 					resource.TestCheckTypeSetElemAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.tags.*", "two"),
@@ -102,7 +106,7 @@ func TestAccDigitalOceanKubernetesCluster_Basic(t *testing.T) {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name          = "%s"
-  region        = "lon1"
+  region        = "%s"
   version       = data.digitalocean_kubernetes_versions.test.latest_version
   surge_upgrade = true
   tags          = ["foo", "bar", "one"]
@@ -116,7 +120,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
       priority = "high"
     }
   }
-}`, testClusterVersionLatest, rName),
+}`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "tags.#", "3"),
@@ -133,6 +137,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 func TestAccDigitalOceanKubernetesCluster_CreateWithHAControlPlane(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "ha_doks")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -144,7 +149,7 @@ func TestAccDigitalOceanKubernetesCluster_CreateWithHAControlPlane(t *testing.T)
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "nyc1"
+  region  = "%s"
   ha      = true
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
@@ -154,11 +159,11 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
-					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", region),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "ha", "true"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "ipv4_address", ""),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
@@ -172,16 +177,52 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_EnableHAControlPlane(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var before, after godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "ha_doks")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigHA(testClusterVersionLatest, rName, region, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &before),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "ha", "false"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "surge_upgrade", "false"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigHA(testClusterVersionLatest, rName, region, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &after),
+					testAccCheckDigitalOceanKubernetesClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "ha", "true"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "surge_upgrade", "true"),
+				),
+			},
+			{
+				Config:      testAccDigitalOceanKubernetesConfigHA(testClusterVersionLatest, rName, region, false, true),
+				ExpectError: regexp.MustCompile("ha can not be disabled on an existing Kubernetes cluster"),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_CreateWithRegistry(t *testing.T) {
+	region := acceptance.TestAccRegion(t, "kubernetes", "registry")
 	var (
 		rName          = acceptance.RandomTestName()
 		k8s            godo.KubernetesCluster
 		registryConfig = fmt.Sprintf(`
 resource "digitalocean_container_registry" "foobar" {
   name                   = "%s"
-  region                 = "nyc3"
+  region                 = "%s"
   subscription_tier_slug = "starter"
-}`, rName)
+}`, rName, region)
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -197,7 +238,7 @@ resource "digitalocean_container_registry" "foobar" {
 					resource.TestCheckResourceAttr("digitalocean_container_registry.foobar", "endpoint", "registry.digitalocean.com/"+rName),
 					resource.TestCheckResourceAttr("digitalocean_container_registry.foobar", "server_url", "registry.digitalocean.com"),
 					resource.TestCheckResourceAttr("digitalocean_container_registry.foobar", "subscription_tier_slug", "starter"),
-					resource.TestCheckResourceAttr("digitalocean_container_registry.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttr("digitalocean_container_registry.foobar", "region", region),
 					resource.TestCheckResourceAttrSet("digitalocean_container_registry.foobar", "created_at"),
 					resource.TestCheckResourceAttrSet("digitalocean_container_registry.foobar", "storage_usage_bytes"),
 				),
@@ -210,7 +251,7 @@ resource "digitalocean_container_registry" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name                 = "%s"
-  region               = "nyc3"
+  region               = "%s"
   registry_integration = true
   version              = data.digitalocean_kubernetes_versions.test.latest_version
 
@@ -220,11 +261,11 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-				`, testClusterVersionLatest, registryConfig, rName),
+				`, testClusterVersionLatest, registryConfig, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
-					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "region", region),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "registry_integration", "true"),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
 					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "status"),
@@ -241,7 +282,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "nyc3"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -250,7 +291,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-				`, testClusterVersionLatest, registryConfig, rName),
+				`, testClusterVersionLatest, registryConfig, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "registry_integration", "false"),
@@ -264,7 +305,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name                 = "%s"
-  region               = "nyc3"
+  region               = "%s"
   version              = data.digitalocean_kubernetes_versions.test.latest_version
   registry_integration = true
 
@@ -274,7 +315,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-				`, testClusterVersionLatest, registryConfig, rName),
+				`, testClusterVersionLatest, registryConfig, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "registry_integration", "true"),
@@ -287,6 +328,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 func TestAccDigitalOceanKubernetesCluster_UpdateCluster(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -294,7 +336,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdateCluster(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -302,7 +344,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdateCluster(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic4(testClusterVersionLatest, rName+"-updated"),
+				Config: testAccDigitalOceanKubernetesConfigBasic4(testClusterVersionLatest, rName+"-updated", region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName+"-updated"),
@@ -321,6 +363,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdateCluster(t *testing.T) {
 func TestAccDigitalOceanKubernetesCluster_MaintenancePolicy(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	policy := `
 	maintenance_policy {
@@ -342,7 +385,7 @@ func TestAccDigitalOceanKubernetesCluster_MaintenancePolicy(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, policy),
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, policy, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -352,7 +395,7 @@ func TestAccDigitalOceanKubernetesCluster_MaintenancePolicy(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, updatedPolicy),
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, updatedPolicy, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -365,9 +408,169 @@ func TestAccDigitalOceanKubernetesCluster_MaintenancePolicy(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_ControlPlaneFirewall(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
+
+	firewall := `
+	control_plane_firewall {
+		enabled           = true
+		allowed_addresses = ["1.2.3.4/32", "10.0.0.0/8"]
+	}
+`
+
+	updatedFirewall := `
+	control_plane_firewall {
+		enabled           = true
+		allowed_addresses = ["172.16.0.0/12"]
+	}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, firewall, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.enabled", "true"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.0", "1.2.3.4/32"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.1", "10.0.0.0/8"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, updatedFirewall, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.enabled", "true"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.0.allowed_addresses.0", "172.16.0.0/12"),
+				),
+			},
+			{
+				// Removing the block disables the firewall.
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, "", region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "control_plane_firewall.#", "0"),
+					func(s *terraform.State) error {
+						if fw := k8s.ControlPlaneFirewall; fw != nil && fw.Enabled != nil && *fw.Enabled {
+							return fmt.Errorf("expected the control plane firewall to be disabled")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesCluster_ClusterAutoscalerConfiguration(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
+
+	autoscaler := `
+	cluster_autoscaler_configuration {
+		scale_down_utilization_threshold = 0.5
+		scale_down_unneeded_time         = "10m"
+	}
+`
+
+	updatedAutoscaler := `
+	cluster_autoscaler_configuration {
+		scale_down_utilization_threshold = 0.65
+		scale_down_unneeded_time         = "2m"
+		expanders                        = ["priority"]
+	}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, autoscaler, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "cluster_autoscaler_configuration.0.scale_down_utilization_threshold", "0.5"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "cluster_autoscaler_configuration.0.scale_down_unneeded_time"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, updatedAutoscaler, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "cluster_autoscaler_configuration.0.scale_down_utilization_threshold", "0.65"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "cluster_autoscaler_configuration.0.expanders.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "cluster_autoscaler_configuration.0.expanders.0", "priority"),
+				),
+			},
+			{
+				// Removing the block keeps the current configuration without a diff.
+				Config:   testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, "", region),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesCluster_RoutingAgent(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s, k8sUpdated godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
+
+	routingAgent := `
+	routing_agent {
+		enabled = true
+	}
+`
+
+	disabledRoutingAgent := `
+	routing_agent {
+		enabled = false
+	}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, routingAgent, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "routing_agent.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, disabledRoutingAgent, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8sUpdated),
+					testAccCheckDigitalOceanKubernetesClusterNotRecreated(&k8s, &k8sUpdated),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "routing_agent.0.enabled", "false"),
+				),
+			},
+			{
+				// Removing the block keeps the current setting without a diff.
+				Config:   testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersionLatest, rName, "", region),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_UpdatePoolDetails(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -375,7 +578,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolDetails(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -386,7 +589,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolDetails(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic2(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic2(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -404,9 +607,65 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolDetails(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_UpdatePoolTaints(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var poolID string
+	region := acceptance.TestAccRegion(t, "kubernetes")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.taint.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_kubernetes_cluster.foobar", "node_pool.0.taint.*", map[string]string{
+						"key":    "key1",
+						"value":  "val1",
+						"effect": "PreferNoSchedule",
+					}),
+					func(s *terraform.State) error {
+						poolID = s.RootModule().Resources["digitalocean_kubernetes_cluster.foobar"].Primary.Attributes["node_pool.0.id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigPoolTaints(testClusterVersionLatest, rName, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.taint.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_kubernetes_cluster.foobar", "node_pool.0.taint.*", map[string]string{
+						"key":    "key1",
+						"value":  "val2",
+						"effect": "NoSchedule",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_kubernetes_cluster.foobar", "node_pool.0.taint.*", map[string]string{
+						"key":    "key2",
+						"value":  "val2",
+						"effect": "NoExecute",
+					}),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["digitalocean_kubernetes_cluster.foobar"].Primary.Attributes["node_pool.0.id"]
+						if id != poolID {
+							return fmt.Errorf("expected the default node pool to be updated in place, but its ID changed from %s to %s", poolID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_UpdatePoolSize(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -414,7 +673,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolSize(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -425,7 +684,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolSize(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic3(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic3(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -442,6 +701,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolSize(t *testing.T) {
 func TestAccDigitalOceanKubernetesCluster_CreatePoolWithAutoScale(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -454,7 +714,7 @@ func TestAccDigitalOceanKubernetesCluster_CreatePoolWithAutoScale(t *testing.T)
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name         = "%s"
-  region       = "lon1"
+  region       = "%s"
   version      = data.digitalocean_kubernetes_versions.test.latest_version
   auto_upgrade = true
 
@@ -471,7 +731,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     day        = "sunday"
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -493,7 +753,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -504,7 +764,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     max_nodes  = 3
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -523,7 +783,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -535,7 +795,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     max_nodes  = 3
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -554,7 +814,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -563,7 +823,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 2
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -583,6 +843,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 func TestAccDigitalOceanKubernetesCluster_UpdatePoolWithAutoScale(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -595,7 +856,7 @@ func TestAccDigitalOceanKubernetesCluster_UpdatePoolWithAutoScale(t *testing.T)
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -604,7 +865,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-			`, testClusterVersionLatest, rName),
+			`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -623,7 +884,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -635,7 +896,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     max_nodes  = 3
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -654,7 +915,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -665,7 +926,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     max_nodes  = 3
   }
 }
-				`, testClusterVersionLatest, rName),
+				`, testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
@@ -685,6 +946,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 func TestAccDigitalOceanKubernetesCluster_KubernetesProviderInteroperability(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -698,7 +960,7 @@ func TestAccDigitalOceanKubernetesCluster_KubernetesProviderInteroperability(t *
 		CheckDestroy: testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfig_KubernetesProviderInteroperability(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfig_KubernetesProviderInteroperability(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s), resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "kube_config.0.raw_config"),
 					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "kube_config.0.cluster_ca_certificate"),
@@ -713,6 +975,7 @@ func TestAccDigitalOceanKubernetesCluster_KubernetesProviderInteroperability(t *
 func TestAccDigitalOceanKubernetesCluster_UpgradeVersion(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -720,16 +983,15 @@ func TestAccDigitalOceanKubernetesCluster_UpgradeVersion(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrPtr("digitalocean_kubernetes_cluster.foobar", "id", &k8s.ID),
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
 				),
@@ -741,6 +1003,7 @@ func TestAccDigitalOceanKubernetesCluster_UpgradeVersion(t *testing.T) {
 func TestAccDigitalOceanKubernetesCluster_DestroyAssociated(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -748,7 +1011,7 @@ func TestAccDigitalOceanKubernetesCluster_DestroyAssociated(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigDestroyAssociated(testClusterVersionPrevious, rName),
+				Config: testAccDigitalOceanKubernetesConfigDestroyAssociated(testClusterVersionPrevious, rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "version", "data.digitalocean_kubernetes_versions.test", "latest_version"),
@@ -759,12 +1022,12 @@ func TestAccDigitalOceanKubernetesCluster_DestroyAssociated(t *testing.T) {
 	})
 }
 
-func testAccDigitalOceanKubernetesConfigBasic(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigBasic(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name          = "%s"
-  region        = "nyc1"
+  region        = "%s"
   version       = data.digitalocean_kubernetes_versions.test.latest_version
   surge_upgrade = true
   tags          = ["foo", "bar", "one"]
@@ -784,15 +1047,48 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     }
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersion string, rName string, policy string) string {
+func testAccDigitalOceanKubernetesConfigPoolTaints(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name          = "%s"
-  region        = "lon1"
+  region        = "%s"
+  version       = data.digitalocean_kubernetes_versions.test.latest_version
+  surge_upgrade = true
+  tags          = ["foo", "bar", "one"]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+    tags       = ["one", "two"]
+    labels = {
+      priority = "high"
+    }
+    taint {
+      key    = "key1"
+      value  = "val2"
+      effect = "NoSchedule"
+    }
+    taint {
+      key    = "key2"
+      value  = "val2"
+      effect = "NoExecute"
+    }
+  }
+}
+`, testClusterVersion, rName, region)
+}
+
+func testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersion string, rName string, policy, region string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name          = "%s"
+  region        = "%s"
   version       = data.digitalocean_kubernetes_versions.test.latest_version
   surge_upgrade = true
   tags          = ["foo", "bar", "one"]
@@ -814,15 +1110,15 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     }
   }
 }
-`, testClusterVersion, rName, policy)
+`, testClusterVersion, rName, region, policy)
 }
 
-func testAccDigitalOceanKubernetesConfigBasic2(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigBasic2(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name          = "%s"
-  region        = "lon1"
+  region        = "%s"
   version       = data.digitalocean_kubernetes_versions.test.latest_version
   surge_upgrade = true
   tags          = ["foo", "bar"]
@@ -838,15 +1134,15 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     }
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfigBasic3(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigBasic3(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -857,15 +1153,15 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     tags       = ["one", "two"]
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfigBasic4(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigBasic4(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name          = "%s"
-  region        = "lon1"
+  region        = "%s"
   surge_upgrade = true
   ha            = true
   version       = data.digitalocean_kubernetes_versions.test.latest_version
@@ -878,15 +1174,15 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     tags       = ["foo", "bar"]
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfigBasic5(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigBasic5(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["one", "two"]
 
@@ -897,15 +1193,15 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     tags       = ["foo", "bar"]
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfig_KubernetesProviderInteroperability(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfig_KubernetesProviderInteroperability(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -928,15 +1224,15 @@ resource "kubernetes_namespace" "example" {
     name = "example-namespace"
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
 }
 
-func testAccDigitalOceanKubernetesConfigDestroyAssociated(testClusterVersion string, rName string) string {
+func testAccDigitalOceanKubernetesConfigDestroyAssociated(testClusterVersion string, rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name                             = "%s"
-  region                           = "nyc1"
+  region                           = "%s"
   version                          = data.digitalocean_kubernetes_versions.test.latest_version
   destroy_all_associated_resources = true
 
@@ -946,7 +1242,50 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     node_count = 1
   }
 }
-`, testClusterVersion, rName)
+`, testClusterVersion, rName, region)
+}
+
+func testAccDigitalOceanKubernetesConfigHA(testClusterVersion, rName, region string, ha, surgeUpgrade bool) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name          = "%s"
+  region        = "%s"
+  ha            = %t
+  surge_upgrade = %t
+  version       = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName, region, ha, surgeUpgrade)
+}
+
+// testAccCheckDigitalOceanKubernetesClusterNotRecreated checks that neither
+// the cluster nor the nodes of its default node pool were replaced.
+func testAccCheckDigitalOceanKubernetesClusterNotRecreated(before, after *godo.KubernetesCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("expected the cluster not to be recreated, its ID changed from %s to %s", before.ID, after.ID)
+		}
+
+		nodeIDs := func(cluster *godo.KubernetesCluster) []string {
+			ids := []string{}
+			for _, node := range cluster.NodePools[0].Nodes {
+				ids = append(ids, node.ID)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+		if !reflect.DeepEqual(nodeIDs(before), nodeIDs(after)) {
+			return fmt.Errorf("expected the nodes not to be replaced, they changed from %v to %v", nodeIDs(before), nodeIDs(after))
+		}
+
+		return nil
+	}
 }
 
 func testAccCheckDigitalOceanKubernetesClusterDestroy(s *terraform.State) error {
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
@@ -18,6 +19,21 @@ import (
 const DigitaloceanKubernetesDefaultNodePoolTag = "terraform:default-node-pool"
 
 func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
+	nodePool := nodePoolSchema(true)
+
+	nodePool["drain_before_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	nodePool["node_drain_timeout"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          defaultNodeDrainTimeout.String(),
+		ValidateFunc:     validateKubernetesDuration,
+		DiffSuppressFunc: suppressEquivalentKubernetesDuration,
+	}
 
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanKubernetesNodePoolCreate,
@@ -29,10 +45,11 @@ func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 		},
 		SchemaVersion: 1,
 
-		Schema: nodePoolSchema(true),
+		Schema: nodePool,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
@@ -54,7 +71,7 @@ func resourceDigitalOceanKubernetesNodePoolCreate(ctx context.Context, d *schema
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	pool, err := digitaloceanKubernetesNodePoolCreate(client, timeout, rawPool, d.Get("cluster_id").(string))
+	pool, err := digitaloceanKubernetesNodePoolCreate(ctx, client, timeout, rawPool, d.Get("cluster_id").(string))
 	if err != nil {
 		return diag.Errorf("Error creating Kubernetes node pool: %s", err)
 	}
@@ -82,12 +99,14 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 	d.Set("node_count", pool.Count)
 	d.Set("actual_node_count", pool.Count)
 	d.Set("tags", tag.FlattenTags(FilterTags(pool.Tags)))
-	d.Set("labels", flattenLabels(pool.Labels))
+	d.Set("labels", flattenLabels(filterSystemLabels(pool.Labels)))
 	d.Set("auto_scale", pool.AutoScale)
 	d.Set("min_nodes", pool.MinNodes)
 	d.Set("max_nodes", pool.MaxNodes)
 	d.Set("nodes", flattenNodes(pool.Nodes))
-	d.Set("taint", flattenNodePoolTaints(pool.Taints))
+	d.Set("running_node_count", countRunningNodes(pool.Nodes))
+	d.Set("last_node_created_at", lastNodeCreatedAt(pool.Nodes))
+	d.Set("taint", flattenNodePoolTaints(filterSystemTaints(pool.Taints)))
 
 	// Assign a node_count only if it's been set explicitly, since it's
 	// optional and we don't want to update with a 0 if it's not set.
@@ -101,6 +120,11 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	// The drain settings are only used when the node pool is destroyed.
+	if !d.HasChangesExcept("drain_before_destroy", "node_drain_timeout") {
+		return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+	}
+
 	rawPool := map[string]interface{}{
 		"name": d.Get("name"),
 		"tags": d.Get("tags"),
@@ -118,8 +142,8 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 	_, newTaint := d.GetChange("taint")
 	rawPool["taint"] = newTaint
 
-	timeout := d.Timeout(schema.TimeoutCreate)
-	_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, rawPool, d.Get("cluster_id").(string), d.Id())
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	_, err := digitaloceanKubernetesNodePoolUpdate(ctx, client, timeout, rawPool, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("Error updating node pool: %s", err)
 	}
@@ -129,12 +153,26 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 
 func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
-	_, err := client.Kubernetes.DeleteNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+
+	if d.Get("drain_before_destroy").(bool) {
+		// The timeout is validated by the schema, but may be missing from the
+		// state of node pools created before it was added.
+		nodeTimeout, err := time.ParseDuration(d.Get("node_drain_timeout").(string))
+		if err != nil {
+			nodeTimeout = defaultNodeDrainTimeout
+		}
+
+		if err := drainKubernetesNodePool(ctx, client, d.Get("cluster_id").(string), d.Id(), nodeTimeout); err != nil {
+			return diag.Errorf("Error draining node pool: %s", err)
+		}
+	}
+
+	_, err := client.Kubernetes.DeleteNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("Unable to delete node pool %s", err)
 	}
 
-	err = waitForKubernetesNodePoolDelete(client, d)
+	err = waitForKubernetesNodePoolDelete(ctx, client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -143,6 +181,10 @@ func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema
 }
 
 func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// These attributes are not part of the node pool, so set the defaults.
+	d.Set("drain_before_destroy", false)
+	d.Set("node_drain_timeout", defaultNodeDrainTimeout.String())
+
 	if _, ok := d.GetOk("cluster_id"); ok {
 		// Short-circuit: The resource already has a cluster ID, no need to search for it.
 		return []*schema.ResourceData{d}, nil
@@ -204,30 +246,16 @@ func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, m
 	return []*schema.ResourceData{d}, nil
 }
 
-func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID string, customTags ...string) (*godo.KubernetesNodePool, error) {
-	// append any custom tags
-	tags := tag.ExpandTags(pool["tags"].(*schema.Set).List())
-	tags = append(tags, customTags...)
-
-	req := &godo.KubernetesNodePoolCreateRequest{
-		Name:      pool["name"].(string),
-		Size:      pool["size"].(string),
-		Count:     pool["node_count"].(int),
-		Tags:      tags,
-		Labels:    expandLabels(pool["labels"].(map[string]interface{})),
-		AutoScale: pool["auto_scale"].(bool),
-		MinNodes:  pool["min_nodes"].(int),
-		MaxNodes:  pool["max_nodes"].(int),
-		Taints:    expandNodePoolTaints(pool["taint"].(*schema.Set).List()),
-	}
+func digitaloceanKubernetesNodePoolCreate(ctx context.Context, client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID string, customTags ...string) (*godo.KubernetesNodePool, error) {
+	req := expandNodePoolCreateRequest(pool, customTags...)
 
-	p, _, err := client.Kubernetes.CreateNodePool(context.Background(), clusterID, req)
+	p, _, err := client.Kubernetes.CreateNodePool(ctx, clusterID, req)
 
 	if err != nil {
 		return nil, fmt.Errorf("Unable to create new default node pool %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
+	err = waitForKubernetesNodePoolCreate(ctx, client, timeout, clusterID, p.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +263,7 @@ func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Dura
 	return p, nil
 }
 
-func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID, poolID string, customTags ...string) (*godo.KubernetesNodePool, error) {
+func digitaloceanKubernetesNodePoolUpdate(ctx context.Context, client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID, poolID string, customTags ...string) (*godo.KubernetesNodePool, error) {
 	tags := tag.ExpandTags(pool["tags"].(*schema.Set).List())
 	tags = append(tags, customTags...)
 
@@ -261,16 +289,35 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 		req.MaxNodes = godo.PtrTo(pool["max_nodes"].(int))
 	}
 
-	if pool["labels"] != nil {
-		req.Labels = expandLabels(pool["labels"].(map[string]interface{}))
-	}
+	if pool["labels"] != nil || pool["taint"] != nil {
+		// The update replaces all of the labels and taints of the pool, so the
+		// system labels and taints, which are not part of the configuration,
+		// are sent back.
+		current, resp, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("Unable to retrieve nodepool: %s", err)
+		}
 
-	if pool["taint"] != nil {
-		t := expandNodePoolTaints(pool["taint"].(*schema.Set).List())
-		req.Taints = &t
+		if pool["labels"] != nil {
+			labels := expandLabels(pool["labels"].(map[string]interface{}))
+			for key, value := range systemLabels(current.Labels) {
+				labels[key] = value
+			}
+			req.Labels = labels
+		}
+
+		if pool["taint"] != nil {
+			// Removing all of the taints requires sending an empty list.
+			t := append(expandNodePoolTaints(pool["taint"].(*schema.Set).List()), systemTaints(current.Taints)...)
+			req.Taints = &t
+		}
 	}
 
-	p, resp, err := client.Kubernetes.UpdateNodePool(context.Background(), clusterID, poolID, req)
+	p, resp, err := client.Kubernetes.UpdateNodePool(ctx, clusterID, poolID, req)
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -280,7 +327,7 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 		return nil, fmt.Errorf("Unable to update nodepool: %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
+	err = waitForKubernetesNodePoolCreate(ctx, client, timeout, clusterID, p.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -288,17 +335,16 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 	return p, nil
 }
 
-func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration, id string, poolID string) error {
+func waitForKubernetesNodePoolCreate(ctx context.Context, client *godo.Client, duration time.Duration, id string, poolID string) error {
 	var (
-		tickerInterval = 10 * time.Second
 		timeoutSeconds = duration.Seconds()
-		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		timeout        = int(timeoutSeconds / kubernetesPollInterval.Seconds())
 		n              = 0
 	)
 
-	ticker := time.NewTicker(tickerInterval)
+	ticker := time.NewTicker(kubernetesPollInterval)
 	for range ticker.C {
-		pool, _, err := client.Kubernetes.GetNodePool(context.Background(), id, poolID)
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, id, poolID)
 		if err != nil {
 			ticker.Stop()
 			return fmt.Errorf("Error trying to read nodepool state: %s", err)
@@ -327,21 +373,20 @@ func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration
 	return fmt.Errorf("Timeout waiting to create nodepool")
 }
 
-func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData) error {
+func waitForKubernetesNodePoolDelete(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
 	var (
-		tickerInterval = 10 * time.Second
 		timeoutSeconds = d.Timeout(schema.TimeoutDelete).Seconds()
-		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		timeout        = int(timeoutSeconds / kubernetesPollInterval.Seconds())
 		n              = 0
-		ticker         = time.NewTicker(tickerInterval)
+		ticker         = time.NewTicker(kubernetesPollInterval)
 	)
 
 	for range ticker.C {
-		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+		_, resp, err := client.Kubernetes.GetNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 		if err != nil {
 			ticker.Stop()
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return nil
 			}
 
@@ -358,3 +403,75 @@ func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData
 
 	return fmt.Errorf("Timeout waiting to delete nodepool")
 }
+
+// defaultNodeDrainTimeout is how long a node is waited on to be drained and
+// deleted by default.
+const defaultNodeDrainTimeout = 10 * time.Minute
+
+// drainKubernetesNodePool deletes the nodes of a node pool one at a time,
+// draining each of them first so that its pods are evicted, respecting their
+// PodDisruptionBudgets, and rescheduled before the next node is drained. Each
+// node is waited on for up to nodeTimeout to be deleted.
+func drainKubernetesNodePool(ctx context.Context, client *godo.Client, clusterID, poolID string, nodeTimeout time.Duration) error {
+	pool, resp, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error trying to read nodepool state: %s", err)
+	}
+
+	for _, node := range pool.Nodes {
+		log.Printf("[INFO] Draining and deleting node %s of node pool %s", node.ID, poolID)
+
+		resp, err := client.Kubernetes.DeleteNode(ctx, clusterID, poolID, node.ID, &godo.KubernetesNodeDeleteRequest{SkipDrain: false})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("Unable to delete node %s: %s", node.ID, err)
+		}
+
+		if err := waitForKubernetesNodeDelete(ctx, client, clusterID, poolID, node.ID, nodeTimeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForKubernetesNodeDelete waits for a node to be removed from its node
+// pool, or for the node pool itself to be deleted.
+func waitForKubernetesNodeDelete(ctx context.Context, client *godo.Client, clusterID, poolID, nodeID string, timeout time.Duration) error {
+	ticker := time.NewTicker(kubernetesPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Timeout waiting for node %s to be drained and deleted: %s", nodeID, ctx.Err())
+		case <-deadline:
+			return fmt.Errorf("Timeout waiting for node %s to be drained and deleted after %s", nodeID, timeout)
+		case <-ticker.C:
+		}
+
+		pool, resp, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		found := false
+		for _, node := range pool.Nodes {
+			if node.ID == nodeID {
+				found = true
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestKubernetesNodePoolTimeoutsDefaults(t *testing.T) {
+	expected := map[string]time.Duration{
+		schema.TimeoutCreate: 30 * time.Minute,
+		schema.TimeoutUpdate: 30 * time.Minute,
+		schema.TimeoutDelete: 30 * time.Minute,
+	}
+
+	d := ResourceDigitalOceanKubernetesNodePool().Data(nil)
+	for key, timeout := range expected {
+		if got := d.Timeout(key); got != timeout {
+			t.Errorf("%s: expected %s, got %s", key, timeout, got)
+		}
+	}
+}
+
+func TestKubernetesNodePoolTimeoutsHonored(t *testing.T) {
+	client := newNeverReadyKubernetesTestClient(t)
+
+	timeouts := &schema.ResourceTimeout{
+		Create: godo.PtrTo(100 * time.Millisecond),
+		Update: godo.PtrTo(100 * time.Millisecond),
+		Delete: godo.PtrTo(100 * time.Millisecond),
+	}
+
+	pool := ResourceDigitalOceanKubernetesNodePool()
+	pool.Timeouts = timeouts
+	poolData := pool.Data(&terraform.InstanceState{
+		ID:         "pool-id",
+		Attributes: map[string]string{"cluster_id": "cluster-id"},
+	})
+
+	cases := []struct {
+		name     string
+		expected string
+		wait     func() error
+	}{
+		{
+			name:     "node pool create",
+			expected: "Timeout waiting to create nodepool",
+			wait: func() error {
+				return waitForKubernetesNodePoolCreate(context.Background(), client, poolData.Timeout(schema.TimeoutCreate), "cluster-id", "pool-id")
+			},
+		},
+		{
+			name:     "node pool update",
+			expected: "Timeout waiting to create nodepool",
+			wait: func() error {
+				return waitForKubernetesNodePoolCreate(context.Background(), client, poolData.Timeout(schema.TimeoutUpdate), "cluster-id", "pool-id")
+			},
+		},
+		{
+			name:     "node pool delete",
+			expected: "Timeout waiting to delete nodepool",
+			wait: func() error {
+				return waitForKubernetesNodePoolDelete(context.Background(), client, poolData)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			start := time.Now()
+			err := c.wait()
+			if err == nil || err.Error() != c.expected {
+				t.Fatalf("expected %q, got: %v", c.expected, err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the configured timeout to be honored, waited %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestDigitaloceanKubernetesNodePoolUpdateTaints(t *testing.T) {
+	interval := kubernetesPollInterval
+	t.Cleanup(func() { kubernetesPollInterval = interval })
+	kubernetesPollInterval = 10 * time.Millisecond
+
+	cases := []struct {
+		name     string
+		current  string
+		taints   []interface{}
+		expected []godo.Taint
+	}{
+		{
+			name:    "remove all",
+			current: `[{"key":"dedicated","value":"db","effect":"NoSchedule"}]`,
+			taints:  []interface{}{},
+			// An empty list, rather than no taints field.
+			expected: []godo.Taint{},
+		},
+		{
+			name:    "system taints preserved",
+			current: `[{"key":"dedicated","value":"db","effect":"NoSchedule"},{"key":"doks.digitalocean.com/gpu","value":"true","effect":"NoSchedule"}]`,
+			taints: []interface{}{
+				map[string]interface{}{"key": "dedicated", "value": "web", "effect": "NoExecute"},
+			},
+			expected: []godo.Taint{
+				{Key: "dedicated", Value: "web", Effect: "NoExecute"},
+				{Key: "doks.digitalocean.com/gpu", Value: "true", Effect: "NoSchedule"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var body map[string]json.RawMessage
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != kubernetesClustersPath+"/cluster-id/node_pools/pool-id" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if r.Method == http.MethodPut {
+					raw, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(raw, &body); err != nil {
+						t.Fatalf("unable to decode request body: %s", err)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"node_pool":{"id":"pool-id","count":0,"taints":%s}}`, c.current)
+			})).GodoClient()
+
+			pool := map[string]interface{}{
+				"name":  "pool",
+				"tags":  schema.NewSet(schema.HashString, nil),
+				"taint": schema.NewSet(schema.HashResource(nodePoolTaintSchema()), c.taints),
+			}
+
+			if _, err := digitaloceanKubernetesNodePoolUpdate(context.Background(), client, time.Second, pool, "cluster-id", "pool-id"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			raw, ok := body["taints"]
+			if !ok {
+				t.Fatalf("expected the taints to be sent, got: %v", body)
+			}
+			var taints []godo.Taint
+			if err := json.Unmarshal(raw, &taints); err != nil {
+				t.Fatalf("unable to decode taints: %s", err)
+			}
+			if !reflect.DeepEqual(taints, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, taints)
+			}
+		})
+	}
+}
+
+func TestDigitaloceanKubernetesNodePoolUpdateLabels(t *testing.T) {
+	interval := kubernetesPollInterval
+	t.Cleanup(func() { kubernetesPollInterval = interval })
+	kubernetesPollInterval = 10 * time.Millisecond
+
+	var body godo.KubernetesNodePoolUpdateRequest
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != kubernetesClustersPath+"/cluster-id/node_pools/pool-id" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("unable to decode request body: %s", err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"node_pool":{"id":"pool-id","count":0,"labels":{"priority":"high","doks.digitalocean.com/node-pool":"pool","doks.digitalocean.com/version":"1.29.1-do.0"}}}`)
+	})).GodoClient()
+
+	pool := map[string]interface{}{
+		"name":   "pool",
+		"tags":   schema.NewSet(schema.HashString, nil),
+		"labels": map[string]interface{}{"priority": "low", "team": "web"},
+	}
+
+	if _, err := digitaloceanKubernetesNodePoolUpdate(context.Background(), client, time.Second, pool, "cluster-id", "pool-id"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The update replaces all of the labels, so the system labels are sent
+	// back along with the configured ones.
+	expected := map[string]string{
+		"priority":                        "low",
+		"team":                            "web",
+		"doks.digitalocean.com/node-pool": "pool",
+		"doks.digitalocean.com/version":   "1.29.1-do.0",
+	}
+	if !reflect.DeepEqual(body.Labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, body.Labels)
+	}
+}
+
+func TestKubernetesNodePoolSystemLabelsDiff(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != kubernetesClustersPath+"/cluster-id/node_pools/pool-id" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"node_pool":{"id":"pool-id","name":"pool","size":"s-1vcpu-2gb","count":1,"labels":{
+			"priority":"high",
+			"doks.digitalocean.com/node-pool":"pool",
+			"doks.digitalocean.com/node-pool-id":"pool-id",
+			"doks.digitalocean.com/version":"1.31.1-do.0"
+		}}}`)
+	}))
+
+	raw := map[string]interface{}{
+		"cluster_id": "cluster-id",
+		"name":       "pool",
+		"size":       "s-1vcpu-2gb",
+		"node_count": 1,
+		"labels": map[string]interface{}{
+			"priority": "high",
+		},
+	}
+
+	r := ResourceDigitalOceanKubernetesNodePool()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("pool-id")
+
+	if diags := resourceDigitalOceanKubernetesNodePoolRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]interface{}{"priority": "high"}
+	if labels := d.Get("labels").(map[string]interface{}); !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, labels)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected an empty diff, got %v", diff.Attributes)
+	}
+}
+
+func TestDrainKubernetesNodePool(t *testing.T) {
+	interval := kubernetesPollInterval
+	t.Cleanup(func() { kubernetesPollInterval = interval })
+	kubernetesPollInterval = 10 * time.Millisecond
+
+	poolPath := kubernetesClustersPath + "/cluster-id/node_pools/pool-id"
+
+	cases := []struct {
+		name             string
+		removeNodes      bool
+		expectedDeleted  []string
+		expectedErrorMsg string
+	}{
+		{name: "drained", removeNodes: true, expectedDeleted: []string{"node-1", "node-2"}},
+		{name: "timeout", removeNodes: false, expectedDeleted: []string{"node-1"}, expectedErrorMsg: "Timeout waiting for node node-1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			nodes := []string{"node-1", "node-2"}
+			var deleted []string
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == poolPath:
+					var items []string
+					for _, node := range nodes {
+						items = append(items, fmt.Sprintf(`{"id":%q,"status":{"state":"running"}}`, node))
+					}
+					fmt.Fprintf(w, `{"node_pool":{"id":"pool-id","count":%d,"nodes":[%s]}}`, len(nodes), strings.Join(items, ","))
+				case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, poolPath+"/nodes/"):
+					if r.URL.Query().Get("skip_drain") != "" {
+						t.Errorf("expected the node to be drained, got query %q", r.URL.RawQuery)
+					}
+					// A node is only deleted once the previous one is gone.
+					if len(nodes) == 0 || r.URL.Path != poolPath+"/nodes/"+nodes[0] {
+						t.Errorf("unexpected node deletion: %s, remaining nodes: %v", r.URL.Path, nodes)
+					}
+					deleted = append(deleted, strings.TrimPrefix(r.URL.Path, poolPath+"/nodes/"))
+					if c.removeNodes {
+						nodes = nodes[1:]
+					}
+					w.WriteHeader(http.StatusAccepted)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			})).GodoClient()
+
+			err := drainKubernetesNodePool(context.Background(), client, "cluster-id", "pool-id", 100*time.Millisecond)
+			if c.expectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+				t.Fatalf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+			}
+
+			if !reflect.DeepEqual(deleted, c.expectedDeleted) {
+				t.Errorf("expected %v to be deleted, got %v", c.expectedDeleted, deleted)
+			}
+		})
+	}
+}
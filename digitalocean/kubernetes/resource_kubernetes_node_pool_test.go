@@ -16,11 +16,12 @@ func TestAccDigitalOceanKubernetesNodePool_Basic(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
 	var k8sPool godo.KubernetesNodePool
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	clusterConfig := fmt.Sprintf(`%s
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -31,7 +32,7 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
     tags       = ["one", "two"]
   }
 }
-`, testClusterVersionLatest, rName)
+`, testClusterVersionLatest, rName, region)
 
 	nodePoolConfig := fmt.Sprintf(`resource digitalocean_kubernetes_node_pool "barfoo" {
 	cluster_id = digitalocean_kubernetes_cluster.foobar.id
@@ -96,6 +97,8 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
 	var k8sPool godo.KubernetesNodePool
+	var poolID string
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -103,7 +106,7 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePool(rName),
+				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePool(rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -114,11 +117,12 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 					resource.TestCheckNoResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "labels.priority"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "node_count", "1"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "actual_node_count", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_node_pool.barfoo", "last_node_created_at"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.#", "1"),
 				),
 			},
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePool2(rName),
+				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePool2(rName, region),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -130,22 +134,27 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "node_count", "2"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "actual_node_count", "2"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.#", "2"),
+					func(s *terraform.State) error {
+						poolID = k8sPool.ID
+						return nil
+					},
 				),
 			},
-			// Update NodePool Taint
+			// Update NodePool Taint, in place
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint(rName),
+				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint(rName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName+"-tainted"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.#", "1"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.#", "1"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.0.effect", "NoSchedule"),
+					resource.TestCheckResourceAttrPtr("digitalocean_kubernetes_node_pool.barfoo", "id", &poolID),
 				),
 			},
 			// Add second NodePool Taint
 			{
-				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint2(rName),
+				Config: testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint2(rName, region),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName+"-tainted"),
@@ -153,6 +162,7 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.#", "2"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.0.effect", "NoSchedule"),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.1.effect", "PreferNoSchedule"),
+					resource.TestCheckResourceAttrPtr("digitalocean_kubernetes_node_pool.barfoo", "id", &poolID),
 				),
 			},
 		},
@@ -163,6 +173,7 @@ func TestAccDigitalOceanKubernetesNodePool_CreateWithAutoScale(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
 	var k8sPool godo.KubernetesNodePool
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -175,7 +186,7 @@ func TestAccDigitalOceanKubernetesNodePool_CreateWithAutoScale(t *testing.T) {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -194,7 +205,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   min_nodes  = 1
   max_nodes  = 5
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -214,7 +225,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -232,7 +243,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   min_nodes  = 1
   max_nodes  = 3
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -252,7 +263,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -271,7 +282,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   min_nodes  = 1
   max_nodes  = 3
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -291,7 +302,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -307,7 +318,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   size       = "s-1vcpu-2gb"
   node_count = 2
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -329,6 +340,7 @@ func TestAccDigitalOceanKubernetesNodePool_UpdateWithAutoScale(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
 	var k8sPool godo.KubernetesNodePool
+	region := acceptance.TestAccRegion(t, "kubernetes")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -341,7 +353,7 @@ func TestAccDigitalOceanKubernetesNodePool_UpdateWithAutoScale(t *testing.T) {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -357,7 +369,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   size       = "s-1vcpu-2gb"
   node_count = 1
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -377,7 +389,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -396,7 +408,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   min_nodes  = 1
   max_nodes  = 3
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -416,7 +428,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
 
   node_pool {
@@ -434,7 +446,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   min_nodes  = 1
   max_nodes  = 3
 }
-				`, testClusterVersionLatest, rName, rName),
+				`, testClusterVersionLatest, rName, region, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
 					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
@@ -452,12 +464,12 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 	})
 }
 
-func testAccDigitalOceanKubernetesConfigBasicWithNodePool(rName string) string {
+func testAccDigitalOceanKubernetesConfigBasicWithNodePool(rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -477,15 +489,15 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
   node_count = 1
   tags       = ["three", "four"]
 }
-`, testClusterVersionLatest, rName, rName)
+`, testClusterVersionLatest, rName, region, rName)
 }
 
-func testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint(rName string) string {
+func testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint(rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -513,15 +525,15 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
     effect = "NoSchedule"
   }
 }
-`, testClusterVersionLatest, rName, rName)
+`, testClusterVersionLatest, rName, region, rName)
 }
 
-func testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint2(rName string) string {
+func testAccDigitalOceanKubernetesConfigBasicWithNodePoolTaint2(rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -554,15 +566,15 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
     effect = "PreferNoSchedule"
   }
 }
-`, testClusterVersionLatest, rName, rName)
+`, testClusterVersionLatest, rName, region, rName)
 }
 
-func testAccDigitalOceanKubernetesConfigBasicWithNodePool2(rName string) string {
+func testAccDigitalOceanKubernetesConfigBasicWithNodePool2(rName, region string) string {
 	return fmt.Sprintf(`%s
 
 resource "digitalocean_kubernetes_cluster" "foobar" {
   name    = "%s"
-  region  = "lon1"
+  region  = "%s"
   version = data.digitalocean_kubernetes_versions.test.latest_version
   tags    = ["foo", "bar"]
 
@@ -585,7 +597,7 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
     priority = "high"
   }
 }
-`, testClusterVersionLatest, rName, rName)
+`, testClusterVersionLatest, rName, region, rName)
 }
 
 func testAccCheckDigitalOceanKubernetesNodePoolExists(n string, cluster *godo.KubernetesCluster, pool *godo.KubernetesNodePool) resource.TestCheckFunc {
@@ -0,0 +1,232 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultNodeRecycleTimeout is how long a node is waited on to be replaced by
+// a new node that is running.
+const defaultNodeRecycleTimeout = 30 * time.Minute
+
+// ResourceDigitalOceanKubernetesNodeRecycle replaces the nodes of a node pool
+// when it is created. It manages no remote object: recycling the nodes again
+// requires a change to one of its arguments, usually triggers, which forces it
+// to be replaced.
+func ResourceDigitalOceanKubernetesNodeRecycle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesNodeRecycleCreate,
+		ReadContext:   resourceDigitalOceanKubernetesNodeRecycleRead,
+		UpdateContext: resourceDigitalOceanKubernetesNodeRecycleUpdate,
+		DeleteContext: resourceDigitalOceanKubernetesNodeRecycleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"node_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"node_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultNodeRecycleTimeout.String(),
+				ValidateFunc:     validateKubernetesDuration,
+				DiffSuppressFunc: suppressEquivalentKubernetesDuration,
+			},
+
+			"recycled_node_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesNodeRecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	nodeTimeout, err := time.ParseDuration(d.Get("node_timeout").(string))
+	if err != nil {
+		return diag.Errorf("Invalid node_timeout: %s", err)
+	}
+
+	var nodeIDs []string
+	if v, ok := d.GetOk("node_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			nodeIDs = append(nodeIDs, id.(string))
+		}
+	}
+
+	recycled, err := recycleKubernetesNodePoolNodes(ctx, client, clusterID, poolID, nodeIDs, nodeTimeout)
+	if len(recycled) > 0 || err == nil {
+		d.SetId(resource.UniqueId())
+		d.Set("recycled_node_ids", recycled)
+	}
+	if err != nil {
+		// The recycled nodes are kept in the state of a partially created
+		// resource, which is tainted so that the remaining nodes are
+		// recycled when it is replaced.
+		return diag.Errorf("Error recycling the nodes of node pool %s: %s", poolID, err)
+	}
+
+	return resourceDigitalOceanKubernetesNodeRecycleRead(ctx, d, meta)
+}
+
+// resourceDigitalOceanKubernetesNodeRecycleRead does nothing, as the resource
+// only exists in the state.
+func resourceDigitalOceanKubernetesNodeRecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceDigitalOceanKubernetesNodeRecycleUpdate only updates node_timeout
+// in the state, all other arguments force the nodes to be recycled again.
+func resourceDigitalOceanKubernetesNodeRecycleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDigitalOceanKubernetesNodeRecycleRead(ctx, d, meta)
+}
+
+// resourceDigitalOceanKubernetesNodeRecycleDelete removes the resource from
+// the state, leaving the nodes as they are.
+func resourceDigitalOceanKubernetesNodeRecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// recycleKubernetesNodePoolNodes replaces the nodes of a node pool one at a
+// time, or only the nodes in nodeIDs if any are given. Each node is drained,
+// deleted and replaced by a new node, which is waited on for up to
+// nodeTimeout to be running before the next node is recycled. The IDs of the
+// nodes which were recycled are returned, including when an error occurs.
+func recycleKubernetesNodePoolNodes(ctx context.Context, client *godo.Client, clusterID, poolID string, nodeIDs []string, nodeTimeout time.Duration) ([]string, error) {
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("Error trying to read nodepool state: %s", err)
+	}
+
+	if len(nodeIDs) == 0 {
+		for _, node := range pool.Nodes {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+	} else {
+		current := make(map[string]bool, len(pool.Nodes))
+		for _, node := range pool.Nodes {
+			current[node.ID] = true
+		}
+		for _, id := range nodeIDs {
+			if !current[id] {
+				return nil, fmt.Errorf("node %s is not a node of node pool %s", id, poolID)
+			}
+		}
+	}
+
+	recycled := make([]string, 0, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		log.Printf("[INFO] Recycling node %s of node pool %s (%d of %d)", nodeID, poolID, i+1, len(nodeIDs))
+
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			return recycled, fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+		existing := make(map[string]bool, len(pool.Nodes))
+		for _, node := range pool.Nodes {
+			existing[node.ID] = true
+		}
+
+		_, err = client.Kubernetes.DeleteNode(ctx, clusterID, poolID, nodeID, &godo.KubernetesNodeDeleteRequest{Replace: true})
+		if err != nil {
+			return recycled, fmt.Errorf("Unable to recycle node %s: %s", nodeID, err)
+		}
+
+		replacement, err := waitForKubernetesNodeReplacement(ctx, client, clusterID, poolID, nodeID, existing, nodeTimeout)
+		if err != nil {
+			return recycled, err
+		}
+		recycled = append(recycled, nodeID)
+
+		log.Printf("[INFO] Node %s of node pool %s was replaced by node %s (%d of %d)", nodeID, poolID, replacement, i+1, len(nodeIDs))
+	}
+
+	return recycled, nil
+}
+
+// waitForKubernetesNodeReplacement waits for a node to be removed from its
+// node pool and for a node which is not one of the existing nodes to be
+// running. The ID of the new node is returned.
+func waitForKubernetesNodeReplacement(ctx context.Context, client *godo.Client, clusterID, poolID, nodeID string, existing map[string]bool, timeout time.Duration) (string, error) {
+	ticker := time.NewTicker(kubernetesPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("Timeout waiting for node %s to be replaced: %s", nodeID, ctx.Err())
+		case <-deadline:
+			return "", fmt.Errorf("Timeout waiting for node %s to be replaced after %s", nodeID, timeout)
+		case <-ticker.C:
+		}
+
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			return "", fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		removed := true
+		replacement := ""
+		for _, node := range pool.Nodes {
+			if node.ID == nodeID {
+				removed = false
+			}
+			if !existing[node.ID] && node.Status != nil && node.Status.State == "running" {
+				replacement = node.ID
+			}
+		}
+		if removed && replacement != "" {
+			return replacement, nil
+		}
+
+		log.Printf("[DEBUG] Waiting for node %s of node pool %s to be replaced", nodeID, poolID)
+	}
+}
@@ -0,0 +1,95 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecycleKubernetesNodePoolNodes(t *testing.T) {
+	interval := kubernetesPollInterval
+	t.Cleanup(func() { kubernetesPollInterval = interval })
+	kubernetesPollInterval = 10 * time.Millisecond
+
+	poolPath := kubernetesClustersPath + "/cluster-id/node_pools/pool-id"
+
+	cases := []struct {
+		name             string
+		nodeIDs          []string
+		replaceNodes     bool
+		expectedRecycled []string
+		expectedErrorMsg string
+	}{
+		{name: "all", replaceNodes: true, expectedRecycled: []string{"node-1", "node-2"}},
+		{name: "selected", nodeIDs: []string{"node-2"}, replaceNodes: true, expectedRecycled: []string{"node-2"}},
+		{name: "unknown", nodeIDs: []string{"node-3"}, expectedErrorMsg: "node node-3 is not a node of node pool pool-id"},
+		{name: "timeout", replaceNodes: false, expectedRecycled: []string{}, expectedErrorMsg: "Timeout waiting for node node-1 to be replaced"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			type node struct{ id, state string }
+			nodes := []node{{"node-1", "running"}, {"node-2", "running"}}
+			replacements := 0
+			polls := 0
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == poolPath:
+					// Replacement nodes are provisioning when first listed.
+					polls++
+					var items []string
+					for i, n := range nodes {
+						if n.state == "provisioning" && polls%2 == 0 {
+							nodes[i].state = "running"
+						}
+						items = append(items, fmt.Sprintf(`{"id":%q,"status":{"state":%q}}`, n.id, n.state))
+					}
+					fmt.Fprintf(w, `{"node_pool":{"id":"pool-id","count":%d,"nodes":[%s]}}`, len(nodes), strings.Join(items, ","))
+				case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, poolPath+"/nodes/"):
+					if r.URL.Query().Get("replace") != "1" {
+						t.Errorf("expected the node to be replaced, got query %q", r.URL.RawQuery)
+					}
+					for _, n := range nodes {
+						if n.state != "running" {
+							t.Errorf("node %s was recycled before node %s was running", r.URL.Path, n.id)
+						}
+					}
+					if !c.replaceNodes {
+						w.WriteHeader(http.StatusAccepted)
+						return
+					}
+					id := strings.TrimPrefix(r.URL.Path, poolPath+"/nodes/")
+					remaining := nodes[:0]
+					for _, n := range nodes {
+						if n.id != id {
+							remaining = append(remaining, n)
+						}
+					}
+					replacements++
+					nodes = append(remaining, node{fmt.Sprintf("replacement-%d", replacements), "provisioning"})
+					w.WriteHeader(http.StatusAccepted)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			})).GodoClient()
+
+			recycled, err := recycleKubernetesNodePoolNodes(context.Background(), client, "cluster-id", "pool-id", c.nodeIDs, 100*time.Millisecond)
+			if c.expectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+				t.Fatalf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+			}
+
+			if c.expectedRecycled != nil && !reflect.DeepEqual(recycled, c.expectedRecycled) {
+				t.Errorf("expected %v to be recycled, got %v", c.expectedRecycled, recycled)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesNodeRecycle_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	region := acceptance.TestAccRegion(t, "kubernetes")
+
+	clusterConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "%s"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName, region)
+
+	recycleConfig := `resource "digitalocean_kubernetes_node_recycle" "foobar" {
+  cluster_id   = digitalocean_kubernetes_cluster.foobar.id
+  node_pool_id = digitalocean_kubernetes_cluster.foobar.node_pool[0].id
+
+  triggers = {
+    rollout = "%s"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + fmt.Sprintf(recycleConfig, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "recycled_node_ids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "triggers.rollout", "1"),
+				),
+			},
+			// Bumping the trigger recycles the nodes again.
+			{
+				Config: clusterConfig + fmt.Sprintf(recycleConfig, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "recycled_node_ids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "triggers.rollout", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const routingAgentKey = "routing_agent"
+
+// kubernetesRoutingAgent is the routing agent addon of a cluster. It is not a
+// field of godo.KubernetesCluster, so it is decoded from the raw cluster.
+type kubernetesRoutingAgent struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// routingAgentSchema is computed so that clusters which do not configure the
+// routing agent keep the API default without a diff.
+func routingAgentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// expandRoutingAgent returns nil if the block is not set.
+func expandRoutingAgent(config []interface{}) *kubernetesRoutingAgent {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	configMap := config[0].(map[string]interface{})
+
+	return &kubernetesRoutingAgent{
+		Enabled: godo.PtrTo(configMap["enabled"].(bool)),
+	}
+}
+
+// flattenRoutingAgent flattens the routing agent returned by the API, which
+// may be missing or null.
+func flattenRoutingAgent(raw json.RawMessage) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0)
+	if len(raw) == 0 || string(raw) == "null" {
+		return result, nil
+	}
+
+	routingAgent := &kubernetesRoutingAgent{}
+	if err := json.Unmarshal(raw, routingAgent); err != nil {
+		return nil, err
+	}
+
+	enabled := false
+	if routingAgent.Enabled != nil {
+		enabled = *routingAgent.Enabled
+	}
+
+	result = append(result, map[string]interface{}{
+		"enabled": enabled,
+	})
+
+	return result, nil
+}
+
+func setKubernetesClusterRoutingAgent(d *schema.ResourceData, cluster map[string]json.RawMessage) error {
+	routingAgent, err := flattenRoutingAgent(cluster[routingAgentKey])
+	if err != nil {
+		return fmt.Errorf("Error reading Kubernetes cluster routing agent: %s", err)
+	}
+	if err := d.Set("routing_agent", routingAgent); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting routing_agent - error: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandRoutingAgent(t *testing.T) {
+	if routingAgent := expandRoutingAgent([]interface{}{}); routingAgent != nil {
+		t.Errorf("expected no routing agent when the block is not set, got %+v", routingAgent)
+	}
+
+	routingAgent := expandRoutingAgent([]interface{}{map[string]interface{}{"enabled": false}})
+
+	b, err := json.Marshal(routingAgent)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Disabling the routing agent must be sent explicitly.
+	expected := `{"enabled":false}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestFlattenRoutingAgent(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      string
+		expected []map[string]interface{}
+	}{
+		{"Missing", "", []map[string]interface{}{}},
+		{"Null", "null", []map[string]interface{}{}},
+		{"Enabled", `{"enabled":true}`, []map[string]interface{}{{"enabled": true}}},
+		{"Disabled", `{}`, []map[string]interface{}{{"enabled": false}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := flattenRoutingAgent(json.RawMessage(tc.raw))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestKubernetesClusterRoutingAgentRequests(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"8d91899c","name":"foo"}}`)
+	})).GodoClient()
+
+	routingAgent := &kubernetesRoutingAgent{Enabled: godo.PtrTo(true)}
+	expected := map[string]interface{}{"enabled": true}
+
+	opts := &godo.KubernetesClusterCreateRequest{Name: "foo"}
+	if _, _, err := createKubernetesClusterWithOptions(context.Background(), client, opts, nil, nil, routingAgent); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(body[routingAgentKey], expected) {
+		t.Errorf("expected the routing agent to be created as %#v, got %#v", expected, body[routingAgentKey])
+	}
+
+	updateOpts := &godo.KubernetesClusterUpdateRequest{Name: "foo"}
+	if _, _, err := updateKubernetesCluster(context.Background(), client, "8d91899c", updateOpts, nil, routingAgent); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(body[routingAgentKey], expected) {
+		t.Errorf("expected the routing agent to be updated as %#v, got %#v", expected, body[routingAgentKey])
+	}
+
+	if _, _, err := updateKubernetesCluster(context.Background(), client, "8d91899c", updateOpts, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := body[routingAgentKey]; ok {
+		t.Errorf("expected the routing agent not to be sent unless changed, got %#v", body[routingAgentKey])
+	}
+}
+
+func TestKubernetesClusterRoutingAgentDiff(t *testing.T) {
+	cases := []struct {
+		name         string
+		routingAgent []interface{}
+		expectDiff   bool
+	}{
+		{name: "Omitted"},
+		{name: "Unchanged", routingAgent: []interface{}{map[string]interface{}{"enabled": true}}},
+		{name: "Disabled", routingAgent: []interface{}{map[string]interface{}{"enabled": false}}, expectDiff: true},
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// The state of a cluster read with the routing agent enabled by
+			// default.
+			state := &terraform.InstanceState{
+				ID: "cluster-id",
+				Attributes: map[string]string{
+					"name":                    "foo",
+					"region":                  "nyc1",
+					"version":                 "1.30.1-do.0",
+					"surge_upgrade":           "true",
+					"routing_agent.#":         "1",
+					"routing_agent.0.enabled": "true",
+					"node_pool.#":             "1",
+					"node_pool.0.name":        "default",
+					"node_pool.0.size":        "s-1vcpu-2gb",
+					"node_pool.0.node_count":  "1",
+				},
+			}
+			raw := map[string]interface{}{
+				"name":    "foo",
+				"region":  "nyc1",
+				"version": "1.30.1-do.0",
+				"node_pool": []interface{}{map[string]interface{}{
+					"name":       "default",
+					"size":       "s-1vcpu-2gb",
+					"node_count": 1,
+				}},
+			}
+			if c.routingAgent != nil {
+				raw["routing_agent"] = c.routingAgent
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attr *terraform.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes["routing_agent.0.enabled"]
+			}
+			if hasDiff := attr != nil && attr.Old != attr.New; hasDiff != c.expectDiff {
+				t.Fatalf("expected a routing agent diff to be %t, got %#v", c.expectDiff, attr)
+			}
+			if diff != nil && diff.RequiresNew() {
+				t.Errorf("expected the routing agent to be updated in place, got diff: %#v", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package loadbalancer
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -56,6 +57,12 @@ func DataSourceDigitalOceanLoadbalancer() *schema.Resource {
 				Computed:    true,
 				Description: "public-facing IP address of the load balancer",
 			},
+
+			"ipv6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "public-facing IPv6 address of the load balancer",
+			},
 			"algorithm": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -95,7 +102,7 @@ func DataSourceDigitalOceanLoadbalancer() *schema.Resource {
 						"certificate_id": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "the id of the tls certificate used for ssl termination if enabled",
+							Description: "the name of the tls certificate used for ssl termination if enabled, use certificate_name instead",
 						},
 						"certificate_name": {
 							Type:        schema.TypeString,
@@ -361,7 +368,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 	var foundLoadbalancer *godo.LoadBalancer
 
 	if id, ok := d.GetOk("id"); ok {
-		loadbalancer, _, err := client.LoadBalancers.Get(context.Background(), id.(string))
+		loadbalancer, _, err := client.LoadBalancers.Get(ctx, id.(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -376,7 +383,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 		lbList := []godo.LoadBalancer{}
 
 		for {
-			lbs, resp, err := client.LoadBalancers.List(context.Background(), opts)
+			lbs, resp, err := client.LoadBalancers.List(ctx, opts)
 
 			if err != nil {
 				return diag.Errorf("Error retrieving load balancers: %s", err)
@@ -419,6 +426,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 	}
 
 	d.Set("ip", foundLoadbalancer.IP)
+	d.Set("ipv6", foundLoadbalancer.IPv6)
 	d.Set("algorithm", foundLoadbalancer.Algorithm)
 	d.Set("status", foundLoadbalancer.Status)
 	d.Set("droplet_tag", foundLoadbalancer.Tag)
@@ -490,5 +498,10 @@ func findLoadBalancerByName(lbs []godo.LoadBalancer, name string) (*godo.LoadBal
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no load balancer found with name %s", name)
 	}
-	return nil, fmt.Errorf("too many load balancers found with name %s (found %d, expected 1)", name, len(results))
+
+	ids := make([]string, len(results))
+	for i, lb := range results {
+		ids[i] = lb.ID
+	}
+	return nil, fmt.Errorf("too many load balancers found with name %s (found %d, expected 1): %s; use id to select one", name, len(results), strings.Join(ids, ", "))
 }
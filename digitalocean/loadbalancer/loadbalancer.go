@@ -5,18 +5,24 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func loadbalancerStateRefreshFunc(client *godo.Client, loadbalancerId string) resource.StateRefreshFunc {
+func loadbalancerStateRefreshFunc(ctx context.Context, client *godo.Client, loadbalancerId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		lb, _, err := client.LoadBalancers.Get(context.Background(), loadbalancerId)
+		lb, _, err := client.LoadBalancers.Get(ctx, loadbalancerId)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in LoadbalancerStateRefreshFunc to DigitalOcean for Load Balancer '%s': %s", loadbalancerId, err)
 		}
@@ -25,6 +31,50 @@ func loadbalancerStateRefreshFunc(client *godo.Client, loadbalancerId string) re
 	}
 }
 
+// waitForLoadBalancerActive waits up to the timeout for the load balancer to
+// be active, failing as soon as it is errored as it will not recover.
+func waitForLoadBalancerActive(ctx context.Context, client *godo.Client, loadbalancerId string, timeout time.Duration) error {
+	refresh := loadbalancerStateRefreshFunc(ctx, client, loadbalancerId)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"new"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			lb, status, err := refresh()
+			if err == nil && status == "errored" {
+				return lb, status, loadBalancerErroredError(lb.(*godo.LoadBalancer))
+			}
+			return lb, status, err
+		},
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// loadBalancerErroredError describes a load balancer that failed to be
+// provisioned. The API does not return the reason, so the settings it was
+// provisioned with are included to tell which one was rejected.
+func loadBalancerErroredError(lb *godo.LoadBalancer) error {
+	details := []string{}
+	if lb.Region != nil {
+		details = append(details, fmt.Sprintf("region %s", lb.Region.Slug))
+	}
+	if lb.SizeUnit > 0 {
+		details = append(details, fmt.Sprintf("size_unit %d", lb.SizeUnit))
+	} else if lb.SizeSlug != "" {
+		details = append(details, fmt.Sprintf("size %s", lb.SizeSlug))
+	}
+	if lb.Network != "" {
+		details = append(details, fmt.Sprintf("network %s", lb.Network))
+	}
+	if lb.VPCUUID != "" {
+		details = append(details, fmt.Sprintf("vpc_uuid %s", lb.VPCUUID))
+	}
+
+	return fmt.Errorf("Load Balancer (%s) failed to be provisioned and is errored (%s)", lb.ID, strings.Join(details, ", "))
+}
+
 func expandStickySessions(config []interface{}) *godo.StickySessions {
 	stickysessionConfig := config[0].(map[string]interface{})
 
@@ -43,11 +93,16 @@ func expandStickySessions(config []interface{}) *godo.StickySessions {
 	return stickySession
 }
 
+// expandLBFirewall returns an empty firewall, not nil, when the block is not
+// set or empty so that removing the block clears the firewall rules.
 func expandLBFirewall(config []interface{}) *godo.LBFirewall {
-	firewallConfig := config[0].(map[string]interface{})
-
 	firewall := &godo.LBFirewall{}
 
+	if len(config) == 0 || config[0] == nil {
+		return firewall
+	}
+	firewallConfig := config[0].(map[string]interface{})
+
 	if v, ok := firewallConfig["allow"]; ok {
 		allows := make([]string, 0, len(v.([]interface{})))
 		for _, val := range v.([]interface{}) {
@@ -67,6 +122,31 @@ func expandLBFirewall(config []interface{}) *godo.LBFirewall {
 	return firewall
 }
 
+// validateLBFirewallRule validates that a firewall rule is either an IP
+// address prefixed with "ip:" or a CIDR block prefixed with "cidr:".
+func validateLBFirewallRule(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(value, "ip:"):
+		if net.ParseIP(strings.TrimPrefix(value, "ip:")) == nil {
+			errors = append(errors, fmt.Errorf("%q must contain a valid IP address after \"ip:\", got %q", k, value))
+		}
+	case strings.HasPrefix(value, "cidr:"):
+		if _, _, err := net.ParseCIDR(strings.TrimPrefix(value, "cidr:")); err != nil {
+			errors = append(errors, fmt.Errorf("%q must contain a valid CIDR block after \"cidr:\", got %q", k, value))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("%q must be in the form \"ip:1.2.3.4\" or \"cidr:1.2.0.0/16\", got %q", k, value))
+	}
+
+	return
+}
+
 func expandHealthCheck(config []interface{}) *godo.HealthCheck {
 	healthcheckConfig := config[0].(map[string]interface{})
 
@@ -86,9 +166,74 @@ func expandHealthCheck(config []interface{}) *godo.HealthCheck {
 	return healthcheck
 }
 
+// certificateNames caches the names of certificates by ID, so that the
+// forwarding rules of a load balancer sharing a certificate only look it up
+// once. The ID of a Let's Encrypt certificate changes when it is renewed, but
+// the name of the certificate with a given ID never does, so the entries do
+// not expire.
+var certificateNames = &certificateNameCache{names: make(map[string]string)}
+
+type certificateNameCache struct {
+	mu    sync.RWMutex
+	names map[string]string
+}
+
+func (c *certificateNameCache) get(id string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	name, ok := c.names[id]
+	return name, ok
+}
+
+func (c *certificateNameCache) add(cert *godo.Certificate) {
+	if cert == nil || cert.ID == "" || cert.Name == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.names[cert.ID] = cert.Name
+}
+
+// lookupCertificateName returns the name of the certificate with the ID.
+func lookupCertificateName(client *godo.Client, id string) (string, error) {
+	if name, ok := certificateNames.get(id); ok {
+		return name, nil
+	}
+
+	cert, _, err := client.Certificates.Get(context.Background(), id)
+	if err != nil {
+		return "", err
+	}
+	certificateNames.add(cert)
+
+	return cert.Name, nil
+}
+
 func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.ForwardingRule, error) {
 	forwardingRules := make([]godo.ForwardingRule, 0, len(config))
 
+	// Certificates are looked up once by name, however many rules use them.
+	// They are not cached for longer, as the ID of a name changes when a
+	// Let's Encrypt certificate is renewed.
+	certsByName := make(map[string]*godo.Certificate)
+	findCertificateByName := func(name string) (*godo.Certificate, error) {
+		if cert, ok := certsByName[name]; ok {
+			return cert, nil
+		}
+
+		cert, err := certificate.FindCertificateByName(client, name)
+		if err != nil {
+			return nil, err
+		}
+		certsByName[name] = cert
+		certificateNames.add(cert)
+
+		return cert, nil
+	}
+
 	for _, rawRule := range config {
 		rule := rawRule.(map[string]interface{})
 
@@ -103,7 +248,7 @@ func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.Fo
 		if name, nameOk := rule["certificate_name"]; nameOk {
 			certName := name.(string)
 			if certName != "" {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := findCertificateByName(certName)
 				if err != nil {
 					return nil, err
 				}
@@ -118,7 +263,7 @@ func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.Fo
 			// certificate name as the primary identifier instead.
 			certName := id.(string)
 			if certName != "" {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := findCertificateByName(certName)
 				if err != nil {
 					if strings.Contains(err.Error(), "not found") {
 						log.Println("[DEBUG] Certificate not found looking up by name. Falling back to lookup by ID.")
@@ -126,6 +271,7 @@ func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.Fo
 						if err != nil {
 							return nil, err
 						}
+						certificateNames.add(cert)
 					} else {
 						return nil, err
 					}
@@ -152,11 +298,15 @@ func hashForwardingRules(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-",
 		strings.ToLower(m["target_protocol"].(string))))
 
-	if v, ok := m["certificate_id"]; ok {
-		if v.(string) == "" {
-			if name, nameOk := m["certificate_name"]; nameOk {
-				buf.WriteString(fmt.Sprintf("%s-", name.(string)))
-			}
+	// The certificate name is preferred as the certificate ID changes when a
+	// Let's Encrypt certificate is renewed, which would otherwise change the
+	// hash of the rule. Rules only configured with an ID use the name of the
+	// certificate if it has been read, which the state always holds.
+	if name, ok := m["certificate_name"]; ok && name.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", name.(string)))
+	} else if v, ok := m["certificate_id"]; ok && v.(string) != "" {
+		if name, ok := certificateNames.get(v.(string)); ok {
+			buf.WriteString(fmt.Sprintf("%s-", name))
 		} else {
 			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 		}
@@ -177,6 +327,67 @@ func flattenDropletIds(list []int) *schema.Set {
 	return flatSet
 }
 
+// removedDropletIDs returns the Droplet IDs present in old but not in new.
+func removedDropletIDs(old, new *schema.Set) []int {
+	removed := make([]int, 0)
+	for _, v := range old.Difference(new).List() {
+		removed = append(removed, v.(int))
+	}
+	sort.Ints(removed)
+
+	return removed
+}
+
+// loadBalancerSizeUnits are the size units equivalent to the legacy size
+// slugs.
+var loadBalancerSizeUnits = map[string]int{
+	"lb-small":  1,
+	"lb-medium": 3,
+	"lb-large":  6,
+}
+
+// loadBalancerSizeSlug returns the legacy size slug of a load balancer, derived
+// from its size unit if it has one, or an empty string if the size unit has no
+// equivalent slug.
+func loadBalancerSizeSlug(lb *godo.LoadBalancer) string {
+	if lb.SizeUnit == 0 {
+		return lb.SizeSlug
+	}
+
+	for slug, unit := range loadBalancerSizeUnits {
+		if uint32(unit) == lb.SizeUnit {
+			return slug
+		}
+	}
+
+	return ""
+}
+
+// dropletIDsConfigured returns whether droplet_ids is set in the
+// configuration, rather than resolved from droplet_tag, and whether the
+// configuration was available to tell.
+func dropletIDsConfigured(rawConfig cty.Value) (configured bool, ok bool) {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("droplet_ids") {
+		return false, false
+	}
+
+	return !rawConfig.GetAttr("droplet_ids").IsNull(), true
+}
+
+// waitForLoadBalancerDrain blocks for the given duration, or until ctx is done
+// such as when the update timeout is reached.
+func waitForLoadBalancerDrain(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func flattenHealthChecks(health *godo.HealthCheck) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 
@@ -216,7 +427,9 @@ func flattenStickySessions(session *godo.StickySessions) []map[string]interface{
 func flattenLBFirewall(firewall *godo.LBFirewall) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 
-	if firewall != nil {
+	// The API returns an empty firewall for load balancers without rules,
+	// which is flattened as no block to match configurations without one.
+	if firewall != nil && (len(firewall.Allow) > 0 || len(firewall.Deny) > 0) {
 		r := make(map[string]interface{})
 		r["allow"] = (*firewall).Allow
 		r["deny"] = (*firewall).Deny
@@ -243,12 +456,12 @@ func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([
 			// When the certificate type is lets_encrypt, the certificate
 			// ID will change when it's renewed, so we have to rely on the
 			// certificate name as the primary identifier instead.
-			cert, _, err := client.Certificates.Get(context.Background(), rule.CertificateID)
+			name, err := lookupCertificateName(client, rule.CertificateID)
 			if err != nil {
 				return nil, err
 			}
-			r["certificate_id"] = cert.Name
-			r["certificate_name"] = cert.Name
+			r["certificate_id"] = name
+			r["certificate_name"] = name
 		}
 
 		result = append(result, r)
@@ -257,7 +470,10 @@ func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([
 	return result, nil
 }
 
-func expandDomains(client *godo.Client, config []interface{}) ([]*godo.LBDomain, error) {
+// expandDomains also returns warnings for certificates that do not cover the
+// domain they are attached to.
+func expandDomains(client *godo.Client, config []interface{}) ([]*godo.LBDomain, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
 	domains := make([]*godo.LBDomain, 0, len(config))
 
 	for _, rawDomain := range config {
@@ -273,15 +489,16 @@ func expandDomains(client *godo.Client, config []interface{}) ([]*godo.LBDomain,
 			if certName != "" {
 				cert, err := certificate.FindCertificateByName(client, certName)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				r.CertificateID = cert.ID
+				diags = append(diags, certificate.CertificateDomainWarnings(cert, r.Name)...)
 			}
 		}
 		domains = append(domains, r)
 	}
 
-	return domains, nil
+	return domains, diags, nil
 }
 
 func expandGLBSettings(config []interface{}) *godo.GLBSettings {
@@ -332,12 +549,12 @@ func flattenDomains(client *godo.Client, domains []*godo.LBDomain) ([]map[string
 			// When the certificate type is lets_encrypt, the certificate
 			// ID will change when it's renewed, so we have to rely on the
 			// certificate name as the primary identifier instead.
-			cert, _, err := client.Certificates.Get(context.Background(), domain.CertificateID)
+			name, err := lookupCertificateName(client, domain.CertificateID)
 			if err != nil {
 				return nil, err
 			}
-			r["certificate_id"] = cert.Name
-			r["certificate_name"] = cert.Name
+			r["certificate_id"] = name
+			r["certificate_name"] = name
 		}
 		result = append(result, r)
 	}
@@ -0,0 +1,381 @@
+package loadbalancer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestRemovedDropletIDs(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      []interface{}
+		new      []interface{}
+		expected []int
+	}{
+		{name: "unchanged", old: []interface{}{1, 2}, new: []interface{}{1, 2}, expected: []int{}},
+		{name: "added", old: []interface{}{1}, new: []interface{}{1, 2}, expected: []int{}},
+		{name: "removed", old: []interface{}{3, 1, 2}, new: []interface{}{2}, expected: []int{1, 3}},
+		{name: "replaced", old: []interface{}{1}, new: []interface{}{2}, expected: []int{1}},
+		{name: "all removed", old: []interface{}{1, 2}, new: []interface{}{}, expected: []int{1, 2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := removedDropletIDs(schema.NewSet(schema.HashInt, c.old), schema.NewSet(schema.HashInt, c.new))
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestDropletIDsConfigured(t *testing.T) {
+	objType := cty.Object(map[string]cty.Type{
+		"droplet_ids": cty.Set(cty.Number),
+		"droplet_tag": cty.String,
+	})
+
+	cases := []struct {
+		name       string
+		rawConfig  cty.Value
+		configured bool
+		ok         bool
+	}{
+		{name: "unavailable", rawConfig: cty.NullVal(objType)},
+		{name: "unknown", rawConfig: cty.UnknownVal(objType)},
+		{
+			name: "ids",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"droplet_ids": cty.SetVal([]cty.Value{cty.NumberIntVal(1)}),
+				"droplet_tag": cty.NullVal(cty.String),
+			}),
+			configured: true,
+			ok:         true,
+		},
+		{
+			name: "tag",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"droplet_ids": cty.NullVal(cty.Set(cty.Number)),
+				"droplet_tag": cty.StringVal("web"),
+			}),
+			ok: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			configured, ok := dropletIDsConfigured(c.rawConfig)
+			if configured != c.configured || ok != c.ok {
+				t.Errorf("expected (%t, %t), got (%t, %t)", c.configured, c.ok, configured, ok)
+			}
+		})
+	}
+}
+
+func TestWaitForLoadBalancerDrain(t *testing.T) {
+	start := time.Now()
+	if err := waitForLoadBalancerDrain(context.Background(), 50*time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected to wait at least 50ms, waited %s", elapsed)
+	}
+
+	// The wait is cut short when the context is done, e.g. on timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start = time.Now()
+	if err := waitForLoadBalancerDrain(ctx, time.Minute); err != context.DeadlineExceeded {
+		t.Errorf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the wait to stop with the context, waited %s", elapsed)
+	}
+}
+
+func TestWaitForLoadBalancerActiveTimeout(t *testing.T) {
+	// The load balancer never becomes active.
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"load_balancer":{"id":"lb-id","status":"new"}}`)
+	})).GodoClient()
+
+	r := ResourceDigitalOceanLoadbalancer()
+	r.Timeouts.Create = godo.PtrTo(100 * time.Millisecond)
+	d := r.Data(&terraform.InstanceState{ID: "lb-id"})
+
+	start := time.Now()
+	err := waitForLoadBalancerActive(context.Background(), client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err == nil || !strings.Contains(err.Error(), "timeout while waiting for state to become 'active'") {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the configured timeout to be honored, waited %s", elapsed)
+	}
+}
+
+func TestWaitForLoadBalancerActiveErrored(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"load_balancer":{"id":"lb-id","status":"errored","size_unit":2,"region":{"slug":"nyc3"},"network":"EXTERNAL"}}`)
+	})).GodoClient()
+
+	start := time.Now()
+	err := waitForLoadBalancerActive(context.Background(), client, "lb-id", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "Load Balancer (lb-id) failed to be provisioned and is errored (region nyc3, size_unit 2, network EXTERNAL)") {
+		t.Fatalf("expected the errored load balancer to be reported, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected not to wait for an errored load balancer, waited %s", elapsed)
+	}
+}
+
+// newCertificateTestClient returns a client for a fake API serving Let's
+// Encrypt certificates renewed by ID, all with the same name.
+func newCertificateTestClient(t *testing.T, ids ...string) *godo.Client {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, id := range ids {
+			if r.URL.Path == "/v2/certificates/"+id {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"certificate":{"id":"%s","name":"web-cert","type":"lets_encrypt"}}`, id)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+	})).GodoClient()
+	return client
+}
+
+func TestForwardingRulesCertificateRenewal(t *testing.T) {
+	before := "1f2a1e0b-4f8b-4b65-9a4f-0d3c2bb4d1a1"
+	after := "8c3d5f52-9d35-44e4-8b0c-54c1f5e0c0b2"
+	client := newCertificateTestClient(t, before, after)
+
+	apiRule := func(certificateID string) []godo.ForwardingRule {
+		return []godo.ForwardingRule{{
+			EntryProtocol:  "https",
+			EntryPort:      443,
+			TargetProtocol: "http",
+			TargetPort:     80,
+			CertificateID:  certificateID,
+		}}
+	}
+
+	// The rules as configured, by certificate_name or the deprecated
+	// certificate_id set to the name.
+	configured := []map[string]interface{}{
+		{"entry_protocol": "https", "entry_port": 443, "target_protocol": "http", "target_port": 80, "certificate_name": "web-cert", "certificate_id": "", "tls_passthrough": false},
+		{"entry_protocol": "https", "entry_port": 443, "target_protocol": "http", "target_port": 80, "certificate_name": "", "certificate_id": "web-cert", "tls_passthrough": false},
+	}
+
+	var hashes []int
+	for _, id := range []string{before, after} {
+		rules, err := flattenForwardingRules(client, apiRule(id))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if rules[0]["certificate_name"] != "web-cert" || rules[0]["certificate_id"] != "web-cert" {
+			t.Errorf("expected the certificate name to be stored, got: %#v", rules[0])
+		}
+		hashes = append(hashes, hashForwardingRules(rules[0]))
+	}
+
+	if hashes[0] != hashes[1] {
+		t.Errorf("expected the renewal not to change the forwarding rule, hashes %d and %d", hashes[0], hashes[1])
+	}
+	for _, c := range configured {
+		if got := hashForwardingRules(c); got != hashes[1] {
+			t.Errorf("expected configured rule %#v to match the state, hashes %d and %d", c, got, hashes[1])
+		}
+	}
+}
+
+func TestForwardingRulesCertificateByID(t *testing.T) {
+	id := "3b9c1f0e-7d2a-4c55-b1e4-6a0f9d2c7e13"
+	var gets, lists int
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/certificates/"+id:
+			gets++
+			fmt.Fprintf(w, `{"certificate":{"id":"%s","name":"api-cert","type":"custom"}}`, id)
+		case r.URL.Path == "/v2/certificates" && r.URL.Query().Get("name") == "api-cert":
+			lists++
+			fmt.Fprintf(w, `{"certificates":[{"id":"%s","name":"api-cert","type":"custom"}],"links":{}}`, id)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	})).GodoClient()
+
+	// A rule configured with the ID of the certificate, as is done with the
+	// deprecated certificate_id, hashes like the state once the certificate
+	// has been read.
+	configured := map[string]interface{}{"entry_protocol": "https", "entry_port": 443, "target_protocol": "http", "target_port": 80, "certificate_name": "", "certificate_id": id, "tls_passthrough": false}
+
+	apiRules := make([]godo.ForwardingRule, 0, 3)
+	for _, port := range []int{443, 8443, 9443} {
+		apiRules = append(apiRules, godo.ForwardingRule{
+			EntryProtocol:  "https",
+			EntryPort:      port,
+			TargetProtocol: "http",
+			TargetPort:     80,
+			CertificateID:  id,
+		})
+	}
+
+	rules, err := flattenForwardingRules(client, apiRules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gets != 1 {
+		t.Errorf("expected the certificate to be looked up once for all rules, got %d lookups", gets)
+	}
+	if got, expected := hashForwardingRules(configured), hashForwardingRules(rules[0]); got != expected {
+		t.Errorf("expected the configured rule to match the state, hashes %d and %d", got, expected)
+	}
+
+	byName := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		byName = append(byName, map[string]interface{}(rule))
+	}
+	expanded, err := expandForwardingRules(client, byName)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lists != 1 {
+		t.Errorf("expected the certificate to be looked up once by name for all rules, got %d lookups", lists)
+	}
+	for _, rule := range expanded {
+		if rule.CertificateID != id {
+			t.Errorf("expected the certificate ID %s to be sent, got %#v", id, rule)
+		}
+	}
+}
+
+func TestValidateLBFirewallRule(t *testing.T) {
+	cases := []struct {
+		value       string
+		expectError bool
+	}{
+		{"ip:1.2.3.4", false},
+		{"ip:2001:db8::1", false},
+		{"cidr:1.2.0.0/16", false},
+		{"cidr:2001:db8::/32", false},
+		{"1.2.3.4", true},
+		{"ip:1.2.3.0/24", true},
+		{"cidr:1.2.3.4", true},
+		{"ip:", true},
+		{"host:example.com", true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLBFirewallRule(tc.value, "allow")
+		if hasError := len(errors) > 0; hasError != tc.expectError {
+			t.Errorf("%q: expected error to be %t, got %v", tc.value, tc.expectError, errors)
+		}
+	}
+}
+
+func TestExpandLBFirewallRemoved(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanLoadbalancer().Schema, map[string]interface{}{})
+
+	firewall := expandLBFirewall(d.Get("firewall").(*schema.Set).List())
+	if firewall == nil {
+		t.Fatal("expected an empty firewall to be sent when the block is removed")
+	}
+
+	b, err := json.Marshal(struct {
+		Firewall *godo.LBFirewall `json:"firewall,omitempty"`
+	}{firewall})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"firewall":{}}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestFlattenLBFirewall(t *testing.T) {
+	cases := []struct {
+		name     string
+		firewall *godo.LBFirewall
+		expected []map[string]interface{}
+	}{
+		{"Missing", nil, []map[string]interface{}{}},
+		{"Empty", &godo.LBFirewall{}, []map[string]interface{}{}},
+		{
+			"Set",
+			&godo.LBFirewall{Allow: []string{"ip:1.2.3.4"}, Deny: []string{"cidr:1.2.0.0/16"}},
+			[]map[string]interface{}{{
+				"allow": []string{"ip:1.2.3.4"},
+				"deny":  []string{"cidr:1.2.0.0/16"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := flattenLBFirewall(tc.firewall)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFindLoadBalancerByName(t *testing.T) {
+	lbs := []godo.LoadBalancer{
+		{ID: "lb-1", Name: "web"},
+		{ID: "lb-2", Name: "api"},
+		{ID: "lb-3", Name: "api"},
+	}
+
+	lb, err := findLoadBalancerByName(lbs, "web")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lb.ID != "lb-1" {
+		t.Errorf("expected lb-1, got %s", lb.ID)
+	}
+
+	if _, err := findLoadBalancerByName(lbs, "db"); err == nil {
+		t.Error("expected an error when no load balancer has the name")
+	}
+
+	_, err = findLoadBalancerByName(lbs, "api")
+	if err == nil || !strings.Contains(err.Error(), "lb-2, lb-3") {
+		t.Errorf("expected an error listing the candidate IDs, got: %v", err)
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpc"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -28,17 +30,33 @@ func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 3,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceDigitalOceanLoadBalancerV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: migrateLoadBalancerStateV0toV1,
 				Version: 0,
 			},
+			{
+				Type:    (&schema.Resource{Schema: resourceDigitalOceanLoadBalancerV1()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: migrateLoadBalancerStateV1toV2,
+				Version: 1,
+			},
+			{
+				Type:    (&schema.Resource{Schema: resourceDigitalOceanLoadBalancerV1()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: migrateLoadBalancerStateV2toV3,
+				Version: 2,
+			},
 		},
 
 		Schema: resourceDigitalOceanLoadBalancerV1(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			if _, hasHealthCheck := diff.GetOk("healthcheck"); hasHealthCheck {
@@ -86,7 +104,19 @@ func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 				return err
 			}
 
-			return nil
+			if err := loadbalancerNetworkDiffCheck(ctx, diff, v); err != nil {
+				return err
+			}
+
+			if err := loadbalancerDropletsDiffCheck(ctx, diff, v); err != nil {
+				return err
+			}
+
+			if err := loadbalancerSizeDiffCheck(ctx, diff, v); err != nil {
+				return err
+			}
+
+			return vpc.CustomizeDiffVPCName("vpc_uuid")(ctx, diff, v)
 		},
 	}
 }
@@ -132,10 +162,102 @@ func loadbalancerDiffCheck(ctx context.Context, d *schema.ResourceDiff, v interf
 		if regionSet && region.(string) != "" {
 			return fmt.Errorf("'region' must be empty or not set when 'type' is '%s'", typStr)
 		}
-	case "REGIONAL":
-		if !regionSet || region.(string) == "" {
+		if _, ok := d.GetOk("forwarding_rule"); ok {
+			return fmt.Errorf("'forwarding_rule' is not allowed when 'type' is '%s', use 'glb_settings' instead", typStr)
+		}
+		if _, ok := d.GetOk("glb_settings"); !ok {
+			return fmt.Errorf("'glb_settings' must be set when 'type' is '%s'", typStr)
+		}
+	case "REGIONAL", "":
+		if typStr != "" && (!regionSet || region.(string) == "") {
 			return fmt.Errorf("'region' must be set and not be empty when 'type' is '%s'", typStr)
 		}
+		// glb_settings, domains and target_load_balancer_ids are computed,
+		// so only set values which are changed are rejected.
+		for _, key := range []string{"glb_settings", "domains", "target_load_balancer_ids"} {
+			if _, ok := d.GetOk(key); ok && d.HasChange(key) {
+				return fmt.Errorf("'%s' is only allowed when 'type' is 'GLOBAL'", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadbalancerNetworkDiffCheck rejects configurations of INTERNAL load
+// balancers which require them to be reachable from the internet. Let's
+// Encrypt certificates can only be checked once they exist, so certificates
+// that cannot be looked up while planning are left to the API.
+func loadbalancerNetworkDiffCheck(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !strings.EqualFold(d.Get("network").(string), godo.LoadBalancerNetworkTypeInternal) {
+		return nil
+	}
+
+	if strings.EqualFold(d.Get("type").(string), "GLOBAL") {
+		return fmt.Errorf("'network' must be 'EXTERNAL' when 'type' is 'GLOBAL'")
+	}
+
+	if meta == nil {
+		return nil
+	}
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	for _, rawRule := range d.Get("forwarding_rule").(*schema.Set).List() {
+		rule := rawRule.(map[string]interface{})
+
+		var cert *godo.Certificate
+		if name := rule["certificate_name"].(string); name != "" {
+			found, err := certificate.FindCertificateByName(client, name)
+			if err != nil {
+				log.Printf("[DEBUG] Unable to find certificate %q while planning, it is checked when applying: %s", name, err)
+				continue
+			}
+			cert = found
+		} else if id := rule["certificate_id"].(string); id != "" {
+			found, _, err := client.Certificates.Get(ctx, id)
+			if err != nil {
+				log.Printf("[DEBUG] Unable to find certificate (%s) while planning, it is checked when applying: %s", id, err)
+				continue
+			}
+			cert = found
+		}
+
+		if cert != nil && cert.Type == "lets_encrypt" {
+			return fmt.Errorf("certificate %q is a Let's Encrypt certificate, which requires the load balancer to be reachable from the internet and cannot be used when 'network' is 'INTERNAL'", cert.Name)
+		}
+	}
+
+	return nil
+}
+
+// loadbalancerDropletsDiffCheck rejects configurations setting both
+// droplet_tag and droplet_ids. When droplet_ids is not configured, it is
+// resolved by the API from droplet_tag, so it is only expected to change when
+// droplet_tag does.
+func loadbalancerDropletsDiffCheck(ctx context.Context, d *schema.ResourceDiff, v interface{}) error {
+	idsConfigured, ok := dropletIDsConfigured(d.GetRawConfig())
+	if !ok {
+		return nil
+	}
+	tagSet := !d.NewValueKnown("droplet_tag") || d.Get("droplet_tag").(string) != ""
+
+	if idsConfigured && tagSet {
+		return fmt.Errorf("only one of 'droplet_tag' or 'droplet_ids' may be set")
+	}
+
+	if !idsConfigured && d.Id() != "" && d.HasChange("droplet_tag") {
+		return d.SetNewComputed("droplet_ids")
+	}
+
+	return nil
+}
+
+// loadbalancerSizeDiffCheck marks size_unit as computed when the load balancer
+// is resized with the legacy size slug, as the API then resizes it to the
+// equivalent size unit.
+func loadbalancerSizeDiffCheck(ctx context.Context, d *schema.ResourceDiff, v interface{}) error {
+	if d.Id() != "" && d.HasChange("size") && d.Get("size").(string) != "" {
+		return d.SetNewComputed("size_unit")
 	}
 
 	return nil
@@ -161,26 +283,20 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 					"lb-medium",
 					"lb-large",
 				}, false),
+				ConflictsWith: []string{"size_unit"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					if sizeUnit, ok := d.GetOk("size_unit"); ok {
-						switch {
-						case new == "lb-small" && sizeUnit.(int) == 1:
-							return true
-						case new == "lb-medium" && sizeUnit.(int) == 3:
-							return true
-						case new == "lb-large" && sizeUnit.(int) == 6:
-							return true
-						}
-						return false
+						return loadBalancerSizeUnits[new] == sizeUnit.(int)
 					}
 					return old == new
 				},
 			},
 			"size_unit": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IntBetween(1, 100),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntBetween(1, 100),
+				ConflictsWith: []string{"size"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -355,6 +471,7 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: util.CaseSensitive,
 				ValidateFunc:     tag.ValidateTag,
+				ConflictsWith:    []string{"droplet_ids"},
 			},
 
 			"redirect_http_to_https": {
@@ -389,11 +506,18 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"vpc_name": vpc.VPCNameSchema("vpc_uuid"),
+
 			"ip": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -405,6 +529,13 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				Computed: true,
 			},
 
+			"drain_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+
 			"project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -414,19 +545,24 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 			"firewall": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allow": {
-							Type:        schema.TypeList,
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type: schema.TypeList,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLBFirewallRule,
+							},
 							Optional:    true,
 							Description: "the rules for ALLOWING traffic to the LB (strings in the form: 'ip:1.2.3.4' or 'cidr:1.2.0.0/16')",
 						},
 						"deny": {
-							Type:        schema.TypeList,
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type: schema.TypeList,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLBFirewallRule,
+							},
 							Optional:    true,
 							Description: "the rules for DENYING traffic to the LB (strings in the form: 'ip:1.2.3.4' or 'cidr:1.2.0.0/16')",
 						},
@@ -437,9 +573,14 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"REGIONAL", "GLOBAL"}, true),
-				Description:  "the type of the load balancer (GLOBAL or REGIONAL)",
+				StateFunc: func(val interface{}) string {
+					// The API returns the type in upper case
+					return strings.ToUpper(val.(string))
+				},
+				Description: "the type of the load balancer (GLOBAL or REGIONAL)",
 			},
 
 			"domains": {
@@ -520,12 +661,14 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 						"failover_threshold": {
 							Type:         schema.TypeInt,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.IntBetween(1, 99),
 							Description:  "fail-over threshold",
 						},
 						"cdn": {
 							Type:        schema.TypeList,
 							Optional:    true,
+							Computed:    true,
 							MaxItems:    1,
 							Description: "CDN specific configurations",
 							Elem: &schema.Resource{
@@ -554,9 +697,14 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 			"network": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL", "INTERNAL"}, true),
-				Description:  "the type of network the load balancer is accessible from (EXTERNAL or INTERNAL)",
+				StateFunc: func(val interface{}) string {
+					// The API returns the network type in upper case
+					return strings.ToUpper(val.(string))
+				},
+				Description: "the type of network the load balancer is accessible from (EXTERNAL or INTERNAL)",
 			},
 		},
 	}
@@ -573,28 +721,98 @@ func migrateLoadBalancerStateV0toV1(ctx context.Context, rawState map[string]int
 	// When the certificate type is lets_encrypt, the certificate
 	// ID will change when it's renewed, so we have to rely on the
 	// certificate name as the primary identifier instead.
-	for _, forwardingRule := range rawState["forwarding_rule"].([]interface{}) {
-		fw := forwardingRule.(map[string]interface{})
-		if fw["certificate_id"].(string) == "" {
+	if err := migrateForwardingRuleCertificates(ctx, client, rawState); err != nil {
+		return rawState, err
+	}
+
+	return rawState, nil
+}
+
+// migrateLoadBalancerStateV1toV2 stores the certificate name in both
+// certificate_name and certificate_id of forwarding rules that were stored
+// with only a certificate ID.
+func migrateLoadBalancerStateV1toV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if len(rawState) == 0 {
+		log.Println("[DEBUG] Empty state; nothing to migrate.")
+		return rawState, nil
+	}
+	log.Println("[DEBUG] Migrating load balancer schema from v1 to v2.")
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := migrateForwardingRuleCertificates(ctx, client, rawState); err != nil {
+		return rawState, err
+	}
+
+	return rawState, nil
+}
+
+// migrateLoadBalancerStateV2toV3 converts a stored legacy size slug into the
+// equivalent size_unit, so that load balancers switched to size_unit do not
+// keep both in their state.
+func migrateLoadBalancerStateV2toV3(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if len(rawState) == 0 {
+		log.Println("[DEBUG] Empty state; nothing to migrate.")
+		return rawState, nil
+	}
+	log.Println("[DEBUG] Migrating load balancer schema from v2 to v3.")
+
+	size, _ := rawState["size"].(string)
+	sizeUnit, ok := loadBalancerSizeUnits[size]
+	if !ok {
+		return rawState, nil
+	}
+
+	if unit, _ := rawState["size_unit"].(float64); unit == 0 {
+		rawState["size_unit"] = sizeUnit
+	}
+	rawState["size"] = ""
+
+	return rawState, nil
+}
+
+func migrateForwardingRuleCertificates(ctx context.Context, client *godo.Client, rawState map[string]interface{}) error {
+	rules, _ := rawState["forwarding_rule"].([]interface{})
+	for _, forwardingRule := range rules {
+		fw, ok := forwardingRule.(map[string]interface{})
+		if !ok {
 			continue
 		}
 
-		cert, _, err := client.Certificates.Get(context.Background(), fw["certificate_id"].(string))
-		if err != nil {
-			return rawState, err
+		name, _ := fw["certificate_name"].(string)
+		id, _ := fw["certificate_id"].(string)
+		if name == "" && id != "" {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				// The ID is already the name of the certificate.
+				name = id
+			} else {
+				cert, resp, err := client.Certificates.Get(ctx, id)
+				if err != nil {
+					// The certificate was renewed since the state was last
+					// refreshed. The current one is found when it is read.
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						log.Printf("[DEBUG] Certificate (%s) not found, leaving it to be refreshed", id)
+						continue
+					}
+					return err
+				}
+				name = cert.Name
+			}
 		}
 
-		fw["certificate_id"] = cert.Name
-		fw["certificate_name"] = cert.Name
+		if name != "" {
+			fw["certificate_id"] = name
+			fw["certificate_name"] = name
+		}
 	}
 
-	return rawState, nil
+	return nil
 }
 
-func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*godo.LoadBalancerRequest, error) {
+func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*godo.LoadBalancerRequest, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
 	forwardingRules, err := expandForwardingRules(client, d.Get("forwarding_rule").(*schema.Set).List())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	opts := &godo.LoadBalancerRequest{
@@ -608,11 +826,13 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 		DisableLetsEncryptDNSRecords: godo.Bool(d.Get("disable_lets_encrypt_dns_records").(bool)),
 		ProjectID:                    d.Get("project_id").(string),
 	}
-	sizeUnit, ok := d.GetOk("size_unit")
-	if ok {
+	// Only one of the size slug and size unit is sent, as the API resolves
+	// the two unpredictably. size_unit is computed, so it is also set when
+	// the load balancer is sized with the legacy size slug.
+	if size := d.Get("size").(string); size != "" {
+		opts.SizeSlug = size
+	} else if sizeUnit, ok := d.GetOk("size_unit"); ok {
 		opts.SizeUnit = uint32(sizeUnit.(int))
-	} else {
-		opts.SizeSlug = d.Get("size").(string)
 	}
 
 	idleTimeout, ok := d.GetOk("http_idle_timeout_seconds")
@@ -621,9 +841,11 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 		opts.HTTPIdleTimeoutSeconds = &t
 	}
 
+	// droplet_ids is only sent when it is configured, as otherwise it holds
+	// the Droplets resolved from droplet_tag, which may have been removed.
 	if v, ok := d.GetOk("droplet_tag"); ok {
 		opts.Tag = v.(string)
-	} else if v, ok := d.GetOk("droplet_ids"); ok {
+	} else if v, ok := d.GetOk("droplet_ids"); ok && configuredDropletIDs(d) {
 		var droplets []int
 		for _, id := range v.(*schema.Set).List() {
 			droplets = append(droplets, id.(int))
@@ -640,9 +862,8 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 		opts.StickySessions = expandStickySessions(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("firewall"); ok {
-		opts.Firewall = expandLBFirewall(v.(*schema.Set).List())
-	}
+	// The firewall is always sent so that removing the block clears the rules.
+	opts.Firewall = expandLBFirewall(d.Get("firewall").(*schema.Set).List())
 
 	if v, ok := d.GetOk("vpc_uuid"); ok {
 		opts.VPCUUID = v.(string)
@@ -653,12 +874,13 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 	}
 
 	if v, ok := d.GetOk("domains"); ok {
-		domains, err := expandDomains(client, v.(*schema.Set).List())
+		domains, domainDiags, err := expandDomains(client, v.(*schema.Set).List())
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		opts.Domains = domains
+		diags = append(diags, domainDiags...)
 	}
 
 	if v, ok := d.GetOk("glb_settings"); ok {
@@ -678,7 +900,14 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 		opts.Network = v.(string)
 	}
 
-	return opts, nil
+	return opts, diags, nil
+}
+
+// configuredDropletIDs returns whether droplet_ids is set in the
+// configuration, assuming it is when the configuration is not available.
+func configuredDropletIDs(d *schema.ResourceData) bool {
+	configured, ok := dropletIDsConfigured(d.GetRawConfig())
+	return configured || !ok
 }
 
 func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -686,13 +915,19 @@ func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] Create a Loadbalancer Request")
 
-	lbOpts, err := buildLoadBalancerRequest(client, d)
+	lbOpts, diags, err := buildLoadBalancerRequest(client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	vpcID, err := vpc.ExpandVPCID(ctx, d, meta, "vpc_uuid")
+	if err != nil {
+		return diag.Errorf("Error resolving vpc_name: %s", err)
+	}
+	lbOpts.VPCUUID = vpcID
+
 	log.Printf("[DEBUG] Loadbalancer Create: %#v", lbOpts)
-	loadbalancer, _, err := client.LoadBalancers.Create(context.Background(), lbOpts)
+	loadbalancer, _, err := client.LoadBalancers.Create(ctx, lbOpts)
 	if err != nil {
 		return diag.Errorf("Error creating Load Balancer: %s", err)
 	}
@@ -700,18 +935,11 @@ func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.Resou
 	d.SetId(loadbalancer.ID)
 
 	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become active", d.Get("name"))
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"new"},
-		Target:     []string{"active"},
-		Refresh:    loadbalancerStateRefreshFunc(client, d.Id()),
-		Timeout:    10 * time.Minute,
-		MinTimeout: 15 * time.Second,
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if err := waitForLoadBalancerActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("Error waiting for Load Balancer (%s) to become active: %s", d.Get("name"), err)
 	}
 
-	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
+	return append(diags, resourceDigitalOceanLoadbalancerRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -730,7 +958,12 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 
 	d.Set("name", loadbalancer.Name)
 	d.Set("urn", loadbalancer.URN())
-	d.Set("ip", loadbalancer.IP)
+	// The addresses are only known once the load balancer is active, so the
+	// previous ones are kept while it is being provisioned.
+	if loadbalancer.Status == "active" {
+		d.Set("ip", loadbalancer.IP)
+		d.Set("ipv6", loadbalancer.IPv6)
+	}
 	d.Set("status", loadbalancer.Status)
 	d.Set("algorithm", loadbalancer.Algorithm)
 	d.Set("redirect_http_to_https", loadbalancer.RedirectHttpToHttps)
@@ -740,11 +973,16 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 	d.Set("vpc_uuid", loadbalancer.VPCUUID)
 	d.Set("http_idle_timeout_seconds", loadbalancer.HTTPIdleTimeoutSeconds)
 	d.Set("project_id", loadbalancer.ProjectID)
+	d.Set("network", loadbalancer.Network)
+	d.Set("type", loadbalancer.Type)
 
 	if loadbalancer.SizeUnit > 0 {
 		d.Set("size_unit", loadbalancer.SizeUnit)
-	} else {
-		d.Set("size", loadbalancer.SizeSlug)
+	}
+	// The legacy size slug is only stored when it is configured, so that a
+	// load balancer switched to size_unit does not have both in its state.
+	if d.Get("size").(string) != "" || loadbalancer.SizeUnit == 0 {
+		d.Set("size", loadBalancerSizeSlug(loadbalancer))
 	}
 
 	if loadbalancer.Region != nil {
@@ -753,6 +991,9 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 
 	d.Set("disable_lets_encrypt_dns_records", loadbalancer.DisableLetsEncryptDNSRecords)
 
+	// The API returns the Droplets resolved from the tag when one is set, so
+	// droplet_ids always reflects the current membership. It is only compared
+	// against the configuration when droplet_tag is not set.
 	if err := d.Set("droplet_ids", flattenDropletIds(loadbalancer.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Load Balancer droplet_ids - error: %#v", err)
 	}
@@ -778,31 +1019,80 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("[DEBUG] Error setting Load Balancer firewall - error: %#v", err)
 	}
 
+	domains, err := flattenDomains(client, loadbalancer.Domains)
+	if err != nil {
+		return diag.Errorf("[DEBUG] Error building Load Balancer domains - error: %#v", err)
+	}
+
+	// Domains are identified by their certificate_name in the resource.
+	for _, domain := range domains {
+		delete(domain, "certificate_id")
+	}
+
+	if err := d.Set("domains", domains); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Load Balancer domains - error: %#v", err)
+	}
+
+	if err := d.Set("glb_settings", flattenGLBSettings(loadbalancer.GLBSettings)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Load Balancer glb_settings - error: %#v", err)
+	}
+
+	if err := d.Set("target_load_balancer_ids", flattenLoadBalancerIds(loadbalancer.TargetLoadBalancerIDs)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Load Balancer target_load_balancer_ids - error: %#v", err)
+	}
+
 	return nil
 }
 
 func resourceDigitalOceanLoadbalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	lbOpts, err := buildLoadBalancerRequest(client, d)
+	// Connections are only drained from Droplets removed from droplet_ids,
+	// not from those resolved from droplet_tag.
+	var removedIDs []int
+	if d.Get("droplet_tag").(string) == "" {
+		oldIDs, newIDs := d.GetChange("droplet_ids")
+		removedIDs = removedDropletIDs(oldIDs.(*schema.Set), newIDs.(*schema.Set))
+	}
+
+	if err := vpc.CheckVPCNameChange(ctx, d, meta, "vpc_uuid"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	lbOpts, diags, err := buildLoadBalancerRequest(client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Load Balancer Update: %#v", lbOpts)
-	_, _, err = client.LoadBalancers.Update(context.Background(), d.Id(), lbOpts)
+	_, _, err = client.LoadBalancers.Update(ctx, d.Id(), lbOpts)
 	if err != nil {
 		return diag.Errorf("Error updating Load Balancer: %s", err)
 	}
 
-	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
+	// Changes such as resizing the load balancer provision it again.
+	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become active", d.Get("name"))
+	if err := waitForLoadBalancerActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("Error waiting for Load Balancer (%s) to become active: %s", d.Get("name"), err)
+	}
+
+	// The platform drains connections to removed Droplets, but returning
+	// straight away lets them be destroyed while requests are still in flight.
+	if drain := d.Get("drain_seconds").(int); drain > 0 && len(removedIDs) > 0 {
+		log.Printf("[DEBUG] Waiting %d seconds for Load Balancer (%s) to drain connections to Droplets: %v", drain, d.Get("name"), removedIDs)
+		if err := waitForLoadBalancerDrain(ctx, time.Duration(drain)*time.Second); err != nil {
+			return diag.Errorf("Error waiting for Load Balancer (%s) to drain connections: %s", d.Get("name"), err)
+		}
+	}
+
+	return append(diags, resourceDigitalOceanLoadbalancerRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanLoadbalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting Load Balancer: %s", d.Id())
-	resp, err := client.LoadBalancers.Delete(context.Background(), d.Id())
+	resp, err := client.LoadBalancers.Delete(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			d.SetId("")
@@ -0,0 +1,407 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestLoadBalancerTimeoutsDefaults(t *testing.T) {
+	d := ResourceDigitalOceanLoadbalancer().Data(nil)
+
+	expected := map[string]time.Duration{
+		schema.TimeoutCreate: 10 * time.Minute,
+		schema.TimeoutUpdate: 20 * time.Minute,
+		schema.TimeoutDelete: 20 * time.Minute,
+	}
+	for key, timeout := range expected {
+		if got := d.Timeout(key); got != timeout {
+			t.Errorf("%s: expected %s, got %s", key, timeout, got)
+		}
+	}
+}
+
+func TestLoadbalancerReadAddresses(t *testing.T) {
+	cases := []struct {
+		name         string
+		status       string
+		expectedIP   string
+		expectedIPv6 string
+	}{
+		{name: "Active", status: "active", expectedIP: "192.0.2.10", expectedIPv6: "2001:db8::10"},
+		{name: "Provisioning", status: "new", expectedIP: "192.0.2.1", expectedIPv6: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"load_balancer":{"id":"lb-id","name":"web","status":%q,"ip":"192.0.2.10","ipv6":"2001:db8::10","region":{"slug":"nyc3"}}}`, c.status)
+			}))
+
+			d := ResourceDigitalOceanLoadbalancer().Data(&terraform.InstanceState{
+				ID:         "lb-id",
+				Attributes: map[string]string{"ip": "192.0.2.1"},
+			})
+
+			if diags := resourceDigitalOceanLoadbalancerRead(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if ip := d.Get("ip").(string); ip != c.expectedIP {
+				t.Errorf("expected ip %q, got %q", c.expectedIP, ip)
+			}
+			if ipv6 := d.Get("ipv6").(string); ipv6 != c.expectedIPv6 {
+				t.Errorf("expected ipv6 %q, got %q", c.expectedIPv6, ipv6)
+			}
+		})
+	}
+}
+
+func TestMigrateForwardingRuleCertificates(t *testing.T) {
+	current := "8c3d5f52-9d35-44e4-8b0c-54c1f5e0c0b2"
+	renewed := "1f2a1e0b-4f8b-4b65-9a4f-0d3c2bb4d1a1"
+	client := newCertificateTestClient(t, current)
+
+	rawState := map[string]interface{}{
+		"forwarding_rule": []interface{}{
+			map[string]interface{}{"certificate_id": current, "certificate_name": ""},
+			map[string]interface{}{"certificate_id": renewed, "certificate_name": ""},
+			map[string]interface{}{"certificate_id": "web-cert", "certificate_name": ""},
+			map[string]interface{}{"certificate_id": "web-cert", "certificate_name": "web-cert"},
+			map[string]interface{}{"certificate_id": "", "certificate_name": ""},
+		},
+	}
+
+	if err := migrateForwardingRuleCertificates(context.Background(), client, rawState); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"certificate_id": "web-cert", "certificate_name": "web-cert"},
+		// Left to be refreshed, as the certificate no longer exists.
+		map[string]interface{}{"certificate_id": renewed, "certificate_name": ""},
+		map[string]interface{}{"certificate_id": "web-cert", "certificate_name": "web-cert"},
+		map[string]interface{}{"certificate_id": "web-cert", "certificate_name": "web-cert"},
+		map[string]interface{}{"certificate_id": "", "certificate_name": ""},
+	}
+	if !reflect.DeepEqual(rawState["forwarding_rule"], expected) {
+		t.Errorf("Migration did not produce expected result.\nExpected: %#v\nGot: %#v", expected, rawState["forwarding_rule"])
+	}
+}
+
+func TestLoadbalancerNetworkDiffCheckCertificates(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("name") {
+		case "le-cert":
+			fmt.Fprint(w, `{"certificates":[{"id":"1","name":"le-cert","type":"lets_encrypt"}],"meta":{"total":1}}`)
+		case "custom-cert":
+			fmt.Fprint(w, `{"certificates":[{"id":"2","name":"custom-cert","type":"custom"}],"meta":{"total":1}}`)
+		default:
+			fmt.Fprint(w, `{"certificates":[],"meta":{"total":0}}`)
+		}
+	}))
+
+	cases := []struct {
+		name        string
+		network     string
+		certificate string
+		expectError bool
+	}{
+		{"InternalLetsEncrypt", "INTERNAL", "le-cert", true},
+		{"InternalCustom", "INTERNAL", "custom-cert", false},
+		{"InternalUnknownCertificate", "INTERNAL", "new-cert", false},
+		{"ExternalLetsEncrypt", "EXTERNAL", "le-cert", false},
+	}
+
+	r := ResourceDigitalOceanLoadbalancer()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":    "foo",
+				"region":  "nyc3",
+				"network": c.network,
+				"forwarding_rule": []interface{}{map[string]interface{}{
+					"entry_port":       443,
+					"entry_protocol":   "https",
+					"target_port":      80,
+					"target_protocol":  "http",
+					"certificate_name": c.certificate,
+				}},
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), meta)
+			if hasError := err != nil; hasError != c.expectError {
+				t.Fatalf("expected error to be %t, got %v", c.expectError, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "Let's Encrypt") {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestLoadbalancerReadGlobal(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/load_balancers/glb-id":
+			fmt.Fprint(w, `{"load_balancer":{
+				"id":"glb-id",
+				"name":"global",
+				"type":"GLOBAL",
+				"status":"active",
+				"algorithm":"round_robin",
+				"health_check":{"protocol":"http","port":80,"path":"/","check_interval_seconds":10,"response_timeout_seconds":5,"healthy_threshold":5,"unhealthy_threshold":3},
+				"sticky_sessions":{"type":"none"},
+				"domains":[{"name":"example.com","is_managed":true,"certificate_id":"cert-id"}],
+				"glb_settings":{"target_protocol":"http","target_port":80,"cdn":{"is_enabled":true},"region_priorities":{"nyc1":1,"sfo3":2},"failover_threshold":50},
+				"target_load_balancer_ids":["regional-id"]
+			}}`)
+		case "/v2/certificates/cert-id":
+			fmt.Fprint(w, `{"certificate":{"id":"cert-id","name":"example-cert","type":"lets_encrypt"}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	raw := map[string]interface{}{
+		"name": "global",
+		"type": "global",
+		"healthcheck": []interface{}{map[string]interface{}{
+			"protocol": "http",
+			"port":     80,
+			"path":     "/",
+		}},
+		"glb_settings": []interface{}{map[string]interface{}{
+			"target_protocol":   "http",
+			"target_port":       80,
+			"region_priorities": map[string]interface{}{"nyc1": 1, "sfo3": 2},
+		}},
+		"domains": []interface{}{map[string]interface{}{
+			"name":             "example.com",
+			"is_managed":       true,
+			"certificate_name": "example-cert",
+		}},
+		"target_load_balancer_ids": []interface{}{"regional-id"},
+	}
+
+	r := ResourceDigitalOceanLoadbalancer()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("glb-id")
+
+	if diags := resourceDigitalOceanLoadbalancerRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"type":                              "GLOBAL",
+		"glb_settings.0.target_protocol":    "http",
+		"glb_settings.0.target_port":        80,
+		"glb_settings.0.cdn.0.is_enabled":   true,
+		"glb_settings.0.region_priorities":  map[string]interface{}{"nyc1": 1, "sfo3": 2},
+		"glb_settings.0.failover_threshold": 50,
+		"target_load_balancer_ids":          []interface{}{"regional-id"},
+	}
+	for key, value := range expected {
+		actual := d.Get(key)
+		if set, ok := actual.(*schema.Set); ok {
+			actual = set.List()
+		}
+		if !reflect.DeepEqual(actual, value) {
+			t.Errorf("expected %s to be %#v, got %#v", key, value, actual)
+		}
+	}
+
+	domains := d.Get("domains").(*schema.Set).List()
+	if len(domains) != 1 || domains[0].(map[string]interface{})["certificate_name"] != "example-cert" {
+		t.Errorf("expected the domain to be read with its certificate name, got %#v", domains)
+	}
+
+	// Reading the load balancer must not cause drift from the configuration.
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		for key, attr := range diff.Attributes {
+			t.Errorf("unexpected diff for %s: %#v", key, attr)
+		}
+	}
+}
+
+func TestMigrateLoadBalancerStateV2toV3(t *testing.T) {
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "SizeSlug",
+			rawState: map[string]interface{}{"size": "lb-medium", "size_unit": float64(0)},
+			expected: map[string]interface{}{"size": "", "size_unit": 3},
+		},
+		{
+			name:     "SizeSlugAndUnit",
+			rawState: map[string]interface{}{"size": "lb-small", "size_unit": float64(1)},
+			expected: map[string]interface{}{"size": "", "size_unit": float64(1)},
+		},
+		{
+			name:     "SizeUnit",
+			rawState: map[string]interface{}{"size": "", "size_unit": float64(2)},
+			expected: map[string]interface{}{"size": "", "size_unit": float64(2)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rawState, err := migrateLoadBalancerStateV2toV3(context.Background(), c.rawState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(rawState, c.expected) {
+				t.Errorf("Migration did not produce expected result.\nExpected: %#v\nGot: %#v", c.expected, rawState)
+			}
+		})
+	}
+}
+
+func TestBuildLoadBalancerRequestSize(t *testing.T) {
+	cases := []struct {
+		name             string
+		attributes       map[string]string
+		expectedSizeSlug string
+		expectedSizeUnit uint32
+	}{
+		{
+			name:             "SizeUnit",
+			attributes:       map[string]string{"size_unit": "2"},
+			expectedSizeUnit: 2,
+		},
+		{
+			// size_unit is computed, so it is also set when sized by slug.
+			name:             "SizeSlug",
+			attributes:       map[string]string{"size": "lb-medium", "size_unit": "1"},
+			expectedSizeSlug: "lb-medium",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := ResourceDigitalOceanLoadbalancer().Data(&terraform.InstanceState{
+				ID:         "lb-id",
+				Attributes: c.attributes,
+			})
+
+			opts, _, err := buildLoadBalancerRequest(godo.NewClient(nil), d)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if opts.SizeSlug != c.expectedSizeSlug || opts.SizeUnit != c.expectedSizeUnit {
+				t.Errorf("expected size %q and size_unit %d, got %q and %d", c.expectedSizeSlug, c.expectedSizeUnit, opts.SizeSlug, opts.SizeUnit)
+			}
+		})
+	}
+}
+
+func TestLoadbalancerSizeDiff(t *testing.T) {
+	r := ResourceDigitalOceanLoadbalancer()
+	state := &terraform.InstanceState{
+		ID: "lb-id",
+		Attributes: map[string]string{
+			"region":    "nyc3",
+			"name":      "web",
+			"size":      "lb-small",
+			"size_unit": "1",
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": "nyc3",
+		"name":   "web",
+		"size":   "lb-medium",
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attr, ok := diff.Attributes["size_unit"]; !ok || !attr.NewComputed {
+		t.Errorf("expected size_unit to be computed when resizing by size slug, got %#v", attr)
+	}
+	if diff.RequiresNew() {
+		t.Error("expected the load balancer to be resized in place")
+	}
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region":    "nyc3",
+		"name":      "web",
+		"size":      "lb-small",
+		"size_unit": 1,
+	}))
+	if !diags.HasError() {
+		t.Error("expected size and size_unit to conflict")
+	}
+}
+
+func TestLoadbalancerReadSize(t *testing.T) {
+	cases := []struct {
+		name             string
+		lb               string
+		attributes       map[string]string
+		expectedSize     string
+		expectedSizeUnit int
+	}{
+		{
+			name:             "SizeUnit",
+			lb:               `"size_unit":2,"size":"lb-small"`,
+			attributes:       map[string]string{"size_unit": "1"},
+			expectedSizeUnit: 2,
+		},
+		{
+			name:             "SizeSlugConfigured",
+			lb:               `"size_unit":3,"size":"lb-small"`,
+			attributes:       map[string]string{"size": "lb-small", "size_unit": "1"},
+			expectedSize:     "lb-medium",
+			expectedSizeUnit: 3,
+		},
+		{
+			name:         "LegacySizeSlug",
+			lb:           `"size":"lb-large"`,
+			expectedSize: "lb-large",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"load_balancer":{"id":"lb-id","name":"web","status":"active",%s,"region":{"slug":"nyc3"}}}`, c.lb)
+			}))
+
+			d := ResourceDigitalOceanLoadbalancer().Data(&terraform.InstanceState{
+				ID:         "lb-id",
+				Attributes: c.attributes,
+			})
+
+			if diags := resourceDigitalOceanLoadbalancerRead(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if size := d.Get("size").(string); size != c.expectedSize {
+				t.Errorf("expected size %q, got %q", c.expectedSize, size)
+			}
+			if sizeUnit := d.Get("size_unit").(int); sizeUnit != c.expectedSizeUnit {
+				t.Errorf("expected size_unit %d, got %d", c.expectedSizeUnit, sizeUnit)
+			}
+		})
+	}
+}
@@ -188,6 +188,37 @@ func TestAccDigitalOceanLoadbalancer_Updated(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanLoadbalancer_drainSeconds(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_drainSeconds(name, "digitalocean_droplet.foobar.id, digitalocean_droplet.foo.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "drain_seconds", "10"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_drainSeconds(name, "digitalocean_droplet.foobar.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanLoadbalancer_dropletTag(t *testing.T) {
 	var loadbalancer godo.LoadBalancer
 	name := acceptance.RandomTestName()
@@ -228,8 +259,34 @@ func TestAccDigitalOceanLoadbalancer_dropletTag(t *testing.T) {
 						"digitalocean_loadbalancer.foobar", "healthcheck.0.protocol", "tcp"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_loadbalancer.foobar", "droplet_tag", "sample"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_ids.#", "1"),
+				),
+			},
+			// Switching from droplet_tag to droplet_ids must not leave the tag set.
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_dropletTagToIDs(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_tag", ""),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_dropletTag(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_tag", "sample"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "droplet_ids.#", "1"),
 				),
 			},
+			{
+				Config:      testAccCheckDigitalOceanLoadbalancerConfig_dropletTagAndIDs(name),
+				ExpectError: regexp.MustCompile("conflicts with|only one of 'droplet_tag' or 'droplet_ids' may be set"),
+			},
 		},
 	})
 }
@@ -558,7 +615,7 @@ func TestAccDigitalOceanLoadbalancer_sslCertByName(t *testing.T) {
 }
 
 func TestAccDigitalOceanLoadbalancer_resize(t *testing.T) {
-	var loadbalancer godo.LoadBalancer
+	var loadbalancer, resized godo.LoadBalancer
 	name := acceptance.RandomTestName()
 
 	lbConfig := `resource "digitalocean_loadbalancer" "foobar" {
@@ -597,16 +654,85 @@ func TestAccDigitalOceanLoadbalancer_resize(t *testing.T) {
 			},
 			{
 				Config: fmt.Sprintf(lbConfig, name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &resized),
+					testAccCheckDigitalOceanLoadbalancerNotRecreated(&loadbalancer, &resized),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size_unit", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanLoadbalancer_sizeToSizeUnit(t *testing.T) {
+	var loadbalancer, switched godo.LoadBalancer
+	name := acceptance.RandomTestName()
+
+	lbConfig := `resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+  %s
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  healthcheck {
+    port     = 22
+    protocol = "tcp"
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(lbConfig, name, `size = "lb-small"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size", "lb-small"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size_unit", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(lbConfig, name, `size_unit = 2`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &switched),
+					testAccCheckDigitalOceanLoadbalancerNotRecreated(&loadbalancer, &switched),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size", ""),
 					resource.TestCheckResourceAttr(
 						"digitalocean_loadbalancer.foobar", "size_unit", "2"),
 				),
 			},
+			{
+				Config:      fmt.Sprintf(lbConfig, name, "size = \"lb-small\"\n  size_unit = 1"),
+				ExpectError: regexp.MustCompile(`"size": conflicts with size_unit`),
+			},
 		},
 	})
 }
 
+func testAccCheckDigitalOceanLoadbalancerNotRecreated(before, after *godo.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("expected the Load Balancer to be updated in place, it was recreated: %s != %s", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
 func TestAccDigitalOceanLoadbalancer_multipleRules(t *testing.T) {
 	var loadbalancer godo.LoadBalancer
 	rName := acceptance.RandomTestName()
@@ -716,6 +842,7 @@ func TestAccDigitalOceanLoadbalancer_WithVPC(t *testing.T) {
 func TestAccDigitalOceanLoadbalancer_Firewall(t *testing.T) {
 	var loadbalancer godo.LoadBalancer
 	lbName := acceptance.RandomTestName()
+	dropletName := acceptance.RandomTestName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
@@ -723,7 +850,7 @@ func TestAccDigitalOceanLoadbalancer_Firewall(t *testing.T) {
 		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDigitalOceanLoadbalancerConfig_Firewall(lbName),
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_Firewall(dropletName, lbName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
 					resource.TestCheckResourceAttr(
@@ -740,6 +867,38 @@ func TestAccDigitalOceanLoadbalancer_Firewall(t *testing.T) {
 						"digitalocean_loadbalancer.foobar", "firewall.0.allow.1", "cidr:2.3.4.0/24"),
 				),
 			},
+			// Removing the block clears the firewall
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_NoFirewall(dropletName, lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanLoadbalancer_InternalNetwork(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_InternalNetwork(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "network", "INTERNAL"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_loadbalancer.foobar", "ip"),
+				),
+			},
 		},
 	})
 }
@@ -801,6 +960,10 @@ func TestLoadbalancerDiffCheck(t *testing.T) {
 			expectError: false,
 			attrs: map[string]interface{}{
 				"type": "GLOBAL",
+				"glb_settings": []interface{}{map[string]interface{}{
+					"target_protocol": "http",
+					"target_port":     80,
+				}},
 			},
 		},
 		{
@@ -809,6 +972,10 @@ func TestLoadbalancerDiffCheck(t *testing.T) {
 			attrs: map[string]interface{}{
 				"type":   "GLOBAL",
 				"region": "",
+				"glb_settings": []interface{}{map[string]interface{}{
+					"target_protocol": "http",
+					"target_port":     80,
+				}},
 			},
 		},
 		{
@@ -820,6 +987,72 @@ func TestLoadbalancerDiffCheck(t *testing.T) {
 				"region": "nyc3",
 			},
 		},
+		{
+			name:         "Global type without glb_settings",
+			expectError:  true,
+			errorMessage: "'glb_settings' must be set when 'type' is 'GLOBAL'",
+			attrs: map[string]interface{}{
+				"type": "GLOBAL",
+			},
+		},
+		{
+			name:         "Global type with forwarding rules",
+			expectError:  true,
+			errorMessage: "'forwarding_rule' is not allowed when 'type' is 'GLOBAL', use 'glb_settings' instead",
+			attrs: map[string]interface{}{
+				"type": "GLOBAL",
+				"forwarding_rule": []interface{}{map[string]interface{}{
+					"entry_port":      80,
+					"entry_protocol":  "http",
+					"target_port":     80,
+					"target_protocol": "http",
+				}},
+			},
+		},
+		{
+			name:         "Regional type with glb_settings",
+			expectError:  true,
+			errorMessage: "'glb_settings' is only allowed when 'type' is 'GLOBAL'",
+			attrs: map[string]interface{}{
+				"type":   "REGIONAL",
+				"region": "nyc3",
+				"glb_settings": []interface{}{map[string]interface{}{
+					"target_protocol": "http",
+					"target_port":     80,
+				}},
+			},
+		},
+		{
+			name:         "Missing type with target load balancers",
+			expectError:  true,
+			errorMessage: "'target_load_balancer_ids' is only allowed when 'type' is 'GLOBAL'",
+			attrs: map[string]interface{}{
+				"region":                   "nyc3",
+				"target_load_balancer_ids": []interface{}{"regional-id"},
+			},
+		},
+		{
+			name:         "Global type with internal network",
+			expectError:  true,
+			errorMessage: "'network' must be 'EXTERNAL' when 'type' is 'GLOBAL'",
+			attrs: map[string]interface{}{
+				"type":    "GLOBAL",
+				"network": "INTERNAL",
+				"glb_settings": []interface{}{map[string]interface{}{
+					"target_protocol": "http",
+					"target_port":     80,
+				}},
+			},
+		},
+		{
+			name:        "Regional type with internal network",
+			expectError: false,
+			attrs: map[string]interface{}{
+				"type":    "REGIONAL",
+				"region":  "nyc3",
+				"network": "internal",
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -831,9 +1064,11 @@ func TestLoadbalancerDiffCheck(t *testing.T) {
 			_, err := r.Diff(context.Background(), s, conf, nil)
 
 			if c.expectError {
-				if err.Error() != c.errorMessage {
-					t.Fatalf("Expected %s, got %s", c.errorMessage, err)
+				if err == nil || err.Error() != c.errorMessage {
+					t.Fatalf("Expected %s, got %v", c.errorMessage, err)
 				}
+			} else if err != nil {
+				t.Fatalf("Expected no error, got %s", err)
 			}
 		})
 	}
@@ -1039,6 +1274,44 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, name, name, name)
 }
 
+func testAccCheckDigitalOceanLoadbalancerConfig_drainSeconds(name string, dropletIDs string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s-01"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "foo" {
+  name   = "%s-02"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  healthcheck {
+    port     = 22
+    protocol = "tcp"
+  }
+
+  drain_seconds = 10
+  droplet_ids   = [%s]
+}`, name, name, name, dropletIDs)
+}
+
 func testAccCheckDigitalOceanLoadbalancerConfig_dropletTag(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "barbaz" {
@@ -1076,6 +1349,18 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, name, name)
 }
 
+func testAccCheckDigitalOceanLoadbalancerConfig_dropletTagToIDs(name string) string {
+	return strings.Replace(testAccCheckDigitalOceanLoadbalancerConfig_dropletTag(name),
+		"droplet_tag = digitalocean_tag.barbaz.name",
+		"droplet_ids = [digitalocean_droplet.foobar.id]", 1)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_dropletTagAndIDs(name string) string {
+	return strings.Replace(testAccCheckDigitalOceanLoadbalancerConfig_dropletTag(name),
+		"droplet_tag = digitalocean_tag.barbaz.name",
+		"droplet_tag = digitalocean_tag.barbaz.name\n  droplet_ids = [digitalocean_droplet.foobar.id]", 1)
+}
+
 func testAccCheckDigitalOceanLoadbalancerConfig_minimal(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -1102,6 +1387,33 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, name, name)
 }
 
+func testAccCheckDigitalOceanLoadbalancerConfig_InternalNetwork(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name      = "%s"
+  region    = "nyc3"
+  size_unit = 1
+  network   = "INTERNAL"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  droplet_ids = [digitalocean_droplet.foobar.id]
+}`, name, name)
+}
+
 func testAccCheckDigitalOceanLoadbalancerConfig_NonDefaultProject(projectName, lbName string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "test" {
@@ -1301,7 +1613,7 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, acceptance.RandomTestName(), acceptance.RandomTestName(), name)
 }
 
-func testAccCheckDigitalOceanLoadbalancerConfig_Firewall(name string) string {
+func testAccCheckDigitalOceanLoadbalancerConfig_Firewall(dropletName, name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
   name   = "%s"
@@ -1329,7 +1641,33 @@ resource "digitalocean_loadbalancer" "foobar" {
   }
 
   droplet_ids = [digitalocean_droplet.foobar.id]
-}`, acceptance.RandomTestName(), name)
+}`, dropletName, name)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_NoFirewall(dropletName, name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+  size   = "lb-small"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  droplet_ids = [digitalocean_droplet.foobar.id]
+}`, dropletName, name)
 }
 
 func testAccCheckDigitalOceanGlobalLoadbalancerConfig_basic(name string) string {
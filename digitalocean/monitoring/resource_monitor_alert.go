@@ -116,6 +116,7 @@ func ResourceDigitalOceanMonitorAlert() *schema.Resource {
 									"url": {
 										Type:             schema.TypeString,
 										Required:         true,
+										Sensitive:        true,
 										DiffSuppressFunc: util.CaseSensitive,
 										Description:      "The webhook URL for Slack",
 										ValidateFunc:     validation.StringIsNotEmpty,
@@ -47,6 +47,18 @@ data "digitalocean_projects" "staging" {
   }
 }
 
+data "digitalocean_projects" "convention" {
+  filter {
+    key      = "name"
+    values   = ["^%s$", "^%s$"]
+    match_by = "re"
+  }
+  sort {
+    key       = "created_at"
+    direction = "asc"
+  }
+}
+
 data "digitalocean_projects" "both" {
   filter {
     key    = "environment"
@@ -57,7 +69,7 @@ data "digitalocean_projects" "both" {
     values = ["%s"]
   }
 }
-`, stagingProjectName, stagingProjectName)
+`, stagingProjectName, prodProjectName, stagingProjectName, stagingProjectName)
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
@@ -76,6 +88,11 @@ data "digitalocean_projects" "both" {
 					resource.TestCheckResourceAttr("data.digitalocean_projects.staging", "projects.0.name", stagingProjectName),
 					resource.TestCheckResourceAttr("data.digitalocean_projects.staging", "projects.0.environment", "Staging"),
 					resource.TestCheckResourceAttr("data.digitalocean_projects.both", "projects.#", "0"),
+					resource.TestCheckResourceAttr("data.digitalocean_projects.convention", "projects.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_projects.convention", "projects.*", map[string]string{"name": prodProjectName}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_projects.convention", "projects.*", map[string]string{"name": stagingProjectName}),
+					resource.TestCheckResourceAttrSet("data.digitalocean_projects.convention", "projects.0.owner_uuid"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_projects.convention", "projects.0.created_at"),
 				),
 			},
 		},
@@ -11,6 +11,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -91,7 +92,10 @@ func ResourceDigitalOceanProject() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Description: "the resources associated with the project",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: urn.ValidateAssignable,
+				},
 			},
 		},
 
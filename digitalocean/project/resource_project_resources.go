@@ -5,6 +5,7 @@ import (
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,7 +30,10 @@ func ResourceDigitalOceanProjectResources() *schema.Resource {
 				Type:        schema.TypeSet,
 				Required:    true,
 				Description: "the resources associated with the project",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: urn.ValidateAssignable,
+				},
 			},
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -80,6 +81,84 @@ resource "digitalocean_project_resources" "barfoo" {
 	})
 }
 
+func TestAccDigitalOceanProjectResources_ManyKinds(t *testing.T) {
+	projectName := generateProjectName()
+	name := acceptance.RandomTestName("project")
+
+	manyKindsConfig := fmt.Sprintf(`
+resource "digitalocean_project" "foo" {
+  name = "%[1]s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%[2]s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_volume" "foobar" {
+  name   = "%[2]s"
+  region = "nyc3"
+  size   = 10
+}
+
+resource "digitalocean_reserved_ip" "foobar" {
+  region = "nyc3"
+}
+
+resource "digitalocean_domain" "foobar" {
+  name = "%[2]s.com"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%[2]s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  healthcheck {
+    port     = 22
+    protocol = "tcp"
+  }
+}
+
+resource "digitalocean_project_resources" "barfoo" {
+  project = digitalocean_project.foo.id
+  resources = [
+    digitalocean_droplet.foobar.urn,
+    digitalocean_volume.foobar.urn,
+    digitalocean_reserved_ip.foobar.urn,
+    digitalocean_domain.foobar.urn,
+    digitalocean_loadbalancer.foobar.urn,
+  ]
+}
+`, projectName, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanProjectResourcesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: manyKindsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_project_resources.barfoo", "resources.#", "5"),
+					testProjectMembershipCount("digitalocean_project_resources.barfoo", 5),
+					testProjectMembershipKinds("digitalocean_project_resources.barfoo",
+						urn.Droplet, urn.Volume, urn.ReservedIP, urn.Domain, urn.LoadBalancer),
+				),
+			},
+		},
+	})
+}
+
 func testProjectMembershipCount(name string, expectedCount int) resource.TestCheckFunc {
 	return acceptance.TestResourceInstanceState(name, func(is *terraform.InstanceState) error {
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
@@ -105,6 +184,34 @@ func testProjectMembershipCount(name string, expectedCount int) resource.TestChe
 	})
 }
 
+func testProjectMembershipKinds(name string, expectedTypes ...string) resource.TestCheckFunc {
+	return acceptance.TestResourceInstanceState(name, func(is *terraform.InstanceState) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		resources, err := project.LoadResourceURNs(client, is.Attributes["project"])
+		if err != nil {
+			return fmt.Errorf("Error retrieving project resources: %s", err)
+		}
+
+		actualTypes := map[string]bool{}
+		for _, resourceURN := range *resources {
+			resourceType, _, err := urn.Parse(resourceURN)
+			if err != nil {
+				return err
+			}
+			actualTypes[resourceType] = true
+		}
+
+		for _, expected := range expectedTypes {
+			if !actualTypes[expected] {
+				return fmt.Errorf("no resource of type %q is assigned to the project: %v", expected, *resources)
+			}
+		}
+
+		return nil
+	})
+}
+
 func testAccCheckDigitalOceanProjectResourcesDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -331,7 +332,7 @@ func TestAccDigitalOceanProject_UpdateFromDropletToSpacesResource(t *testing.T)
 				Config: updateConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDigitalOceanProjectExists("digitalocean_project.myproj"),
-					testAccCheckDigitalOceanProjectResourceURNIsPresent("digitalocean_project.myproj", "do:spaces:"+generateSpacesName()),
+					testAccCheckDigitalOceanProjectResourceURNIsPresent("digitalocean_project.myproj", urn.Format(urn.Space, expectedSpacesName)),
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "1"),
@@ -397,7 +398,7 @@ func testAccCheckDigitalOceanProjectResourceURNIsPresent(resource, expectedURN s
 
 		}
 
-		return nil
+		return fmt.Errorf("Resource %s is not assigned to the project", expectedURN)
 	}
 }
 
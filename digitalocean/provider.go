@@ -6,11 +6,13 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/cdn"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/costs"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/database"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/inventory"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/loadbalancer"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/monitoring"
@@ -29,6 +31,7 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpcpeering"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a schema.Provider for DigitalOcean.
@@ -36,8 +39,9 @@ func Provider() *schema.Provider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
 					"DIGITALOCEAN_TOKEN",
 					"DIGITALOCEAN_ACCESS_TOKEN",
@@ -65,6 +69,7 @@ func Provider() *schema.Provider {
 			"spaces_secret_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("SPACES_SECRET_ACCESS_KEY", nil),
 				Description: "The secret access key for Spaces API operations.",
 			},
@@ -92,49 +97,74 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_WAIT_MAX", 30.0),
 				Description: "The maximum wait time (in seconds) between failed API requests.",
 			},
+			"droplet_create_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_DROPLET_CREATE_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of retries when a Droplet can not be created due to a transient error.",
+			},
+			"emit_cost_summary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to warn with a summary of the estimated monthly cost of the resources created and destroyed in an apply.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"digitalocean_account":                  account.DataSourceDigitalOceanAccount(),
-			"digitalocean_app":                      app.DataSourceDigitalOceanApp(),
-			"digitalocean_certificate":              certificate.DataSourceDigitalOceanCertificate(),
-			"digitalocean_container_registry":       registry.DataSourceDigitalOceanContainerRegistry(),
-			"digitalocean_database_cluster":         database.DataSourceDigitalOceanDatabaseCluster(),
-			"digitalocean_database_connection_pool": database.DataSourceDigitalOceanDatabaseConnectionPool(),
-			"digitalocean_database_ca":              database.DataSourceDigitalOceanDatabaseCA(),
-			"digitalocean_database_replica":         database.DataSourceDigitalOceanDatabaseReplica(),
-			"digitalocean_database_user":            database.DataSourceDigitalOceanDatabaseUser(),
-			"digitalocean_domain":                   domain.DataSourceDigitalOceanDomain(),
-			"digitalocean_domains":                  domain.DataSourceDigitalOceanDomains(),
-			"digitalocean_droplet":                  droplet.DataSourceDigitalOceanDroplet(),
-			"digitalocean_droplets":                 droplet.DataSourceDigitalOceanDroplets(),
-			"digitalocean_droplet_snapshot":         snapshot.DataSourceDigitalOceanDropletSnapshot(),
-			"digitalocean_firewall":                 firewall.DataSourceDigitalOceanFirewall(),
-			"digitalocean_floating_ip":              reservedip.DataSourceDigitalOceanFloatingIP(),
-			"digitalocean_image":                    image.DataSourceDigitalOceanImage(),
-			"digitalocean_images":                   image.DataSourceDigitalOceanImages(),
-			"digitalocean_kubernetes_cluster":       kubernetes.DataSourceDigitalOceanKubernetesCluster(),
-			"digitalocean_kubernetes_versions":      kubernetes.DataSourceDigitalOceanKubernetesVersions(),
-			"digitalocean_loadbalancer":             loadbalancer.DataSourceDigitalOceanLoadbalancer(),
-			"digitalocean_project":                  project.DataSourceDigitalOceanProject(),
-			"digitalocean_projects":                 project.DataSourceDigitalOceanProjects(),
-			"digitalocean_record":                   domain.DataSourceDigitalOceanRecord(),
-			"digitalocean_records":                  domain.DataSourceDigitalOceanRecords(),
-			"digitalocean_region":                   region.DataSourceDigitalOceanRegion(),
-			"digitalocean_regions":                  region.DataSourceDigitalOceanRegions(),
-			"digitalocean_reserved_ip":              reservedip.DataSourceDigitalOceanReservedIP(),
-			"digitalocean_sizes":                    size.DataSourceDigitalOceanSizes(),
-			"digitalocean_spaces_bucket":            spaces.DataSourceDigitalOceanSpacesBucket(),
-			"digitalocean_spaces_buckets":           spaces.DataSourceDigitalOceanSpacesBuckets(),
-			"digitalocean_spaces_bucket_object":     spaces.DataSourceDigitalOceanSpacesBucketObject(),
-			"digitalocean_spaces_bucket_objects":    spaces.DataSourceDigitalOceanSpacesBucketObjects(),
-			"digitalocean_ssh_key":                  sshkey.DataSourceDigitalOceanSSHKey(),
-			"digitalocean_ssh_keys":                 sshkey.DataSourceDigitalOceanSSHKeys(),
-			"digitalocean_tag":                      tag.DataSourceDigitalOceanTag(),
-			"digitalocean_tags":                     tag.DataSourceDigitalOceanTags(),
-			"digitalocean_volume_snapshot":          snapshot.DataSourceDigitalOceanVolumeSnapshot(),
-			"digitalocean_volume":                   volume.DataSourceDigitalOceanVolume(),
-			"digitalocean_vpc":                      vpc.DataSourceDigitalOceanVPC(),
-			"digitalocean_vpc_peering":              vpcpeering.DataSourceDigitalOceanVPCPeering(),
+			"digitalocean_account":                    account.DataSourceDigitalOceanAccount(),
+			"digitalocean_app":                        app.DataSourceDigitalOceanApp(),
+			"digitalocean_app_component_metrics":      app.DataSourceDigitalOceanAppComponentMetrics(),
+			"digitalocean_certificate":                certificate.DataSourceDigitalOceanCertificate(),
+			"digitalocean_container_registry":         registry.DataSourceDigitalOceanContainerRegistry(),
+			"digitalocean_database_cluster":           database.DataSourceDigitalOceanDatabaseCluster(),
+			"digitalocean_database_cluster_backups":   database.DataSourceDigitalOceanDatabaseClusterBackups(),
+			"digitalocean_database_cluster_status":    database.DataSourceDigitalOceanDatabaseClusterStatus(),
+			"digitalocean_database_connection_pool":   database.DataSourceDigitalOceanDatabaseConnectionPool(),
+			"digitalocean_database_ca":                database.DataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_db":                database.DataSourceDigitalOceanDatabaseDB(),
+			"digitalocean_database_dbs":               database.DataSourceDigitalOceanDatabaseDBs(),
+			"digitalocean_database_replica":           database.DataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_user":              database.DataSourceDigitalOceanDatabaseUser(),
+			"digitalocean_databases":                  database.DataSourceDigitalOceanDatabases(),
+			"digitalocean_domain":                     domain.DataSourceDigitalOceanDomain(),
+			"digitalocean_domains":                    domain.DataSourceDigitalOceanDomains(),
+			"digitalocean_droplet":                    droplet.DataSourceDigitalOceanDroplet(),
+			"digitalocean_droplets":                   droplet.DataSourceDigitalOceanDroplets(),
+			"digitalocean_droplet_effective_firewall": firewall.DataSourceDigitalOceanDropletEffectiveFirewall(),
+			"digitalocean_droplet_snapshot":           snapshot.DataSourceDigitalOceanDropletSnapshot(),
+			"digitalocean_firewall":                   firewall.DataSourceDigitalOceanFirewall(),
+			"digitalocean_floating_ip":                reservedip.DataSourceDigitalOceanFloatingIP(),
+			"digitalocean_image":                      image.DataSourceDigitalOceanImage(),
+			"digitalocean_images":                     image.DataSourceDigitalOceanImages(),
+			"digitalocean_kubernetes_cluster":         kubernetes.DataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_cluster_status":  kubernetes.DataSourceDigitalOceanKubernetesClusterStatus(),
+			"digitalocean_kubernetes_clusters":        kubernetes.DataSourceDigitalOceanKubernetesClusters(),
+			"digitalocean_kubernetes_versions":        kubernetes.DataSourceDigitalOceanKubernetesVersions(),
+			"digitalocean_loadbalancer":               loadbalancer.DataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_project":                    project.DataSourceDigitalOceanProject(),
+			"digitalocean_projects":                   project.DataSourceDigitalOceanProjects(),
+			"digitalocean_record":                     domain.DataSourceDigitalOceanRecord(),
+			"digitalocean_records":                    domain.DataSourceDigitalOceanRecords(),
+			"digitalocean_region":                     region.DataSourceDigitalOceanRegion(),
+			"digitalocean_regions":                    region.DataSourceDigitalOceanRegions(),
+			"digitalocean_reserved_ip":                reservedip.DataSourceDigitalOceanReservedIP(),
+			"digitalocean_resources":                  inventory.DataSourceDigitalOceanResources(),
+			"digitalocean_sizes":                      size.DataSourceDigitalOceanSizes(),
+			"digitalocean_spaces_bucket":              spaces.DataSourceDigitalOceanSpacesBucket(),
+			"digitalocean_spaces_buckets":             spaces.DataSourceDigitalOceanSpacesBuckets(),
+			"digitalocean_spaces_bucket_object":       spaces.DataSourceDigitalOceanSpacesBucketObject(),
+			"digitalocean_spaces_bucket_objects":      spaces.DataSourceDigitalOceanSpacesBucketObjects(),
+			"digitalocean_spaces_keys":                spaces.DataSourceDigitalOceanSpacesKeys(),
+			"digitalocean_ssh_key":                    sshkey.DataSourceDigitalOceanSSHKey(),
+			"digitalocean_ssh_keys":                   sshkey.DataSourceDigitalOceanSSHKeys(),
+			"digitalocean_tag":                        tag.DataSourceDigitalOceanTag(),
+			"digitalocean_tags":                       tag.DataSourceDigitalOceanTags(),
+			"digitalocean_volume_snapshot":            snapshot.DataSourceDigitalOceanVolumeSnapshot(),
+			"digitalocean_volume":                     volume.DataSourceDigitalOceanVolume(),
+			"digitalocean_vpc":                        vpc.DataSourceDigitalOceanVPC(),
+			"digitalocean_vpcs":                       vpc.DataSourceDigitalOceanVPCs(),
+			"digitalocean_vpc_peering":                vpcpeering.DataSourceDigitalOceanVPCPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -153,6 +183,7 @@ func Provider() *schema.Provider {
 			"digitalocean_database_postgresql_config":            database.ResourceDigitalOceanDatabasePostgreSQLConfig(),
 			"digitalocean_database_mysql_config":                 database.ResourceDigitalOceanDatabaseMySQLConfig(),
 			"digitalocean_database_kafka_topic":                  database.ResourceDigitalOceanDatabaseKafkaTopic(),
+			"digitalocean_database_online_migration":             database.ResourceDigitalOceanDatabaseOnlineMigration(),
 			"digitalocean_domain":                                domain.ResourceDigitalOceanDomain(),
 			"digitalocean_droplet":                               droplet.ResourceDigitalOceanDroplet(),
 			"digitalocean_droplet_snapshot":                      snapshot.ResourceDigitalOceanDropletSnapshot(),
@@ -161,6 +192,7 @@ func Provider() *schema.Provider {
 			"digitalocean_floating_ip_assignment":                reservedip.ResourceDigitalOceanFloatingIPAssignment(),
 			"digitalocean_kubernetes_cluster":                    kubernetes.ResourceDigitalOceanKubernetesCluster(),
 			"digitalocean_kubernetes_node_pool":                  kubernetes.ResourceDigitalOceanKubernetesNodePool(),
+			"digitalocean_kubernetes_node_recycle":               kubernetes.ResourceDigitalOceanKubernetesNodeRecycle(),
 			"digitalocean_loadbalancer":                          loadbalancer.ResourceDigitalOceanLoadbalancer(),
 			"digitalocean_monitor_alert":                         monitoring.ResourceDigitalOceanMonitorAlert(),
 			"digitalocean_project":                               project.ResourceDigitalOceanProject(),
@@ -168,14 +200,18 @@ func Provider() *schema.Provider {
 			"digitalocean_record":                                domain.ResourceDigitalOceanRecord(),
 			"digitalocean_reserved_ip":                           reservedip.ResourceDigitalOceanReservedIP(),
 			"digitalocean_reserved_ip_assignment":                reservedip.ResourceDigitalOceanReservedIPAssignment(),
+			"digitalocean_reserved_ipv6":                         reservedip.ResourceDigitalOceanReservedIPV6(),
+			"digitalocean_reserved_ipv6_assignment":              reservedip.ResourceDigitalOceanReservedIPV6Assignment(),
 			"digitalocean_spaces_bucket":                         spaces.ResourceDigitalOceanBucket(),
 			"digitalocean_spaces_bucket_cors_configuration":      spaces.ResourceDigitalOceanBucketCorsConfiguration(),
 			"digitalocean_spaces_bucket_object":                  spaces.ResourceDigitalOceanSpacesBucketObject(),
 			"digitalocean_spaces_bucket_policy":                  spaces.ResourceDigitalOceanSpacesBucketPolicy(),
+			"digitalocean_spaces_bucket_replication_intent":      spaces.ResourceDigitalOceanSpacesBucketReplicationIntent(),
 			"digitalocean_ssh_key":                               sshkey.ResourceDigitalOceanSSHKey(),
 			"digitalocean_tag":                                   tag.ResourceDigitalOceanTag(),
 			"digitalocean_uptime_check":                          uptime.ResourceDigitalOceanUptimeCheck(),
 			"digitalocean_uptime_alert":                          uptime.ResourceDigitalOceanUptimeAlert(),
+			"digitalocean_uptime_check_set":                      uptime.ResourceDigitalOceanUptimeCheckSet(),
 			"digitalocean_volume":                                volume.ResourceDigitalOceanVolume(),
 			"digitalocean_volume_attachment":                     volume.ResourceDigitalOceanVolumeAttachment(),
 			"digitalocean_volume_snapshot":                       snapshot.ResourceDigitalOceanVolumeSnapshot(),
@@ -185,6 +221,8 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	costs.WrapResources(p.ResourcesMap)
+
 	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -200,15 +238,17 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	conf := config.Config{
-		Token:             d.Get("token").(string),
-		APIEndpoint:       d.Get("api_endpoint").(string),
-		AccessID:          d.Get("spaces_access_id").(string),
-		SecretKey:         d.Get("spaces_secret_key").(string),
-		RequestsPerSecond: d.Get("requests_per_second").(float64),
-		HTTPRetryMax:      d.Get("http_retry_max").(int),
-		HTTPRetryWaitMin:  d.Get("http_retry_wait_min").(float64),
-		HTTPRetryWaitMax:  d.Get("http_retry_wait_max").(float64),
-		TerraformVersion:  terraformVersion,
+		Token:                d.Get("token").(string),
+		APIEndpoint:          d.Get("api_endpoint").(string),
+		AccessID:             d.Get("spaces_access_id").(string),
+		SecretKey:            d.Get("spaces_secret_key").(string),
+		RequestsPerSecond:    d.Get("requests_per_second").(float64),
+		HTTPRetryMax:         d.Get("http_retry_max").(int),
+		HTTPRetryWaitMin:     d.Get("http_retry_wait_min").(float64),
+		HTTPRetryWaitMax:     d.Get("http_retry_wait_max").(float64),
+		TerraformVersion:     terraformVersion,
+		DropletCreateRetries: d.Get("droplet_create_retries").(int),
+		EmitCostSummary:      d.Get("emit_cost_summary").(bool),
 	}
 
 	if endpoint, ok := d.GetOk("spaces_endpoint"); ok {
@@ -2,6 +2,11 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
@@ -143,3 +148,129 @@ func TestSpaceAPIEndpointOverride(t *testing.T) {
 		t.Fatalf("Expected %s, got %s", expectedEndpoint, *client.Config.Endpoint)
 	}
 }
+
+// secretAttributePattern matches the names of attributes which are likely to
+// hold secrets and must be marked as sensitive so that they are redacted in
+// plans.
+var secretAttributePattern = regexp.MustCompile(`(^|_)(password|passwords|secret|token|key|keys|credential|credentials)(_|$)`)
+
+// nonSensitiveAttributes lists the attributes matching secretAttributePattern
+// which do not hold secrets. Attributes are identified by their path, with
+// the name of the resource or data source first, or "provider" for the
+// provider configuration.
+var nonSensitiveAttributes = map[string]string{
+	"digitalocean_app.spec.env.key":                                                 "the name of an environment variable",
+	"digitalocean_app.spec.function.env.key":                                        "the name of an environment variable",
+	"digitalocean_app.spec.job.env.key":                                             "the name of an environment variable",
+	"digitalocean_app.spec.service.env.key":                                         "the name of an environment variable",
+	"digitalocean_app.spec.static_site.env.key":                                     "the name of an environment variable",
+	"digitalocean_app.spec.worker.env.key":                                          "the name of an environment variable",
+	"digitalocean_kubernetes_cluster.node_pool.taint.key":                           "the key of a node taint",
+	"digitalocean_kubernetes_node_pool.taint.key":                                   "the key of a node taint",
+	"digitalocean_spaces_bucket_object.key":                                         "the name of an object",
+	"digitalocean_spaces_bucket_objects.keys":                                       "the names of objects",
+	"digitalocean_spaces_keys.keys.access_key":                                      "the ID of an access key, the secret key is never read",
+	"digitalocean_ssh_key.public_key":                                               "a public key",
+	"digitalocean_ssh_keys.ssh_keys.public_key":                                     "a public key",
+	"digitalocean_container_registry_docker_credentials.credential_expiration_time": "the time the credentials expire",
+	"digitalocean_database_user.password_rotation":                                  "an arbitrary value which resets the password when changed",
+	"digitalocean_droplet.ssh_keys":                                                 "the IDs or fingerprints of public keys",
+	"provider.spaces_access_id":                                                     "the ID of an access key, used with spaces_secret_key",
+}
+
+func TestProviderSensitiveAttributes(t *testing.T) {
+	p := Provider()
+
+	var unmarked []string
+	var walk func(path string, attributes map[string]*schema.Schema, sensitive bool)
+	walk = func(path string, attributes map[string]*schema.Schema, sensitive bool) {
+		for name, attribute := range attributes {
+			attributePath := path + "." + name
+
+			if elem, ok := attribute.Elem.(*schema.Resource); ok {
+				walk(attributePath, elem.Schema, sensitive || attribute.Sensitive)
+				continue
+			}
+
+			if sensitive || attribute.Sensitive || !secretAttributePattern.MatchString(name) {
+				continue
+			}
+
+			switch attribute.Type {
+			case schema.TypeBool, schema.TypeInt, schema.TypeFloat:
+				continue
+			}
+
+			// The filter and sort blocks of list data sources refer to
+			// fields by their key.
+			if name == "key" && (strings.HasSuffix(path, ".filter") || strings.HasSuffix(path, ".sort")) {
+				continue
+			}
+
+			if _, ok := nonSensitiveAttributes[attributePath]; !ok {
+				unmarked = append(unmarked, attributePath)
+			}
+		}
+	}
+
+	walk("provider", p.Schema, false)
+	for name, r := range p.ResourcesMap {
+		walk(name, r.Schema, false)
+	}
+	for name, r := range p.DataSourcesMap {
+		walk(name, r.Schema, false)
+	}
+
+	sort.Strings(unmarked)
+	for _, path := range unmarked {
+		t.Errorf("%s looks like it holds a secret: mark it as Sensitive, or add it to nonSensitiveAttributes if it does not", path)
+	}
+}
+
+func TestProviderEmitCostSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v2/reserved_ips/192.0.2.1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	for _, enabled := range []bool{true, false} {
+		t.Run(fmt.Sprintf("Enabled=%t", enabled), func(t *testing.T) {
+			p := Provider()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				"token":             "12345",
+				"api_endpoint":      server.URL,
+				"emit_cost_summary": enabled,
+			}))
+			if diags.HasError() {
+				t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+			}
+
+			r := p.ResourcesMap["digitalocean_reserved_ip"]
+			d := r.TestResourceData()
+			d.SetId("192.0.2.1")
+			d.Set("region", "nyc3")
+
+			diags = r.DeleteContext(context.Background(), d, p.Meta())
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diagnosticsToString(diags))
+			}
+
+			if !enabled {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got: %s", diagnosticsToString(diags))
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "Estimated monthly cost change" {
+				t.Fatalf("expected a cost summary warning, got: %#v", diags)
+			}
+			if !strings.Contains(diags[0].Detail, "digitalocean_reserved_ip 192.0.2.1 destroyed: unknown") {
+				t.Errorf("expected the summary to include the reserved IP, got:\n%s", diags[0].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package reservedip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceDigitalOceanReservedIPV6() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanReservedIPV6Create,
+		UpdateContext: resourceDigitalOceanReservedIPV6Update,
+		ReadContext:   resourceDigitalOceanReservedIPV6Read,
+		DeleteContext: resourceDigitalOceanReservedIPV6Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanReservedIPV6Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the uniform resource name for the reserved ipv6",
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"droplet_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanReservedIPV6Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Creating a reserved IPv6 in a region")
+	regionOpts := &godo.ReservedIPV6CreateRequest{
+		Region: d.Get("region_slug").(string),
+	}
+
+	log.Printf("[DEBUG] Reserved IPv6 create: %#v", regionOpts)
+	reservedIP, _, err := client.ReservedIPV6s.Create(context.Background(), regionOpts)
+	if err != nil {
+		return diag.Errorf("Error creating reserved IPv6: %s", err)
+	}
+
+	d.SetId(reservedIP.IP)
+
+	if v, ok := d.GetOk("droplet_id"); ok {
+		log.Printf("[INFO] Assigning the reserved IPv6 to the Droplet %d", v.(int))
+		if err := assignReservedIPV6(ctx, client, d.Id(), v.(int), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDigitalOceanReservedIPV6Read(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPV6Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChange("droplet_id") {
+		if v, ok := d.GetOk("droplet_id"); ok {
+			log.Printf("[INFO] Assigning the reserved IPv6 %s to the Droplet %d", d.Id(), v.(int))
+			if err := assignReservedIPV6(ctx, client, d.Id(), v.(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("[INFO] Unassigning the reserved IPv6 %s", d.Id())
+			if err := unassignReservedIPV6(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceDigitalOceanReservedIPV6Read(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPV6Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", d.Id())
+	reservedIP, resp, err := client.ReservedIPV6s.Get(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Reserved IPv6 (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving reserved IPv6: %s", err)
+	}
+
+	d.Set("region_slug", reservedIP.RegionSlug)
+	d.Set("ip", reservedIP.IP)
+	d.Set("urn", reservedIP.URN())
+
+	// The reserved IPv6 may be assigned with digitalocean_reserved_ipv6_assignment
+	// instead, in which case droplet_id is not set. Otherwise, it is unset if the
+	// reserved IPv6 was unassigned out-of-band so that it is reassigned on the
+	// next apply.
+	if _, ok := d.GetOk("droplet_id"); ok {
+		if reservedIP.Droplet != nil {
+			d.Set("droplet_id", reservedIP.Droplet.ID)
+		} else {
+			d.Set("droplet_id", 0)
+		}
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanReservedIPV6Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if _, ok := d.GetOk("droplet_id"); ok {
+		log.Printf("[INFO] Unassigning the reserved IPv6 from the Droplet")
+		if err := unassignReservedIPV6(ctx, client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting reserved IPv6: %s", d.Id())
+	resp, err := client.ReservedIPV6s.Delete(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting reserved IPv6: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanReservedIPV6Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	reservedIP, _, err := client.ReservedIPV6s.Get(context.Background(), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if reservedIP.Droplet != nil {
+		d.Set("droplet_id", reservedIP.Droplet.ID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// assignReservedIPV6 assigns the reserved IPv6 to the Droplet and waits for the
+// action to complete. The assignment is retried while the Droplet has another
+// event in progress, e.g. when it is still being provisioned.
+func assignReservedIPV6(ctx context.Context, client *godo.Client, ip string, dropletID int, timeout time.Duration) error {
+	var action *godo.Action
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var resp *godo.Response
+		var err error
+		action, resp, err = client.ReservedIPV6Actions.Assign(context.Background(), ip, dropletID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && strings.Contains(err.Error(), "pending event") {
+				log.Printf("[DEBUG] Droplet %d has a pending event, retrying the assignment of reserved IPv6 (%s)", dropletID, ip)
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error assigning reserved IPv6 (%s) to the Droplet: %s", ip, err)
+	}
+
+	if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+		return fmt.Errorf("Error waiting for reserved IPv6 (%s) to be assigned: %s", ip, err)
+	}
+
+	return nil
+}
+
+// unassignReservedIPV6 unassigns the reserved IPv6 and waits for the action to
+// complete. A reserved IPv6 that is already unassigned is left as is.
+func unassignReservedIPV6(ctx context.Context, client *godo.Client, ip string, timeout time.Duration) error {
+	action, resp, err := client.ReservedIPV6Actions.Unassign(context.Background(), ip)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusUnprocessableEntity || resp.StatusCode == http.StatusNotFound) {
+			log.Printf("[DEBUG] Couldn't unassign reserved IPv6 (%s) from droplet, possibly out of sync: %s", ip, err)
+			return nil
+		}
+		return fmt.Errorf("Error unassigning reserved IPv6 (%s) from the Droplet: %s", ip, err)
+	}
+
+	if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+		return fmt.Errorf("Error waiting for reserved IPv6 (%s) to be unassigned: %s", ip, err)
+	}
+
+	return nil
+}
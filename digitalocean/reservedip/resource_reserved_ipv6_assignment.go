@@ -0,0 +1,136 @@
+package reservedip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanReservedIPV6Assignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanReservedIPV6AssignmentCreate,
+		ReadContext:   resourceDigitalOceanReservedIPV6AssignmentRead,
+		DeleteContext: resourceDigitalOceanReservedIPV6AssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanReservedIPV6AssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanReservedIPV6AssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ip := d.Get("ip").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Assigning the reserved IPv6 (%s) to the Droplet %d", ip, dropletID)
+	if err := assignReservedIPV6(ctx, client, ip, dropletID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%s-", dropletID, ip)))
+	return resourceDigitalOceanReservedIPV6AssignmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPV6AssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ip := d.Get("ip").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", ip)
+	reservedIP, resp, err := client.ReservedIPV6s.Get(context.Background(), ip)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Reserved IPv6 (%s) not found, removing the assignment from state", ip)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving reserved IPv6: %s", err)
+	}
+
+	if reservedIP.Droplet == nil || reservedIP.Droplet.ID != dropletID {
+		log.Printf("[INFO] The reserved IPv6 (%s) is no longer assigned to the Droplet %d, removing the assignment from state", ip, dropletID)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanReservedIPV6AssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ip := d.Get("ip").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", ip)
+	reservedIP, resp, err := client.ReservedIPV6s.Get(context.Background(), ip)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving reserved IPv6: %s", err)
+	}
+
+	if reservedIP.Droplet != nil && reservedIP.Droplet.ID == dropletID {
+		log.Printf("[INFO] Unassigning the reserved IPv6 from the Droplet")
+		if err := unassignReservedIPV6(ctx, client, ip, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		log.Printf("[INFO] reserved IPv6 already unassigned, removing from state.")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanReservedIPV6AssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-%s-", s[1], s[0])))
+		d.Set("ip", s[0])
+		dropletID, err := strconv.Atoi(s[1])
+		if err != nil {
+			return nil, err
+		}
+		d.Set("droplet_id", dropletID)
+	} else {
+		return nil, errors.New("must use the reserved IPv6 and the ID of the Droplet joined with a comma (e.g. `ip,droplet_id`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,168 @@
+package reservedip_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanReservedIPV6Assignment(t *testing.T) {
+	var reservedIPv6 godo.ReservedIPV6
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPV6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6AssignmentConfig(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+					resource.TestMatchResourceAttr(
+						"digitalocean_reserved_ipv6_assignment.foobar", "id", regexp.MustCompile("[0-9a-f:]+")),
+					resource.TestMatchResourceAttr(
+						"digitalocean_reserved_ipv6_assignment.foobar", "droplet_id", regexp.MustCompile("[0-9]+")),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6AssignmentConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+					resource.TestMatchResourceAttr(
+						"digitalocean_reserved_ipv6_assignment.foobar", "droplet_id", regexp.MustCompile("[0-9]+")),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6AssignmentDeleteAssignment(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6Exists("digitalocean_reserved_ipv6.foobar", &reservedIPv6),
+					testAccCheckDigitalOceanReservedIPV6AssignedTo(&reservedIPv6, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanReservedIPV6Assignment_unassignedOutOfBand(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPV6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6AssignmentConfig(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+					testAccUnassignDigitalOceanReservedIPV6("digitalocean_reserved_ipv6.foobar"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6AssignmentConfig(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanReservedIPV6AttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip"] == "" {
+			return fmt.Errorf("No reserved IPv6 is set")
+		}
+		ip := rs.Primary.Attributes["ip"]
+		dropletID, err := strconv.Atoi(rs.Primary.Attributes["droplet_id"])
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		foundReservedIP, _, err := client.ReservedIPV6s.Get(context.Background(), ip)
+		if err != nil {
+			return err
+		}
+
+		if foundReservedIP.IP != ip || foundReservedIP.Droplet == nil || foundReservedIP.Droplet.ID != dropletID {
+			return fmt.Errorf("wrong reserved IPv6 attachment found")
+		}
+
+		return nil
+	}
+}
+
+func testAccUnassignDigitalOceanReservedIPV6(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		action, _, err := client.ReservedIPV6Actions.Unassign(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return util.WaitForAction(client, action)
+	}
+}
+
+func testAccCheckDigitalOceanReservedIPV6AssignmentConfig(name string, index int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region_slug = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6_assignment" "foobar" {
+  ip         = digitalocean_reserved_ipv6.foobar.ip
+  droplet_id = digitalocean_droplet.foobar.%d.id
+}
+`, name, index)
+}
+
+func testAccCheckDigitalOceanReservedIPV6AssignmentDeleteAssignment(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region_slug = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+`, name)
+}
@@ -0,0 +1,199 @@
+package reservedip_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanReservedIPV6_RegionSlug(t *testing.T) {
+	var reservedIPv6 godo.ReservedIPV6
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPV6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6Config_regionSlug,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6Exists("digitalocean_reserved_ipv6.foobar", &reservedIPv6),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "region_slug", "nyc3"),
+					resource.TestMatchResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "urn", regexp.MustCompile("^do:reservedipv6:")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanReservedIPV6_Droplet(t *testing.T) {
+	var reservedIPv6 godo.ReservedIPV6
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPV6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6Config_droplet(name, "foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6Exists("digitalocean_reserved_ipv6.foobar", &reservedIPv6),
+					testAccCheckDigitalOceanReservedIPV6AssignedTo(&reservedIPv6, "digitalocean_droplet.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "region_slug", "nyc3"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6Config_droplet(name, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6Exists("digitalocean_reserved_ipv6.foobar", &reservedIPv6),
+					testAccCheckDigitalOceanReservedIPV6AssignedTo(&reservedIPv6, "digitalocean_droplet.baz"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPV6Config_unassign(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPV6Exists("digitalocean_reserved_ipv6.foobar", &reservedIPv6),
+					testAccCheckDigitalOceanReservedIPV6AssignedTo(&reservedIPv6, ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanReservedIPV6Destroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_reserved_ipv6" {
+			continue
+		}
+
+		_, _, err := client.ReservedIPV6s.Get(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Reserved IPv6 still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanReservedIPV6Exists(n string, reservedIPv6 *godo.ReservedIPV6) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		foundReservedIP, _, err := client.ReservedIPV6s.Get(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundReservedIP.IP != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		*reservedIPv6 = *foundReservedIP
+
+		return nil
+	}
+}
+
+// testAccCheckDigitalOceanReservedIPV6AssignedTo checks that the reserved IPv6
+// is assigned to the Droplet, or unassigned if n is empty.
+func testAccCheckDigitalOceanReservedIPV6AssignedTo(reservedIPv6 *godo.ReservedIPV6, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if n == "" {
+			if reservedIPv6.Droplet != nil {
+				return fmt.Errorf("Expected reserved IPv6 to be unassigned, but it is assigned to %d", reservedIPv6.Droplet.ID)
+			}
+			return nil
+		}
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dropletID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if reservedIPv6.Droplet == nil || reservedIPv6.Droplet.ID != dropletID {
+			return fmt.Errorf("Expected reserved IPv6 to be assigned to %d", dropletID)
+		}
+
+		return nil
+	}
+}
+
+var testAccCheckDigitalOceanReservedIPV6Config_regionSlug = `
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region_slug = "nyc3"
+}`
+
+func testAccCheckDigitalOceanReservedIPV6Config_droplet(name string, assignTo string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%[1]s-foobar"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_droplet" "baz" {
+  name   = "%[1]s-baz"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region_slug = "nyc3"
+  droplet_id  = digitalocean_droplet.%[2]s.id
+}`, name, assignTo)
+}
+
+func testAccCheckDigitalOceanReservedIPV6Config_unassign(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%[1]s-foobar"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_droplet" "baz" {
+  name   = "%[1]s-baz"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region_slug = "nyc3"
+}`, name)
+}
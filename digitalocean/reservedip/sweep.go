@@ -14,6 +14,11 @@ func init() {
 		F:    sweepReservedIPs,
 	})
 
+	resource.AddTestSweepers("digitalocean_reserved_ipv6", &resource.Sweeper{
+		Name: "digitalocean_reserved_ipv6",
+		F:    sweepReservedIPV6s,
+	})
+
 	resource.AddTestSweepers("digitalocean_floating_ip", &resource.Sweeper{
 		Name: "digitalocean_floating_ip",
 		F:    testSweepFloatingIps,
@@ -42,6 +47,28 @@ func sweepReservedIPs(region string) error {
 	return nil
 }
 
+func sweepReservedIPV6s(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ips, _, err := client.ReservedIPV6s.List(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, ip := range ips {
+		if _, err := client.ReservedIPV6s.Delete(context.Background(), ip.IP); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func testSweepFloatingIps(region string) error {
 	meta, err := sweep.SharedConfigForRegion(region)
 	if err != nil {
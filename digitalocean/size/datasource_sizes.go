@@ -2,7 +2,6 @@ package size
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -50,6 +49,11 @@ func DataSourceDigitalOceanSizes() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of region slugs where Droplets can be created in this size.",
 			},
+			"gpu": {
+				Type:        schema.TypeBool,
+				Description: "This represents whether Droplets of this size have GPUs.",
+			},
+			"gpu_info": GPUInfoSchema(),
 		},
 		ResultAttributeName: "sizes",
 		FlattenRecord:       flattenDigitalOceanSize,
@@ -62,33 +66,14 @@ func DataSourceDigitalOceanSizes() *schema.Resource {
 func getDigitalOceanSizes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	sizes := []interface{}{}
-
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
+	allSizes, err := ListSizes(context.Background(), client)
+	if err != nil {
+		return nil, err
 	}
 
-	for {
-		partialSizes, resp, err := client.Sizes.List(context.Background(), opts)
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
-		}
-
-		for _, partialSize := range partialSizes {
-			sizes = append(sizes, partialSize)
-		}
-
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
-		}
-
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
-		}
-
-		opts.Page = page + 1
+	sizes := make([]interface{}, 0, len(allSizes))
+	for _, s := range allSizes {
+		sizes = append(sizes, s)
 	}
 
 	return sizes, nil
@@ -113,5 +98,8 @@ func flattenDigitalOceanSize(size, meta interface{}, extra map[string]interface{
 	}
 	flattenedSize["regions"] = flattenedRegions
 
+	flattenedSize["gpu"] = s.GPUInfo != nil
+	flattenedSize["gpu_info"] = FlattenGPUInfo(s.GPUInfo)
+
 	return flattenedSize, nil
 }
@@ -0,0 +1,120 @@
+package size
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ListSizes returns all of the Droplet sizes.
+func ListSizes(ctx context.Context, client *godo.Client) ([]godo.Size, error) {
+	sizes := []godo.Size{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialSizes, resp, err := client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		sizes = append(sizes, partialSizes...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return sizes, nil
+}
+
+// FindSize returns the size with the given slug, or nil if there is none.
+func FindSize(ctx context.Context, client *godo.Client, slug string) (*godo.Size, error) {
+	sizes, err := ListSizes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sizes {
+		if s.Slug == slug {
+			return &s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GPUInfoSchema is the schema of the computed `gpu_info` attribute.
+func GPUInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Details of the GPUs of the size. Empty for sizes without GPUs.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of GPUs.",
+				},
+				"model": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The model of the GPUs.",
+				},
+				"vram": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The amount of video memory of each GPU.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"amount": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"unit": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// FlattenGPUInfo flattens GPU details for the `gpu_info` attribute.
+func FlattenGPUInfo(info *godo.GPUInfo) []interface{} {
+	if info == nil {
+		return []interface{}{}
+	}
+
+	vram := []interface{}{}
+	if info.VRAM != nil {
+		vram = append(vram, map[string]interface{}{
+			"amount": info.VRAM.Amount,
+			"unit":   info.VRAM.Unit,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"count": info.Count,
+			"model": info.Model,
+			"vram":  vram,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package size
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestListSizes(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprintf(w, `{"sizes":[{"slug":"s-1vcpu-1gb","disk":25,"regions":["nyc1"]}],"links":{"pages":{"next":"http://%s/v2/sizes?page=2","last":"http://%s/v2/sizes?page=2"}}}`, r.Host, r.Host)
+			return
+		}
+		fmt.Fprintf(w, `{"sizes":[{"slug":"gpu-h100x1-80gb","disk":720,"regions":["tor1"],"gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}}],"links":{"pages":{"first":"http://%s/v2/sizes?page=1","prev":"http://%s/v2/sizes?page=1"}}}`, r.Host, r.Host)
+	})).GodoClient()
+
+	sizes, err := ListSizes(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(sizes) != 2 {
+		t.Fatalf("expected 2 sizes, got %d", len(sizes))
+	}
+	if sizes[0].Slug != "s-1vcpu-1gb" || sizes[0].Disk != 25 || sizes[0].GPUInfo != nil {
+		t.Errorf("unexpected size: %#v", sizes[0])
+	}
+
+	gpu, err := FindSize(context.Background(), client, "gpu-h100x1-80gb")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gpu == nil || gpu.GPUInfo == nil {
+		t.Fatalf("expected GPU size, got %#v", gpu)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"count": 1,
+			"model": "nvidia_h100",
+			"vram": []interface{}{
+				map[string]interface{}{"amount": 80, "unit": "gib"},
+			},
+		},
+	}
+	if got := FlattenGPUInfo(gpu.GPUInfo); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+
+	if got := FlattenGPUInfo(nil); len(got) != 0 {
+		t.Errorf("expected no GPU info, got %#v", got)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,6 +64,7 @@ func DataSourceDigitalOceanDropletSnapshot() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
+			"tags": snapshotTagsFilterSchema(),
 		},
 	}
 }
@@ -74,9 +76,10 @@ func dataSourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Re
 	name, hasName := d.GetOk("name")
 	nameRegex, hasNameRegex := d.GetOk("name_regex")
 	region, hasRegion := d.GetOk("region")
+	tags, hasTags := d.GetOk("tags")
 
-	if !hasName && !hasNameRegex {
-		return diag.Errorf("One of `name` or `name_regex` must be assigned")
+	if !hasName && !hasNameRegex && !hasTags {
+		return diag.Errorf("One of `name`, `name_regex`, or `tags` must be assigned")
 	}
 
 	opts := &godo.ListOptions{
@@ -110,12 +113,15 @@ func dataSourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Re
 	// Go through all the possible filters
 	if hasName {
 		snapshotList = filterSnapshotsByName(snapshotList, name.(string))
-	} else {
+	} else if hasNameRegex {
 		snapshotList = filterSnapshotsByNameRegex(snapshotList, nameRegex.(string))
 	}
 	if hasRegion {
 		snapshotList = filterSnapshotsByRegion(snapshotList, region.(string))
 	}
+	if hasTags {
+		snapshotList = filterSnapshotsByTags(snapshotList, tag.ExpandTags(tags.(*schema.Set).List()))
+	}
 
 	// Get the queried snapshot or fail if it can't be determined
 	var snapshot *godo.Snapshot
@@ -142,6 +148,7 @@ func dataSourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Re
 	d.Set("regions", snapshot.Regions)
 	d.Set("droplet_id", snapshot.ResourceID)
 	d.Set("size", snapshot.SizeGigaBytes)
+	d.Set("tags", tag.FlattenTags(snapshot.Tags))
 
 	return nil
 }
@@ -44,6 +44,36 @@ data "digitalocean_droplet_snapshot" "foobar" {
 	})
 }
 
+func TestAccDataSourceDigitalOceanDropletSnapshot_tags(t *testing.T) {
+	var snapshot godo.Snapshot
+	dropletName := acceptance.RandomTestName()
+	snapName := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDataSourceDigitalOceanDropletSnapshot_tags, dropletName, snapName, tagName)
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_droplet_snapshot" "foobar" {
+  tags = ["%s"]
+}`, tagName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceDigitalOceanDropletSnapshotExists("data.digitalocean_droplet_snapshot.foobar", &snapshot),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_snapshot.foobar", "name", snapName),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_snapshot.foobar", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceDigitalOceanDropletSnapshot_regex(t *testing.T) {
 	var snapshot godo.Snapshot
 	dropletName := acceptance.RandomTestName()
@@ -162,3 +192,18 @@ resource "digitalocean_droplet_snapshot" "foo" {
   droplet_id = digitalocean_droplet.foo.id
 }
 `
+
+const testAccCheckDataSourceDigitalOceanDropletSnapshot_tags = `
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foo" {
+  name       = "%s"
+  droplet_id = digitalocean_droplet.foo.id
+  tags       = ["%s"]
+}
+`
@@ -11,6 +11,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -67,7 +68,7 @@ func DataSourceDigitalOceanVolumeSnapshot() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
-			"tags": tag.TagsDataSourceSchema(),
+			"tags": snapshotTagsFilterSchema(),
 		},
 	}
 }
@@ -79,9 +80,10 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 	name, hasName := d.GetOk("name")
 	nameRegex, hasNameRegex := d.GetOk("name_regex")
 	region, hasRegion := d.GetOk("region")
+	tags, hasTags := d.GetOk("tags")
 
-	if !hasName && !hasNameRegex {
-		return diag.Errorf("One of `name` or `name_regex` must be assigned")
+	if !hasName && !hasNameRegex && !hasTags {
+		return diag.Errorf("One of `name`, `name_regex`, or `tags` must be assigned")
 	}
 
 	opts := &godo.ListOptions{
@@ -115,12 +117,15 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 	// Go through all the possible filters
 	if hasName {
 		snapshotList = filterSnapshotsByName(snapshotList, name.(string))
-	} else {
+	} else if hasNameRegex {
 		snapshotList = filterSnapshotsByNameRegex(snapshotList, nameRegex.(string))
 	}
 	if hasRegion {
 		snapshotList = filterSnapshotsByRegion(snapshotList, region.(string))
 	}
+	if hasTags {
+		snapshotList = filterSnapshotsByTags(snapshotList, tag.ExpandTags(tags.(*schema.Set).List()))
+	}
 
 	// Get the queried snapshot or fail if it can't be determined
 	var snapshot *godo.Snapshot
@@ -187,6 +192,44 @@ func filterSnapshotsByRegion(snapshots []godo.Snapshot, region string) []godo.Sn
 }
 
 // Returns the most recent Snapshot out of a slice of Snapshots.
+func filterSnapshotsByTags(snapshots []godo.Snapshot, tags []string) []godo.Snapshot {
+	result := make([]godo.Snapshot, 0)
+	for _, s := range snapshots {
+		snapshotTags := make(map[string]bool, len(s.Tags))
+		for _, t := range s.Tags {
+			snapshotTags[strings.ToLower(t)] = true
+		}
+
+		hasAll := true
+		for _, t := range tags {
+			if !snapshotTags[strings.ToLower(t)] {
+				hasAll = false
+				break
+			}
+		}
+
+		if hasAll {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// snapshotTagsFilterSchema returns the snapshot's tags, or when set, only
+// matches snapshots having all of the given tags.
+func snapshotTagsFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: tag.ValidateTag,
+		},
+		Set: util.HashStringIgnoreCase,
+	}
+}
+
 func findMostRecentSnapshot(snapshots []godo.Snapshot) *godo.Snapshot {
 	sort.Slice(snapshots, func(i, j int) bool {
 		itime, _ := time.Parse(time.RFC3339, snapshots[i].Created)
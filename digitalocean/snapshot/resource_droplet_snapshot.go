@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,9 +21,10 @@ func ResourceDigitalOceanDropletSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDropletSnapshotCreate,
 		ReadContext:   resourceDigitalOceanDropletSnapshotRead,
+		UpdateContext: resourceDigitalOceanDropletSnapshotUpdate,
 		DeleteContext: resourceDigitalOceanDropletSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDigitalOceanDropletSnapshotImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -54,20 +57,53 @@ func ResourceDigitalOceanDropletSnapshot() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"power_off": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to shut down the Droplet before taking the snapshot, and power it back on afterwards",
+			},
+			"tags": tag.TagsSchema(),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
 		},
 	}
 }
 
-func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	resourceId, _ := strconv.Atoi(d.Get("droplet_id").(string))
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	if d.Get("power_off").(bool) {
+		poweredOff, err := powerOffDropletForSnapshot(ctx, client, resourceId, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The Droplet is powered back on whether or not the snapshot succeeds.
+		if poweredOff {
+			defer func() {
+				if err := powerOnDropletAfterSnapshot(ctx, client, resourceId, timeout); err != nil {
+					diags = append(diags, diag.FromErr(err)...)
+				}
+			}()
+		}
+	}
+
+	if err := util.WaitForNoPendingDropletAction(ctx, client, resourceId, timeout); err != nil {
+		return diag.Errorf("Error waiting for the pending action of Droplet (%d) to finish: %s", resourceId, err)
+	}
+
 	action, _, err := client.DropletActions.Snapshot(context.Background(), resourceId, d.Get("name").(string))
 	if err != nil {
 		return diag.Errorf("Error creating Droplet Snapshot: %s", err)
 	}
 
-	if err = util.WaitForAction(client, action); err != nil {
+	if err = util.WaitForActionContext(ctx, client, action, timeout); err != nil {
 		return diag.Errorf(
 			"Error waiting for Droplet snapshot (%v) to finish: %s", resourceId, err)
 	}
@@ -79,10 +115,15 @@ func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.Re
 	}
 
 	d.SetId(strconv.Itoa(snapshot.ID))
+
+	if err = waitForDropletSnapshotAvailable(ctx, client, snapshot.ID, timeout); err != nil {
+		return diag.Errorf("Error waiting for Droplet snapshot (%s) to become available: %s", d.Id(), err)
+	}
+
 	if err = d.Set("name", snapshot.Name); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("droplet_id", fmt.Sprintf("%d", snapshot.ID)); err != nil {
+	if err = d.Set("droplet_id", strconv.Itoa(action.ResourceID)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("regions", snapshot.Regions); err != nil {
@@ -95,6 +136,34 @@ func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
+	// The snapshot action does not accept tags, so they are applied once the
+	// snapshot exists. If that fails, remove the snapshot rather than leave
+	// behind one that tag based tooling can't find.
+	if d.Get("tags").(*schema.Set).Len() > 0 {
+		if err := tag.SetTags(client, d, godo.ImageResourceType); err != nil {
+			log.Printf("[WARN] Error tagging Droplet snapshot (%s), deleting it: %s", d.Id(), err)
+			if _, deleteErr := client.Snapshots.Delete(context.Background(), d.Id()); deleteErr != nil {
+				return diag.Errorf("Error tagging Droplet snapshot (%s): %s; the snapshot could not be deleted: %s", d.Id(), err, deleteErr)
+			}
+
+			d.SetId("")
+			return diag.Errorf("Error tagging Droplet snapshot: %s", err)
+		}
+	}
+
+	return resourceDigitalOceanDropletSnapshotRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChange("tags") {
+		err := tag.SetTags(client, d, godo.ImageResourceType)
+		if err != nil {
+			return diag.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDropletSnapshotRead(ctx, d, meta)
 }
 
@@ -118,16 +187,28 @@ func resourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Reso
 	d.Set("size", snapshot.SizeGigaBytes)
 	d.Set("created_at", snapshot.Created)
 	d.Set("min_disk_size", snapshot.MinDiskSize)
+	d.Set("tags", tag.FlattenTags(snapshot.Tags))
 
 	return nil
 }
 
+func resourceDigitalOceanDropletSnapshotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// This is a non API attribute. So set to the default setting in the schema.
+	d.Set("power_off", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDigitalOceanDropletSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting snapshot: %s", d.Id())
-	_, err := client.Snapshots.Delete(context.Background(), d.Id())
+	resp, err := client.Snapshots.Delete(context.Background(), d.Id())
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error deleting snapshot: %s", err)
 	}
 
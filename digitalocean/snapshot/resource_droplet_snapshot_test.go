@@ -3,6 +3,7 @@ package snapshot_test
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -34,6 +35,98 @@ func TestAccDigitalOceanDropletSnapshot_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDropletSnapshot_tags(t *testing.T) {
+	var snapshot godo.Snapshot
+	dName := acceptance.RandomTestName()
+	snapName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDropletSnapshotConfig_tags, dName, snapName, `"foo", "retention-30d"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletSnapshotExists("digitalocean_droplet_snapshot.foobar", &snapshot),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_snapshot.foobar", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"digitalocean_droplet_snapshot.foobar", "tags.*", "retention-30d"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDropletSnapshotConfig_tags, dName, snapName, `"retention-7d"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletSnapshotExists("digitalocean_droplet_snapshot.foobar", &snapshot),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_snapshot.foobar", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"digitalocean_droplet_snapshot.foobar", "tags.*", "retention-7d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletSnapshot_PowerOff(t *testing.T) {
+	var snapshot godo.Snapshot
+	dName := acceptance.RandomTestName()
+	snapName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDropletSnapshotConfig_powerOff, dName, snapName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletSnapshotExists("digitalocean_droplet_snapshot.foobar", &snapshot),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_snapshot.foobar", "power_off", "true"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_droplet_snapshot.foobar", "droplet_id", "digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet_snapshot.foobar", "size"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet_snapshot.foobar", "min_disk_size"),
+					testAccCheckDigitalOceanDropletActive("digitalocean_droplet.foo"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDigitalOceanDropletActive checks that the Droplet was powered
+// back on after the snapshot was taken.
+func testAccCheckDigitalOceanDropletActive(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		droplet, _, err := client.Droplets.Get(context.Background(), id)
+		if err != nil {
+			return err
+		}
+
+		if droplet.Status != "active" {
+			return fmt.Errorf("Expected the Droplet to be active, got: %s", droplet.Status)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanDropletSnapshotExists(n string, snapshot *godo.Snapshot) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
@@ -95,3 +188,31 @@ resource "digitalocean_droplet_snapshot" "foobar" {
   droplet_id = digitalocean_droplet.foo.id
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDropletSnapshotConfig_tags = `
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id = digitalocean_droplet.foo.id
+  name       = "%s"
+  tags       = [%s]
+}`
+
+const testAccCheckDigitalOceanDropletSnapshotConfig_powerOff = `
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id = digitalocean_droplet.foo.id
+  name       = "%s"
+  power_off  = true
+}`
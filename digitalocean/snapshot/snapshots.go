@@ -0,0 +1,92 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// powerOffDropletForSnapshot shuts down the Droplet so that the snapshot is
+// consistent. If the Droplet can not be shut down gracefully, it is powered
+// off. It returns false if the Droplet was already off.
+func powerOffDropletForSnapshot(ctx context.Context, client *godo.Client, id int, timeout time.Duration) (bool, error) {
+	droplet, _, err := client.Droplets.Get(context.Background(), id)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving Droplet (%d): %s", id, err)
+	}
+
+	if droplet.Status == "off" {
+		log.Printf("[INFO] Droplet (%d) is already off, skipping shutdown", id)
+		return false, nil
+	}
+
+	if err := util.WaitForNoPendingDropletAction(ctx, client, id, timeout); err != nil {
+		return false, fmt.Errorf("Error waiting for the pending action of Droplet (%d) to finish: %s", id, err)
+	}
+
+	log.Printf("[INFO] Shutting down Droplet (%d) for snapshot", id)
+	action, _, err := client.DropletActions.Shutdown(context.Background(), id)
+	if err == nil {
+		err = util.WaitForActionContext(ctx, client, action, timeout)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	log.Printf("[WARN] Droplet (%d) could not be shut down, powering it off: %s", id, err)
+	action, _, err = client.DropletActions.PowerOff(context.Background(), id)
+	if err != nil {
+		return false, fmt.Errorf("Error powering off Droplet (%d): %s", id, err)
+	}
+
+	if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+		return true, fmt.Errorf("Error waiting for Droplet (%d) to power off: %s", id, err)
+	}
+
+	return true, nil
+}
+
+func powerOnDropletAfterSnapshot(ctx context.Context, client *godo.Client, id int, timeout time.Duration) error {
+	if err := util.WaitForNoPendingDropletAction(ctx, client, id, timeout); err != nil {
+		return fmt.Errorf("Error waiting for the pending action of Droplet (%d) to finish: %s", id, err)
+	}
+
+	log.Printf("[INFO] Powering on Droplet (%d) after snapshot", id)
+	action, _, err := client.DropletActions.PowerOn(context.Background(), id)
+	if err != nil {
+		return fmt.Errorf("Error powering on Droplet (%d) after snapshot: %s", id, err)
+	}
+
+	if err := util.WaitForActionContext(ctx, client, action, timeout); err != nil {
+		return fmt.Errorf("Error waiting for Droplet (%d) to power on after snapshot: %s", id, err)
+	}
+
+	return nil
+}
+
+// waitForDropletSnapshotAvailable waits for the image created by the snapshot
+// action to become available, as it may still be processed once the action
+// has completed.
+func waitForDropletSnapshotAvailable(ctx context.Context, client *godo.Client, id int, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		image, _, err := client.Images.GetByID(context.Background(), id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		switch image.Status {
+		case "", "available":
+			return nil
+		case "NEW", "pending":
+			log.Printf("[DEBUG] Droplet snapshot (%d) is %s, waiting for it to become available", id, image.Status)
+			return retry.RetryableError(fmt.Errorf("Droplet snapshot (%d) is %s", id, image.Status))
+		default:
+			return retry.NonRetryableError(fmt.Errorf("Droplet snapshot (%d) has an unexpected status: %s", id, image.Status))
+		}
+	})
+}
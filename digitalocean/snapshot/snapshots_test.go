@@ -0,0 +1,86 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestWaitForDropletSnapshotAvailable(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statuses         []string
+		expectedRequests int
+		expectedErrorMsg string
+	}{
+		{"Available", []string{"available"}, 1, ""},
+		{"NoStatus", []string{""}, 1, ""},
+		{"Pending", []string{"NEW", "pending", "available"}, 3, ""},
+		{"Deleted", []string{"pending", "deleted"}, 2, "unexpected status: deleted"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v2/images/1234" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				status := tc.statuses[requests]
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"image":{"id":1234,"name":"snap","status":%q}}`, status)
+			})).GodoClient()
+
+			err := waitForDropletSnapshotAvailable(context.Background(), client, 1234, time.Minute)
+			if requests != tc.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+			if tc.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErrorMsg) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErrorMsg, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestPowerOffDropletForSnapshotAlreadyOff(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/droplets/1234" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"droplet":{"id":1234,"status":"off"}}`)
+	})).GodoClient()
+
+	poweredOff, err := powerOffDropletForSnapshot(context.Background(), client, 1234, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if poweredOff {
+		t.Error("expected a Droplet that is already off not to be powered off, or back on")
+	}
+}
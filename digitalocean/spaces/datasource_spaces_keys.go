@@ -0,0 +1,17 @@
+package spaces
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanSpacesKeys() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        spacesKeySchema(),
+		ResultAttributeName: "keys",
+		FlattenRecord:       flattenDigitalOceanSpacesKey,
+		GetRecords:          getDigitalOceanSpacesKeys,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
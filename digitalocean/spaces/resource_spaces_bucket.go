@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -468,8 +469,7 @@ func resourceDigitalOceanBucketRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", d.Get("name").(string))
 
 	// Set the URN attribute.
-	urn := fmt.Sprintf("do:space:%s", d.Get("name"))
-	d.Set("urn", urn)
+	d.Set("urn", urn.Format(urn.Space, d.Get("name").(string)))
 
 	// Set the bucket's endpoint.
 	d.Set("endpoint", BucketEndpoint(d.Get("region").(string)))
@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
+	"net/url"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -94,6 +98,12 @@ func ResourceDigitalOceanSpacesBucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"disable_content_type_detection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -203,8 +213,8 @@ func resourceDigitalOceanSpacesBucketObjectPut(ctx context.Context, d *schema.Re
 		putInput.CacheControl = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("content_type"); ok {
-		putInput.ContentType = aws.String(v.(string))
+	if v := spacesBucketObjectContentType(d); v != "" {
+		putInput.ContentType = aws.String(v)
 	}
 
 	if v, ok := d.GetOk("metadata"); ok {
@@ -291,19 +301,12 @@ func resourceDigitalOceanSpacesBucketObjectRead(ctx context.Context, d *schema.R
 }
 
 func resourceDigitalOceanSpacesBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Changes to any of these attributes requires creation of a new object version (if bucket is versioned):
+	// Changes to any of these attributes requires uploading the object body again:
 	for _, key := range []string{
-		"cache_control",
 		"content_base64",
-		"content_disposition",
-		"content_encoding",
-		"content_language",
-		"content_type",
 		"content",
 		"etag",
-		"metadata",
 		"source",
-		"website_redirect",
 	} {
 		if d.HasChange(key) {
 			return resourceDigitalOceanSpacesBucketObjectPut(ctx, d, meta)
@@ -318,6 +321,16 @@ func resourceDigitalOceanSpacesBucketObjectUpdate(ctx context.Context, d *schema
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
+	// Metadata can only be changed by copying the object onto itself, which
+	// creates a new object version (if bucket is versioned) but leaves the body intact.
+	if d.HasChanges(spacesBucketObjectMetadataAttributes...) {
+		if err := copySpacesBucketObjectMetadata(conn, d); err != nil {
+			return diag.Errorf("error updating Spaces Bucket (%s) Object (%s) metadata: %s", bucket, key, err)
+		}
+
+		return resourceDigitalOceanSpacesBucketObjectRead(ctx, d, meta)
+	}
+
 	if d.HasChange("acl") {
 		_, err := conn.PutObjectAcl(&s3.PutObjectAclInput{
 			Bucket: aws.String(bucket),
@@ -332,6 +345,86 @@ func resourceDigitalOceanSpacesBucketObjectUpdate(ctx context.Context, d *schema
 	return resourceDigitalOceanSpacesBucketObjectRead(ctx, d, meta)
 }
 
+// spacesBucketObjectMetadataAttributes are the attributes stored as object
+// headers rather than as part of the object body.
+var spacesBucketObjectMetadataAttributes = []string{
+	"cache_control",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	"content_type",
+	"metadata",
+	"website_redirect",
+}
+
+// copySpacesBucketObjectMetadata replaces an object's headers by copying it
+// onto itself. The ACL is not preserved by a copy, so it is sent again.
+func copySpacesBucketObjectMetadata(conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	copyInput := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(bucket + "/" + (&url.URL{Path: strings.TrimPrefix(key, "/")}).EscapedPath()),
+		ACL:               aws.String(d.Get("acl").(string)),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		copyInput.CacheControl = aws.String(v.(string))
+	}
+
+	if v := spacesBucketObjectContentType(d); v != "" {
+		copyInput.ContentType = aws.String(v)
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		copyInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		copyInput.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		copyInput.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		copyInput.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		copyInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying Spaces Bucket Object to replace metadata: %s", copyInput)
+	_, err := conn.CopyObject(copyInput)
+	return err
+}
+
+// spacesBucketObjectContentType returns the configured content type. If none
+// is set, it is inferred from the extension of the source file or key unless
+// detection has been disabled.
+func spacesBucketObjectContentType(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("content_type"); ok {
+		return v.(string)
+	}
+
+	if d.Get("disable_content_type_detection").(bool) {
+		return ""
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		if contentType := mime.TypeByExtension(filepath.Ext(v.(string))); contentType != "" {
+			return contentType
+		}
+	}
+
+	return mime.TypeByExtension(path.Ext(d.Get("key").(string)))
+}
+
 func resourceDigitalOceanSpacesBucketObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3conn, err := s3connFromResourceData(d, meta)
 	if err != nil {
@@ -377,6 +470,18 @@ func resourceDigitalOceanSpacesBucketObjectCustomizeDiff(
 		d.SetNewComputed("version_id")
 	}
 
+	for _, key := range spacesBucketObjectMetadataAttributes {
+		if d.HasChange(key) {
+			d.SetNewComputed("version_id")
+			break
+		}
+	}
+
+	// Let an inferred content type follow changes to the source file.
+	if d.GetRawConfig().GetAttr("content_type").IsNull() && d.HasChanges("source", "disable_content_type_detection") {
+		d.SetNewComputed("content_type")
+	}
+
 	return nil
 }
 
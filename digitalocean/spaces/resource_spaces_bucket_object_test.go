@@ -384,6 +384,40 @@ func TestAccDigitalOceanSpacesBucketObject_metadata(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanSpacesBucketObject_contentTypeDetection(t *testing.T) {
+	name := acceptance.RandomTestName()
+	var obj s3.GetObjectOutput
+	resourceName := "digitalocean_spaces_bucket_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanSpacesBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanSpacesBucketObjectConfig_contentTypeDetection(name, "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanSpacesBucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=60"),
+				),
+			},
+			{
+				// Only the headers change, so the object is copied rather than uploaded again.
+				Config: testAccDigitalOceanSpacesBucketObjectConfig_contentTypeDetection(name, "no-cache"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanSpacesBucketObjectExists(resourceName, &obj),
+					testAccCheckDigitalOceanSpacesBucketObjectBody(&obj, "<h1>hello</h1>"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+					testAccCheckDigitalOceanSpacesBucketObjectAcl(resourceName, []string{"FULL_CONTROL", "READ"}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanSpacesBucketObject_RegionError(t *testing.T) {
 	badRegion := "ny2"
 	resource.ParallelTest(t, resource.TestCase{
@@ -742,6 +776,25 @@ resource "digitalocean_spaces_bucket_object" "object" {
 `, testAccDigitalOceanSpacesBucketObject_TestRegion, name, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
 }
 
+func testAccDigitalOceanSpacesBucketObjectConfig_contentTypeDetection(name string, cacheControl string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_spaces_bucket" "object_bucket" {
+  region        = "%s"
+  name          = "%s"
+  force_destroy = true
+}
+
+resource "digitalocean_spaces_bucket_object" "object" {
+  region        = digitalocean_spaces_bucket.object_bucket.region
+  bucket        = digitalocean_spaces_bucket.object_bucket.name
+  key           = "index.html"
+  content       = "<h1>hello</h1>"
+  acl           = "public-read"
+  cache_control = "%s"
+}
+`, testAccDigitalOceanSpacesBucketObject_TestRegion, name, cacheControl)
+}
+
 func testAccDigitalOceanSpacesBucketObjectConfig_NonVersioned(name string, source string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_spaces_bucket" "object_bucket_3" {
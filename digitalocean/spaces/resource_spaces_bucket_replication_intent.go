@@ -0,0 +1,251 @@
+package spaces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Spaces does not replicate buckets across regions. A replication intent only
+// records, in the state, which bucket is to be copied to which other bucket,
+// so that external replication workers can consume it from replication_json.
+// Both buckets are validated when the intent is created or changed, and again
+// whenever it is read. Should Spaces ever support replication, this resource
+// is where it would be configured.
+
+// replicationIntentVersion is the version of the document in
+// replication_json, to be increased on incompatible changes.
+const replicationIntentVersion = 1
+
+type replicationIntentBucket struct {
+	Bucket   string `json:"bucket"`
+	Region   string `json:"region"`
+	Endpoint string `json:"endpoint"`
+}
+
+type replicationIntentFilter struct {
+	Prefixes []string `json:"prefixes"`
+}
+
+type replicationIntent struct {
+	Version     int                     `json:"version"`
+	Source      replicationIntentBucket `json:"source"`
+	Destination replicationIntentBucket `json:"destination"`
+	Filter      replicationIntentFilter `json:"filter"`
+}
+
+func ResourceDigitalOceanSpacesBucketReplicationIntent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanBucketReplicationIntentCreate,
+		ReadContext:   resourceDigitalOceanBucketReplicationIntentRead,
+		UpdateContext: resourceDigitalOceanBucketReplicationIntentUpdate,
+		DeleteContext: resourceDigitalOceanBucketReplicationIntentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanBucketReplicationIntentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"source_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
+			},
+			"destination_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
+			},
+			"prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"replication_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("replication_json", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("prefixes")
+		}),
+	}
+}
+
+// replicationIntentID returns the ID of the intent to replicate the source
+// bucket to the destination bucket, which is also the format of imports.
+func replicationIntentID(sourceRegion, sourceBucket, destinationRegion, destinationBucket string) string {
+	return strings.Join([]string{sourceRegion, sourceBucket, destinationRegion, destinationBucket}, ",")
+}
+
+func expandReplicationIntent(d *schema.ResourceData) *replicationIntent {
+	prefixes := []string{}
+	for _, prefix := range d.Get("prefixes").(*schema.Set).List() {
+		prefixes = append(prefixes, prefix.(string))
+	}
+	sort.Strings(prefixes)
+
+	sourceRegion := strings.ToLower(d.Get("source_region").(string))
+	destinationRegion := strings.ToLower(d.Get("destination_region").(string))
+
+	return &replicationIntent{
+		Version: replicationIntentVersion,
+		Source: replicationIntentBucket{
+			Bucket:   d.Get("source_bucket").(string),
+			Region:   sourceRegion,
+			Endpoint: BucketEndpoint(sourceRegion),
+		},
+		Destination: replicationIntentBucket{
+			Bucket:   d.Get("destination_bucket").(string),
+			Region:   destinationRegion,
+			Endpoint: BucketEndpoint(destinationRegion),
+		},
+		Filter: replicationIntentFilter{
+			Prefixes: prefixes,
+		},
+	}
+}
+
+// checkReplicationBucket returns whether the bucket exists and whether
+// versioning is enabled on it, which is required so that deletions and
+// overwrites can be replicated.
+func checkReplicationBucket(meta interface{}, bucket replicationIntentBucket) (bool, bool, error) {
+	client, err := meta.(*config.CombinedConfig).SpacesClient(bucket.Region)
+	if err != nil {
+		return false, false, err
+	}
+
+	svc := s3.New(client)
+
+	_, err = svc.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(bucket.Bucket)})
+	if err != nil {
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			return false, false, nil
+		}
+		return false, false, fmt.Errorf("error reading Spaces bucket %q in %s: %s", bucket.Bucket, bucket.Region, err)
+	}
+
+	versioning, err := svc.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String(bucket.Bucket)})
+	if err != nil {
+		return true, false, fmt.Errorf("error reading the versioning of Spaces bucket %q in %s: %s", bucket.Bucket, bucket.Region, err)
+	}
+
+	return true, aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled, nil
+}
+
+func validateReplicationIntent(meta interface{}, intent *replicationIntent) error {
+	if intent.Source.Bucket == intent.Destination.Bucket && intent.Source.Region == intent.Destination.Region {
+		return fmt.Errorf("the destination bucket must be different from the source bucket")
+	}
+
+	for _, bucket := range []replicationIntentBucket{intent.Source, intent.Destination} {
+		exists, versioned, err := checkReplicationBucket(meta, bucket)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Spaces bucket %q does not exist in %s", bucket.Bucket, bucket.Region)
+		}
+		if !versioned {
+			return fmt.Errorf("versioning must be enabled on Spaces bucket %q in %s to replicate it", bucket.Bucket, bucket.Region)
+		}
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanBucketReplicationIntentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 4 {
+		return nil, fmt.Errorf("importing a Spaces bucket replication intent requires the format: <source region>,<source bucket>,<destination region>,<destination bucket>")
+	}
+
+	d.Set("source_region", s[0])
+	d.Set("source_bucket", s[1])
+	d.Set("destination_region", s[2])
+	d.Set("destination_bucket", s[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDigitalOceanBucketReplicationIntentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	intent := expandReplicationIntent(d)
+
+	log.Printf("[DEBUG] Validating Spaces bucket replication intent: %s to %s", intent.Source.Bucket, intent.Destination.Bucket)
+	if err := validateReplicationIntent(meta, intent); err != nil {
+		return diag.Errorf("Error creating Spaces bucket replication intent: %s", err)
+	}
+
+	d.SetId(replicationIntentID(intent.Source.Region, intent.Source.Bucket, intent.Destination.Region, intent.Destination.Bucket))
+
+	return resourceDigitalOceanBucketReplicationIntentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBucketReplicationIntentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	intent := expandReplicationIntent(d)
+
+	// An intent whose buckets are gone, or whose buckets are no longer
+	// versioned, can not be acted upon. It is removed so that the next apply
+	// creates it again, validating the buckets.
+	for _, bucket := range []replicationIntentBucket{intent.Source, intent.Destination} {
+		exists, versioned, err := checkReplicationBucket(meta, bucket)
+		if err != nil {
+			return diag.Errorf("Error reading Spaces bucket replication intent: %s", err)
+		}
+		if !exists {
+			log.Printf("[WARN] Spaces bucket %q of replication intent (%s) not found, removing from state", bucket.Bucket, d.Id())
+			d.SetId("")
+			return nil
+		}
+		if !versioned {
+			log.Printf("[WARN] Versioning of Spaces bucket %q of replication intent (%s) is not enabled, removing from state", bucket.Bucket, d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	document, err := json.Marshal(intent)
+	if err != nil {
+		return diag.Errorf("Error encoding Spaces bucket replication intent: %s", err)
+	}
+
+	d.Set("replication_json", string(document))
+
+	return nil
+}
+
+func resourceDigitalOceanBucketReplicationIntentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDigitalOceanBucketReplicationIntentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBucketReplicationIntentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Nothing is stored outside of the state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,92 @@
+package spaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanBucketReplicationIntent_basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceName := "digitalocean_spaces_bucket_replication_intent.intent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanSpacesBucketReplicationIntent(name, true, `["logs/"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefixes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_json",
+						fmt.Sprintf(`{"version":1,"source":{"bucket":"%[1]s-source","region":"nyc3","endpoint":"nyc3.digitaloceanspaces.com"},"destination":{"bucket":"%[1]s-destination","region":"ams3","endpoint":"ams3.digitaloceanspaces.com"},"filter":{"prefixes":["logs/"]}}`, name)),
+				),
+			},
+			{
+				Config: testAccDigitalOceanSpacesBucketReplicationIntent(name, true, `["uploads/", "logs/"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefixes.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "replication_json", regexp.MustCompile(`"filter":\{"prefixes":\["logs/","uploads/"\]\}`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prefixes", "replication_json"},
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanBucketReplicationIntent_unversioned(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDigitalOceanSpacesBucketReplicationIntent(name, false, `[]`),
+				ExpectError: regexp.MustCompile(`versioning must be enabled on Spaces bucket`),
+			},
+		},
+	})
+}
+
+func testAccDigitalOceanSpacesBucketReplicationIntent(name string, versioning bool, prefixes string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_spaces_bucket" "source" {
+  name          = "%[1]s-source"
+  region        = "nyc3"
+  force_destroy = true
+
+  versioning {
+    enabled = %[2]t
+  }
+}
+
+resource "digitalocean_spaces_bucket" "destination" {
+  name          = "%[1]s-destination"
+  region        = "ams3"
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "digitalocean_spaces_bucket_replication_intent" "intent" {
+  source_bucket      = digitalocean_spaces_bucket.source.name
+  source_region      = digitalocean_spaces_bucket.source.region
+  destination_bucket = digitalocean_spaces_bucket.destination.name
+  destination_region = digitalocean_spaces_bucket.destination.region
+  prefixes           = %[3]s
+}
+`, name, versioning, prefixes)
+}
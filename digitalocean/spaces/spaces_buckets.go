@@ -1,11 +1,10 @@
 package spaces
 
 import (
-	"fmt"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/urn"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -95,7 +94,7 @@ func flattenSpacesBucket(rawBucketMetadata, meta interface{}, extra map[string]i
 	flattenedBucket["name"] = name
 	flattenedBucket["region"] = region
 	flattenedBucket["bucket_domain_name"] = BucketDomainName(name, region)
-	flattenedBucket["urn"] = fmt.Sprintf("do:space:%s", name)
+	flattenedBucket["urn"] = urn.Format(urn.Space, name)
 	flattenedBucket["endpoint"] = BucketEndpoint(region)
 
 	return flattenedBucket, nil
@@ -0,0 +1,153 @@
+package spaces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// spacesKey is a Spaces access key. The secret key is only returned when a
+// key is created, so it is never read.
+type spacesKey struct {
+	Name      string            `json:"name"`
+	AccessKey string            `json:"access_key"`
+	Grants    []*spacesKeyGrant `json:"grants"`
+	CreatedAt string            `json:"created_at"`
+}
+
+// spacesKeyGrant is the permission of a Spaces access key on a bucket. A
+// fullaccess grant applies to all of the buckets, so it has no bucket.
+type spacesKeyGrant struct {
+	Bucket     string `json:"bucket"`
+	Permission string `json:"permission"`
+}
+
+type spacesKeysRoot struct {
+	Keys  []spacesKey `json:"keys"`
+	Links *godo.Links `json:"links"`
+	Meta  *godo.Meta  `json:"meta"`
+}
+
+// listSpacesKeys lists a page of the Spaces access keys. godo has no Spaces
+// keys service, so the request is built with the client's NewRequest.
+func listSpacesKeys(ctx context.Context, client *godo.Client, opts *godo.ListOptions) ([]spacesKey, *godo.Response, error) {
+	path := fmt.Sprintf("/v2/spaces/keys?page=%d&per_page=%d", opts.Page, opts.PerPage)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(spacesKeysRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if root.Links != nil {
+		resp.Links = root.Links
+	}
+	if root.Meta != nil {
+		resp.Meta = root.Meta
+	}
+
+	return root.Keys, resp, nil
+}
+
+func spacesKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the key",
+		},
+		"access_key": {
+			Type:        schema.TypeString,
+			Description: "The access key ID of the key",
+		},
+		"grants": {
+			Type:        schema.TypeList,
+			Description: "The permissions of the key on buckets",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bucket": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the bucket, or an empty string for a fullaccess grant",
+					},
+					"permission": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The permission on the bucket, one of read, readwrite or fullaccess",
+					},
+				},
+			},
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The time the key was created",
+		},
+	}
+}
+
+func getDigitalOceanSpacesKeys(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var keyList []interface{}
+
+	for {
+		keys, resp, err := listSpacesKeys(context.Background(), client, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Spaces keys: %s", err)
+		}
+
+		for _, key := range keys {
+			keyList = append(keyList, key)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Spaces keys: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return keyList, nil
+}
+
+func flattenDigitalOceanSpacesKey(rawKey, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	key := rawKey.(spacesKey)
+
+	grants := make([]interface{}, 0, len(key.Grants))
+	for _, grant := range key.Grants {
+		if grant == nil {
+			continue
+		}
+
+		grants = append(grants, map[string]interface{}{
+			"bucket":     grant.Bucket,
+			"permission": grant.Permission,
+		})
+	}
+
+	flattenedKey := map[string]interface{}{
+		"name":       key.Name,
+		"access_key": key.AccessKey,
+		"grants":     grants,
+		"created_at": key.CreatedAt,
+	}
+
+	return flattenedKey, nil
+}
@@ -0,0 +1,120 @@
+package spaces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestDataSourceDigitalOceanSpacesKeysRead(t *testing.T) {
+	// The keys are listed over two pages.
+	pages := map[string]string{
+		"1": `{"keys":[
+			{"name":"deploy","access_key":"DO001","grants":[{"bucket":"assets","permission":"readwrite"},{"bucket":"logs","permission":"read"}],"created_at":"2024-06-01T10:00:00Z"},
+			{"name":"admin","access_key":"DO002","grants":[{"bucket":"","permission":"fullaccess"}],"created_at":"2024-06-02T10:00:00Z"}],
+			"links":{"pages":{"next":"%[1]s/v2/spaces/keys?page=2&per_page=200","last":"%[1]s/v2/spaces/keys?page=2&per_page=200"}},"meta":{"total":3}}`,
+		"2": `{"keys":[
+			{"name":"backup","access_key":"DO003","grants":[{"bucket":"backups","permission":"readwrite"}],"created_at":"2024-06-03T10:00:00Z"}],
+			"links":{"pages":{"first":"%[1]s/v2/spaces/keys?page=1&per_page=200","prev":"%[1]s/v2/spaces/keys?page=1&per_page=200"}},"meta":{"total":3}}`,
+	}
+
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Query().Get("page")]
+		if r.URL.Path != "/v2/spaces/keys" || !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, page, "http://"+r.Host)
+	}))
+
+	cases := []struct {
+		name     string
+		filter   []interface{}
+		expected []string
+	}{
+		{
+			name:     "All",
+			expected: []string{"deploy", "admin", "backup"},
+		},
+		{
+			name: "FullAccess",
+			filter: []interface{}{
+				map[string]interface{}{"key": "grants.permission", "values": []interface{}{"fullaccess"}},
+			},
+			expected: []string{"admin"},
+		},
+		{
+			name: "Bucket",
+			filter: []interface{}{
+				map[string]interface{}{"key": "grants.bucket", "values": []interface{}{"logs", "backups"}},
+			},
+			expected: []string{"deploy", "backup"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tc.filter != nil {
+				raw["filter"] = tc.filter
+			}
+
+			r := DataSourceDigitalOceanSpacesKeys()
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			var names []string
+			for _, k := range d.Get("keys").([]interface{}) {
+				names = append(names, k.(map[string]interface{})["name"].(string))
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected keys %v, got %v", tc.expected, names)
+			}
+		})
+	}
+
+	r := DataSourceDigitalOceanSpacesKeys()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"name":       "deploy",
+		"access_key": "DO001",
+		"grants": []interface{}{
+			map[string]interface{}{"bucket": "assets", "permission": "readwrite"},
+			map[string]interface{}{"bucket": "logs", "permission": "read"},
+		},
+		"created_at": "2024-06-01T10:00:00Z",
+	}
+	if actual := d.Get("keys.0").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected the grants of the key to be flattened to %#v, got %#v", expected, actual)
+	}
+}
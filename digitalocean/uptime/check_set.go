@@ -0,0 +1,465 @@
+package uptime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxUptimeCheckSetConcurrency bounds the number of checks in a set that are
+// changed or read at the same time.
+const maxUptimeCheckSetConcurrency = 5
+
+// uptimeCheckSetEntry is the configuration of a check in an uptime check set
+// along with its alerts, keyed by name.
+type uptimeCheckSetEntry struct {
+	check  godo.UpdateUptimeCheckRequest
+	alerts map[string]godo.CreateUptimeAlertRequest
+}
+
+// uptimeCheckSetEntryIDs are the IDs of a check in an uptime check set and of
+// its alerts, keyed by alert name. An empty check ID means the check does not
+// exist.
+type uptimeCheckSetEntryIDs struct {
+	checkID  string
+	alertIDs map[string]string
+}
+
+func expandUptimeCheckSetEntries(config []interface{}) map[string]*uptimeCheckSetEntry {
+	entries := make(map[string]*uptimeCheckSetEntry, len(config))
+	for _, raw := range config {
+		c := raw.(map[string]interface{})
+		name := c["name"].(string)
+
+		regions := expandRegions(c["regions"].(*schema.Set).List())
+		sort.Strings(regions)
+
+		entry := &uptimeCheckSetEntry{
+			check: godo.UpdateUptimeCheckRequest{
+				Name:    name,
+				Type:    c["type"].(string),
+				Target:  c["target"].(string),
+				Regions: regions,
+				Enabled: c["enabled"].(bool),
+			},
+			alerts: make(map[string]godo.CreateUptimeAlertRequest),
+		}
+
+		for _, rawAlert := range c["alert"].([]interface{}) {
+			if rawAlert == nil {
+				continue
+			}
+
+			a := rawAlert.(map[string]interface{})
+			entry.alerts[a["name"].(string)] = godo.CreateUptimeAlertRequest{
+				Name:          a["name"].(string),
+				Type:          a["type"].(string),
+				Threshold:     a["threshold"].(int),
+				Comparison:    godo.UptimeAlertComp(a["comparison"].(string)),
+				Period:        a["period"].(string),
+				Notifications: expandNotifications(a["notifications"].([]interface{})),
+			}
+		}
+
+		entries[name] = entry
+	}
+
+	return entries
+}
+
+// sortedUptimeCheckSetNames returns the names of the checks in any of the
+// given sets of entries in sorted order.
+func sortedUptimeCheckSetNames(entries ...map[string]*uptimeCheckSetEntry) []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, e := range entries {
+		for name := range e {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// applyUptimeCheckSetChanges calls f for each of the checks with bounded
+// concurrency. It returns the IDs reported for every check, including those
+// that failed part way, so that nothing that was created is lost, along with
+// the errors keyed by check name.
+func applyUptimeCheckSetChanges(names []string, f func(name string) (uptimeCheckSetEntryIDs, error)) (map[string]uptimeCheckSetEntryIDs, map[string]error) {
+	results := make(map[string]uptimeCheckSetEntryIDs, len(names))
+	errs := make(map[string]error)
+
+	var mu sync.Mutex
+	sem := make(chan struct{}, maxUptimeCheckSetConcurrency)
+	var wg sync.WaitGroup
+
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ids, err := f(name)
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[name] = ids
+			if err != nil {
+				errs[name] = err
+			}
+		}(name)
+	}
+
+	wg.Wait()
+
+	return results, errs
+}
+
+func createUptimeCheckSetEntry(ctx context.Context, client *godo.Client, name string, entry *uptimeCheckSetEntry) (uptimeCheckSetEntryIDs, error) {
+	opts := &godo.CreateUptimeCheckRequest{
+		Name:    name,
+		Type:    entry.check.Type,
+		Target:  entry.check.Target,
+		Regions: entry.check.Regions,
+		Enabled: entry.check.Enabled,
+	}
+
+	check, _, err := client.UptimeChecks.Create(ctx, opts)
+	if err != nil {
+		return uptimeCheckSetEntryIDs{}, fmt.Errorf("Error creating check: %s", err)
+	}
+
+	// The check has just been created, so only its alerts need to be.
+	ids := uptimeCheckSetEntryIDs{checkID: check.ID}
+	created := &uptimeCheckSetEntry{check: entry.check}
+
+	return updateUptimeCheckSetEntry(ctx, client, name, ids, created, entry)
+}
+
+// updateUptimeCheckSetEntry makes the changes needed to go from the old to the
+// new configuration of a check, only updating the check and the alerts that
+// have changed.
+func updateUptimeCheckSetEntry(ctx context.Context, client *godo.Client, name string, ids uptimeCheckSetEntryIDs, old, new *uptimeCheckSetEntry) (uptimeCheckSetEntryIDs, error) {
+	result := uptimeCheckSetEntryIDs{
+		checkID:  ids.checkID,
+		alertIDs: make(map[string]string, len(ids.alertIDs)),
+	}
+	for alertName, id := range ids.alertIDs {
+		result.alertIDs[alertName] = id
+	}
+
+	var oldAlerts map[string]godo.CreateUptimeAlertRequest
+	if old != nil {
+		oldAlerts = old.alerts
+	}
+
+	if old == nil || !reflect.DeepEqual(old.check, new.check) {
+		check := new.check
+		check.Name = name
+		if _, _, err := client.UptimeChecks.Update(ctx, ids.checkID, &check); err != nil {
+			return result, fmt.Errorf("Error updating check: %s", err)
+		}
+	}
+
+	alertNames := make([]string, 0, len(oldAlerts)+len(new.alerts))
+	for alertName := range result.alertIDs {
+		alertNames = append(alertNames, alertName)
+	}
+	for alertName := range new.alerts {
+		if _, ok := result.alertIDs[alertName]; !ok {
+			alertNames = append(alertNames, alertName)
+		}
+	}
+	sort.Strings(alertNames)
+
+	for _, alertName := range alertNames {
+		oldAlert, hadAlert := oldAlerts[alertName]
+		newAlert, hasAlert := new.alerts[alertName]
+		alertID, tracked := result.alertIDs[alertName]
+
+		switch {
+		case !hasAlert:
+			resp, err := client.UptimeChecks.DeleteAlert(ctx, ids.checkID, alertID)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return result, fmt.Errorf("Error deleting alert %q: %s", alertName, err)
+			}
+			delete(result.alertIDs, alertName)
+		case !tracked:
+			alert, _, err := client.UptimeChecks.CreateAlert(ctx, ids.checkID, &newAlert)
+			if err != nil {
+				return result, fmt.Errorf("Error creating alert %q: %s", alertName, err)
+			}
+			result.alertIDs[alertName] = alert.ID
+		case !hadAlert || !reflect.DeepEqual(oldAlert, newAlert):
+			opts := godo.UpdateUptimeAlertRequest(newAlert)
+			if _, _, err := client.UptimeChecks.UpdateAlert(ctx, ids.checkID, alertID, &opts); err != nil {
+				return result, fmt.Errorf("Error updating alert %q: %s", alertName, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// uptimeCheckSetCreatedAny reports whether any of the checks of a set were
+// created.
+func uptimeCheckSetCreatedAny(results map[string]uptimeCheckSetEntryIDs) bool {
+	for _, entryIDs := range results {
+		if entryIDs.checkID != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deleteUptimeCheckSetEntry(ctx context.Context, client *godo.Client, ids uptimeCheckSetEntryIDs) (uptimeCheckSetEntryIDs, error) {
+	if ids.checkID == "" {
+		return uptimeCheckSetEntryIDs{}, nil
+	}
+
+	// Deleting the check also deletes its alerts.
+	resp, err := client.UptimeChecks.Delete(ctx, ids.checkID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return ids, fmt.Errorf("Error deleting check: %s", err)
+	}
+
+	return uptimeCheckSetEntryIDs{}, nil
+}
+
+func getUptimeCheckSetIDs(d *schema.ResourceData) map[string]uptimeCheckSetEntryIDs {
+	ids := make(map[string]uptimeCheckSetEntryIDs)
+	for name, id := range d.Get("check_ids").(map[string]interface{}) {
+		ids[name] = uptimeCheckSetEntryIDs{
+			checkID:  id.(string),
+			alertIDs: make(map[string]string),
+		}
+	}
+
+	for key, id := range d.Get("alert_ids").(map[string]interface{}) {
+		// Check names can not contain a slash, but alert names can.
+		parts := strings.SplitN(key, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		if entryIDs, ok := ids[parts[0]]; ok {
+			entryIDs.alertIDs[parts[1]] = id.(string)
+		}
+	}
+
+	return ids
+}
+
+func setUptimeCheckSetIDs(d *schema.ResourceData, ids map[string]uptimeCheckSetEntryIDs) error {
+	checkIDs := make(map[string]interface{}, len(ids))
+	alertIDs := make(map[string]interface{})
+	for name, entryIDs := range ids {
+		if entryIDs.checkID == "" {
+			continue
+		}
+
+		checkIDs[name] = entryIDs.checkID
+		for alertName, id := range entryIDs.alertIDs {
+			alertIDs[name+"/"+alertName] = id
+		}
+	}
+
+	if err := d.Set("check_ids", checkIDs); err != nil {
+		return fmt.Errorf("Error setting check_ids: %s", err)
+	}
+
+	if err := d.Set("alert_ids", alertIDs); err != nil {
+		return fmt.Errorf("Error setting alert_ids: %s", err)
+	}
+
+	return nil
+}
+
+// listUptimeChecks returns all of the uptime checks on the account keyed by
+// ID, so that a whole set can be read with as few requests as possible.
+func listUptimeChecks(ctx context.Context, client *godo.Client) (map[string]godo.UptimeCheck, error) {
+	checks := make(map[string]godo.UptimeCheck)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialChecks, resp, err := client.UptimeChecks.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range partialChecks {
+			checks[c.ID] = c
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	return checks, nil
+}
+
+func listUptimeAlerts(ctx context.Context, client *godo.Client, checkID string) ([]godo.UptimeAlert, error) {
+	alerts := make([]godo.UptimeAlert, 0)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialAlerts, resp, err := client.UptimeChecks.ListAlerts(ctx, checkID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving alerts: %s", err)
+		}
+
+		alerts = append(alerts, partialAlerts...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving alerts: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return alerts, nil
+}
+
+// uptimeCheckSetAlertOrder returns the names of the alerts of each check in
+// the order they are configured, so that they are read back in the same order.
+func uptimeCheckSetAlertOrder(config []interface{}) map[string][]string {
+	order := make(map[string][]string, len(config))
+	for _, raw := range config {
+		c := raw.(map[string]interface{})
+		name := c["name"].(string)
+
+		for _, rawAlert := range c["alert"].([]interface{}) {
+			if rawAlert == nil {
+				continue
+			}
+			order[name] = append(order[name], rawAlert.(map[string]interface{})["name"].(string))
+		}
+	}
+
+	return order
+}
+
+// flattenUptimeCheckSetEntry flattens a check and the alerts that belong to
+// the set. Alerts that no longer exist are dropped from the returned IDs.
+// Alerts added to the check outside of Terraform are ignored.
+func flattenUptimeCheckSetEntry(name string, check godo.UptimeCheck, alerts []godo.UptimeAlert, ids uptimeCheckSetEntryIDs, alertOrder []string) (map[string]interface{}, uptimeCheckSetEntryIDs) {
+	alertsByID := make(map[string]godo.UptimeAlert, len(alerts))
+	for _, a := range alerts {
+		alertsByID[a.ID] = a
+	}
+
+	result := uptimeCheckSetEntryIDs{
+		checkID:  ids.checkID,
+		alertIDs: make(map[string]string, len(ids.alertIDs)),
+	}
+
+	alertNames := make([]string, 0, len(ids.alertIDs))
+	seen := make(map[string]bool, len(ids.alertIDs))
+	for _, alertName := range alertOrder {
+		if _, ok := ids.alertIDs[alertName]; ok && !seen[alertName] {
+			seen[alertName] = true
+			alertNames = append(alertNames, alertName)
+		}
+	}
+
+	remaining := make([]string, 0)
+	for alertName := range ids.alertIDs {
+		if !seen[alertName] {
+			remaining = append(remaining, alertName)
+		}
+	}
+	sort.Strings(remaining)
+	alertNames = append(alertNames, remaining...)
+
+	flattenedAlerts := make([]interface{}, 0, len(alertNames))
+	for _, alertName := range alertNames {
+		alert, ok := alertsByID[ids.alertIDs[alertName]]
+		if !ok {
+			continue
+		}
+
+		result.alertIDs[alertName] = alert.ID
+
+		notifications := []interface{}{}
+		if alert.Notifications != nil {
+			notifications = flattenNotifications(alert.Notifications)
+		}
+
+		flattenedAlerts = append(flattenedAlerts, map[string]interface{}{
+			"name":          alertName,
+			"type":          alert.Type,
+			"threshold":     alert.Threshold,
+			"comparison":    string(alert.Comparison),
+			"period":        alert.Period,
+			"notifications": notifications,
+		})
+	}
+
+	regions := make([]interface{}, 0, len(check.Regions))
+	for _, r := range check.Regions {
+		regions = append(regions, r)
+	}
+
+	entry := map[string]interface{}{
+		"name":    name,
+		"target":  check.Target,
+		"type":    check.Type,
+		"regions": regions,
+		"enabled": check.Enabled,
+		"alert":   flattenedAlerts,
+	}
+
+	return entry, result
+}
+
+// uptimeCheckSetDiagnostics reports an error for each check that failed, in
+// order of check name.
+func uptimeCheckSetDiagnostics(action string, errs map[string]error) diag.Diagnostics {
+	names := make([]string, 0, len(errs))
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, name := range names {
+		diags = append(diags, diag.Errorf("Error %s uptime check %q: %s", action, name, errs[name])...)
+	}
+
+	return diags
+}
@@ -0,0 +1,177 @@
+package uptime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestApplyUptimeCheckSetChanges(t *testing.T) {
+	names := make([]string, 0, 20)
+	for i := 0; i < 20; i++ {
+		names = append(names, fmt.Sprintf("check-%02d", i))
+	}
+
+	var running, maxRunning int32
+	results, errs := applyUptimeCheckSetChanges(names, func(name string) (uptimeCheckSetEntryIDs, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		ids := uptimeCheckSetEntryIDs{checkID: "id-" + name}
+		if name == "check-03" || name == "check-17" {
+			return ids, fmt.Errorf("creating alert failed")
+		}
+		return ids, nil
+	})
+
+	if maxRunning > maxUptimeCheckSetConcurrency {
+		t.Errorf("expected at most %d concurrent changes, got %d", maxUptimeCheckSetConcurrency, maxRunning)
+	}
+
+	if len(results) != len(names) {
+		t.Fatalf("expected %d results, got %d", len(names), len(results))
+	}
+
+	// Checks that failed part way are still tracked.
+	if results["check-03"].checkID != "id-check-03" {
+		t.Errorf("expected the failed check to be tracked, got: %#v", results["check-03"])
+	}
+
+	failed := make([]string, 0, len(errs))
+	for name := range errs {
+		failed = append(failed, name)
+	}
+	sort.Strings(failed)
+	if !reflect.DeepEqual(failed, []string{"check-03", "check-17"}) {
+		t.Errorf("unexpected failed checks: %v", failed)
+	}
+
+	diags := uptimeCheckSetDiagnostics("creating", errs)
+	if len(diags) != 2 || diags[0].Summary != `Error creating uptime check "check-03": creating alert failed` {
+		t.Errorf("unexpected diagnostics: %#v", diags)
+	}
+}
+
+func TestUptimeCheckSetIDs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanUptimeCheckSet().Schema, map[string]interface{}{})
+
+	ids := map[string]uptimeCheckSetEntryIDs{
+		"api":  {checkID: "c1", alertIDs: map[string]string{"latency": "a1", "down/global": "a2"}},
+		"web":  {checkID: "c2", alertIDs: map[string]string{}},
+		"gone": {checkID: "", alertIDs: map[string]string{"latency": "a3"}},
+	}
+
+	if err := setUptimeCheckSetIDs(d, ids); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedAlertIDs := map[string]interface{}{"api/latency": "a1", "api/down/global": "a2"}
+	if got := d.Get("alert_ids").(map[string]interface{}); !reflect.DeepEqual(got, expectedAlertIDs) {
+		t.Errorf("expected alert_ids %v, got %v", expectedAlertIDs, got)
+	}
+
+	delete(ids, "gone")
+	if got := getUptimeCheckSetIDs(d); !reflect.DeepEqual(got, ids) {
+		t.Errorf("expected %#v, got %#v", ids, got)
+	}
+}
+
+func TestUpdateUptimeCheckSetEntry(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"alert":{"id":"a-new"}}`)
+		default:
+			fmt.Fprint(w, `{"alert":{"id":"a1"}}`)
+		}
+	})).GodoClient()
+
+	email := &godo.Notifications{Email: []string{"ops@example.com"}}
+	check := godo.UpdateUptimeCheckRequest{Name: "api", Type: "https", Target: "https://example.com", Enabled: true}
+	old := &uptimeCheckSetEntry{
+		check: check,
+		alerts: map[string]godo.CreateUptimeAlertRequest{
+			"latency": {Name: "latency", Type: "latency", Threshold: 300, Comparison: "greater_than", Period: "2m", Notifications: email},
+			"down":    {Name: "down", Type: "down", Period: "2m", Notifications: email},
+			"ssl":     {Name: "ssl", Type: "ssl_expiry", Threshold: 30, Notifications: email},
+		},
+	}
+	new := &uptimeCheckSetEntry{
+		check: check,
+		alerts: map[string]godo.CreateUptimeAlertRequest{
+			"latency": {Name: "latency", Type: "latency", Threshold: 500, Comparison: "greater_than", Period: "2m", Notifications: email},
+			"ssl":     {Name: "ssl", Type: "ssl_expiry", Threshold: 30, Notifications: email},
+			"global":  {Name: "global", Type: "down_global", Period: "2m", Notifications: email},
+		},
+	}
+	ids := uptimeCheckSetEntryIDs{
+		checkID:  "c1",
+		alertIDs: map[string]string{"latency": "a1", "down": "a2", "ssl": "a3"},
+	}
+
+	result, err := updateUptimeCheckSetEntry(context.Background(), client, "api", ids, old, new)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Only the changed alerts are touched, and the check itself is unchanged.
+	expectedRequests := []string{
+		"DELETE /v2/uptime/checks/c1/alerts/a2",
+		"POST /v2/uptime/checks/c1/alerts",
+		"PUT /v2/uptime/checks/c1/alerts/a1",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("expected requests %v, got %v", expectedRequests, requests)
+	}
+
+	expectedIDs := uptimeCheckSetEntryIDs{
+		checkID:  "c1",
+		alertIDs: map[string]string{"latency": "a1", "ssl": "a3", "global": "a-new"},
+	}
+	if !reflect.DeepEqual(result, expectedIDs) {
+		t.Errorf("expected %#v, got %#v", expectedIDs, result)
+	}
+}
@@ -16,6 +16,17 @@ import (
 )
 
 func ResourceDigitalOceanUptimeAlert() *schema.Resource {
+	alertSchema := uptimeAlertSchema()
+	alertSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	alertSchema["check_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "A unique identifier for a check.",
+		Required:    true,
+	}
+
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanUptimeAlertCreate,
 		ReadContext:   resourceDigitalOceanUptimeAlertRead,
@@ -25,95 +36,93 @@ func ResourceDigitalOceanUptimeAlert() *schema.Resource {
 			StateContext: resourceDigitalOceanUptimeAlertImport,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Description: "A human-friendly display name for the alert.",
-				Required:    true,
-			},
-			"id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"check_id": {
-				Type:        schema.TypeString,
-				Description: "A unique identifier for a check.",
-				Required:    true,
-			},
-			"type": {
-				Type:        schema.TypeString,
-				Description: "The type of health check to perform. Enum: 'latency' 'down' 'down_global' 'ssl_expiry'",
-				ValidateFunc: validation.StringInSlice([]string{
-					"latency",
-					"down",
-					"down_global",
-					"ssl_expiry",
-				}, false),
-				Required: true,
-			},
-			"threshold": {
-				Type:        schema.TypeInt,
-				Description: "The threshold at which the alert will enter a trigger state. The specific threshold is dependent on the alert type.",
-				Optional:    true,
-			},
-			"comparison": {
-				Type:        schema.TypeString,
-				Description: "The comparison operator used against the alert's threshold. Enum: 'greater_than' 'less_than",
-				ValidateFunc: validation.StringInSlice([]string{
-					"greater_than",
-					"less_than",
-				}, false),
-				Optional: true,
-			},
-			"period": {
-				Type:        schema.TypeString,
-				Description: "Period of time the threshold must be exceeded to trigger the alert. Enum '2m' '3m' '5m' '10m' '15m' '30m' '1h'",
-				ValidateFunc: validation.StringInSlice([]string{
-					"2m",
-					"3m",
-					"5m",
-					"10m",
-					"15m",
-					"30m",
-					"1h",
-				}, false),
-				Optional: true,
-			},
-			"notifications": {
-				Type:        schema.TypeList,
-				Required:    true,
-				Description: "The notification settings for a trigger alert.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"slack": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"channel": {
-										Type:             schema.TypeString,
-										Required:         true,
-										DiffSuppressFunc: util.CaseSensitive,
-										Description:      "The Slack channel to send alerts to",
-										ValidateFunc:     validation.StringIsNotEmpty,
-									},
-									"url": {
-										Type:             schema.TypeString,
-										Required:         true,
-										DiffSuppressFunc: util.CaseSensitive,
-										Description:      "The webhook URL for Slack",
-										ValidateFunc:     validation.StringIsNotEmpty,
-									},
+		Schema: alertSchema,
+	}
+}
+
+// uptimeAlertSchema returns the schema for the settings of an uptime alert. It
+// is shared by the alert resource and the alerts of an uptime check set.
+func uptimeAlertSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "A human-friendly display name for the alert.",
+			Required:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "The type of health check to perform. Enum: 'latency' 'down' 'down_global' 'ssl_expiry'",
+			ValidateFunc: validation.StringInSlice([]string{
+				"latency",
+				"down",
+				"down_global",
+				"ssl_expiry",
+			}, false),
+			Required: true,
+		},
+		"threshold": {
+			Type:        schema.TypeInt,
+			Description: "The threshold at which the alert will enter a trigger state. The specific threshold is dependent on the alert type.",
+			Optional:    true,
+		},
+		"comparison": {
+			Type:        schema.TypeString,
+			Description: "The comparison operator used against the alert's threshold. Enum: 'greater_than' 'less_than",
+			ValidateFunc: validation.StringInSlice([]string{
+				"greater_than",
+				"less_than",
+			}, false),
+			Optional: true,
+		},
+		"period": {
+			Type:        schema.TypeString,
+			Description: "Period of time the threshold must be exceeded to trigger the alert. Enum '2m' '3m' '5m' '10m' '15m' '30m' '1h'",
+			ValidateFunc: validation.StringInSlice([]string{
+				"2m",
+				"3m",
+				"5m",
+				"10m",
+				"15m",
+				"30m",
+				"1h",
+			}, false),
+			Optional: true,
+		},
+		"notifications": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "The notification settings for a trigger alert.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"slack": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"channel": {
+									Type:             schema.TypeString,
+									Required:         true,
+									DiffSuppressFunc: util.CaseSensitive,
+									Description:      "The Slack channel to send alerts to",
+									ValidateFunc:     validation.StringIsNotEmpty,
+								},
+								"url": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Sensitive:        true,
+									DiffSuppressFunc: util.CaseSensitive,
+									Description:      "The webhook URL for Slack",
+									ValidateFunc:     validation.StringIsNotEmpty,
 								},
 							},
 						},
-						"email": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "List of email addresses to sent notifications to",
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+					},
+					"email": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "List of email addresses to sent notifications to",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
 						},
 					},
 				},
@@ -0,0 +1,275 @@
+package uptime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanUptimeCheckSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanUptimeCheckSetCreate,
+		ReadContext:   resourceDigitalOceanUptimeCheckSetRead,
+		UpdateContext: resourceDigitalOceanUptimeCheckSetUpdate,
+		DeleteContext: resourceDigitalOceanUptimeCheckSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"check": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The uptime checks in the set. Each check is identified by its name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "A human-friendly display name for the check. Must be unique within the set.",
+							ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.StringDoesNotContainAny("/")),
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The endpoint to perform healthchecks on.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "https",
+							Description: "The type of health check to perform. Enum: 'ping' 'http' 'https'",
+							ValidateFunc: validation.StringInSlice([]string{
+								"ping",
+								"http",
+								"https",
+							}, false),
+						},
+						"regions": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "An array containing the selected regions to perform healthchecks from.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "A boolean value indicating whether the check is enabled/disabled.",
+						},
+						"alert": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The alerts for the check. Each alert is identified by its name.",
+							Elem: &schema.Resource{
+								Schema: uptimeAlertSchema(),
+							},
+						},
+					},
+				},
+			},
+			"check_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The IDs of the checks in the set, keyed by check name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"alert_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The IDs of the alerts in the set, keyed by the check and alert names joined with a slash, e.g. 'api/latency'.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: validateUptimeCheckSetNames,
+	}
+}
+
+func resourceDigitalOceanUptimeCheckSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	entries := expandUptimeCheckSetEntries(d.Get("check").(*schema.Set).List())
+
+	results, errs := applyUptimeCheckSetChanges(sortedUptimeCheckSetNames(entries), func(name string) (uptimeCheckSetEntryIDs, error) {
+		return createUptimeCheckSetEntry(ctx, client, name, entries[name])
+	})
+
+	// Track whatever was created, even if some entries failed, so that it is
+	// not orphaned. A check may have been created even though creating its
+	// alerts failed.
+	if len(entries) > 0 && !uptimeCheckSetCreatedAny(results) {
+		return uptimeCheckSetDiagnostics("creating", errs)
+	}
+
+	d.SetId(resource.UniqueId())
+	if err := setUptimeCheckSetIDs(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := resourceDigitalOceanUptimeCheckSetRead(ctx, d, meta)
+	return append(diags, uptimeCheckSetDiagnostics("creating", errs)...)
+}
+
+func resourceDigitalOceanUptimeCheckSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ids := getUptimeCheckSetIDs(d)
+
+	checks, err := listUptimeChecks(ctx, client)
+	if err != nil {
+		return diag.Errorf("Error retrieving uptime checks: %s", err)
+	}
+
+	// Drop any checks that have been deleted outside of Terraform, so that
+	// they are recreated.
+	for name, entryIDs := range ids {
+		if _, ok := checks[entryIDs.checkID]; !ok {
+			delete(ids, name)
+		}
+	}
+
+	names := make([]string, 0, len(ids))
+	for name := range ids {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var mu sync.Mutex
+	alerts := make(map[string][]godo.UptimeAlert, len(ids))
+	_, errs := applyUptimeCheckSetChanges(names, func(name string) (uptimeCheckSetEntryIDs, error) {
+		checkAlerts, err := listUptimeAlerts(ctx, client, ids[name].checkID)
+		if err != nil {
+			return ids[name], err
+		}
+
+		mu.Lock()
+		alerts[name] = checkAlerts
+		mu.Unlock()
+
+		return ids[name], nil
+	})
+	if len(errs) > 0 {
+		return uptimeCheckSetDiagnostics("reading", errs)
+	}
+
+	alertOrder := uptimeCheckSetAlertOrder(d.Get("check").(*schema.Set).List())
+
+	entries := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		entry, entryIDs := flattenUptimeCheckSetEntry(name, checks[ids[name].checkID], alerts[name], ids[name], alertOrder[name])
+		entries = append(entries, entry)
+		ids[name] = entryIDs
+	}
+
+	if err := d.Set("check", entries); err != nil {
+		return diag.Errorf("Error setting check: %s", err)
+	}
+
+	if err := setUptimeCheckSetIDs(d, ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanUptimeCheckSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ids := getUptimeCheckSetIDs(d)
+	o, n := d.GetChange("check")
+	oldEntries := expandUptimeCheckSetEntries(o.(*schema.Set).List())
+	newEntries := expandUptimeCheckSetEntries(n.(*schema.Set).List())
+
+	names := sortedUptimeCheckSetNames(oldEntries, newEntries)
+	results, errs := applyUptimeCheckSetChanges(names, func(name string) (uptimeCheckSetEntryIDs, error) {
+		oldEntry := oldEntries[name]
+		newEntry, ok := newEntries[name]
+		entryIDs := ids[name]
+
+		switch {
+		case !ok:
+			return deleteUptimeCheckSetEntry(ctx, client, entryIDs)
+		case entryIDs.checkID == "":
+			return createUptimeCheckSetEntry(ctx, client, name, newEntry)
+		default:
+			return updateUptimeCheckSetEntry(ctx, client, name, entryIDs, oldEntry, newEntry)
+		}
+	})
+
+	if err := setUptimeCheckSetIDs(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := resourceDigitalOceanUptimeCheckSetRead(ctx, d, meta)
+	return append(diags, uptimeCheckSetDiagnostics("updating", errs)...)
+}
+
+func resourceDigitalOceanUptimeCheckSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ids := getUptimeCheckSetIDs(d)
+
+	names := make([]string, 0, len(ids))
+	for name := range ids {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results, errs := applyUptimeCheckSetChanges(names, func(name string) (uptimeCheckSetEntryIDs, error) {
+		return deleteUptimeCheckSetEntry(ctx, client, ids[name])
+	})
+
+	if len(errs) > 0 {
+		// Keep track of the checks that could not be deleted.
+		if err := setUptimeCheckSetIDs(d, results); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return uptimeCheckSetDiagnostics("deleting", errs)
+	}
+
+	return nil
+}
+
+// validateUptimeCheckSetNames ensures that check names are unique within the
+// set and alert names are unique within each check, as they are used to match
+// the configuration to the checks and alerts that have been created.
+func validateUptimeCheckSetNames(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	checkNames := make(map[string]bool)
+	for _, raw := range diff.Get("check").(*schema.Set).List() {
+		check := raw.(map[string]interface{})
+		name := check["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if checkNames[name] {
+			return fmt.Errorf("check names must be unique, found more than one check named %q", name)
+		}
+		checkNames[name] = true
+
+		alertNames := make(map[string]bool)
+		for _, rawAlert := range check["alert"].([]interface{}) {
+			if rawAlert == nil {
+				continue
+			}
+
+			alertName := rawAlert.(map[string]interface{})["name"].(string)
+			if alertNames[alertName] {
+				return fmt.Errorf("alert names must be unique within a check, found more than one alert named %q for check %q", alertName, name)
+			}
+			alertNames[alertName] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package uptime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDigitalOceanUptimeCheckSetCreate_AlertFailed(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/uptime/checks":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"check":{"id":"c1","name":"api"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/uptime/checks/c1/alerts":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"id":"unprocessable_entity","message":"invalid threshold"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/uptime/checks":
+			fmt.Fprint(w, `{"checks":[{"id":"c1","name":"api","type":"https","target":"https://example.com","enabled":true}],"links":{}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/uptime/checks/c1/alerts":
+			fmt.Fprint(w, `{"alerts":[],"links":{}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	r := ResourceDigitalOceanUptimeCheckSet()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"check": []interface{}{
+			map[string]interface{}{
+				"name":   "api",
+				"target": "https://example.com",
+				"alert": []interface{}{
+					map[string]interface{}{
+						"name": "down",
+						"type": "down",
+						"notifications": []interface{}{
+							map[string]interface{}{"email": []interface{}{"ops@example.com"}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	diags := r.CreateContext(context.Background(), d, meta)
+	if !diags.HasError() {
+		t.Fatalf("expected the alert creation to fail")
+	}
+
+	// Every entry failed, but the check was created before its alert
+	// failed, so it must be tracked rather than orphaned.
+	if d.Id() == "" {
+		t.Fatalf("expected the set to be tracked")
+	}
+	if v := d.Get("check_ids.api"); v != "c1" {
+		t.Errorf("expected check_ids.api to be c1, got %v", v)
+	}
+}
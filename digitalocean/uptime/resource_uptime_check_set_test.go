@@ -0,0 +1,178 @@
+package uptime_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccCheckDigitalOceanUptimeCheckSetConfig_Basic = `
+resource "digitalocean_uptime_check_set" "foobar" {
+  check {
+    name    = "%[1]s-api"
+    target  = "https://www.landingpage.com/api"
+    regions = ["us_east"]
+
+    alert {
+      name       = "latency"
+      type       = "latency"
+      threshold  = 300
+      comparison = "greater_than"
+      period     = "2m"
+
+      notifications {
+        email = ["sammy@digitalocean.com"]
+      }
+    }
+  }
+
+  check {
+    name   = "%[1]s-web"
+    target = "https://www.landingpage.com"
+  }
+}
+`
+
+const testAccCheckDigitalOceanUptimeCheckSetConfig_Updated = `
+resource "digitalocean_uptime_check_set" "foobar" {
+  check {
+    name    = "%[1]s-api"
+    target  = "https://www.landingpage.com/api"
+    regions = ["us_east"]
+
+    alert {
+      name       = "latency"
+      type       = "latency"
+      threshold  = 500
+      comparison = "greater_than"
+      period     = "2m"
+
+      notifications {
+        email = ["sammy@digitalocean.com"]
+      }
+    }
+  }
+
+  check {
+    name    = "%[1]s-docs"
+    target  = "https://www.landingpage.com/docs"
+    type    = "http"
+    enabled = false
+  }
+}
+`
+
+func TestAccDigitalOceanUptimeCheckSet_Basic(t *testing.T) {
+	prefix := acceptance.RandomTestName()
+	var apiCheckID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeCheckSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeCheckSetConfig_Basic, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeCheckSetExists("digitalocean_uptime_check_set.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_uptime_check_set.foobar", "check.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_uptime_check_set.foobar", "check_ids.%", "2"),
+					resource.TestCheckResourceAttr("digitalocean_uptime_check_set.foobar", "alert_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_uptime_check_set.foobar", fmt.Sprintf("alert_ids.%s-api/latency", prefix)),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_uptime_check_set.foobar", "check.*", map[string]string{
+						"name":                            prefix + "-api",
+						"alert.0.threshold":               "300",
+						"alert.0.notifications.0.email.0": "sammy@digitalocean.com",
+					}),
+					func(s *terraform.State) error {
+						apiCheckID = s.RootModule().Resources["digitalocean_uptime_check_set.foobar"].Primary.Attributes["check_ids."+prefix+"-api"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeCheckSetConfig_Updated, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeCheckSetExists("digitalocean_uptime_check_set.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_uptime_check_set.foobar", "check.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_uptime_check_set.foobar", "check_ids.%", "2"),
+					resource.TestCheckNoResourceAttr("digitalocean_uptime_check_set.foobar", fmt.Sprintf("check_ids.%s-web", prefix)),
+					resource.TestCheckResourceAttrSet("digitalocean_uptime_check_set.foobar", fmt.Sprintf("check_ids.%s-docs", prefix)),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_uptime_check_set.foobar", "check.*", map[string]string{
+						"name":              prefix + "-api",
+						"alert.0.threshold": "500",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_uptime_check_set.foobar", "check.*", map[string]string{
+						"name":    prefix + "-docs",
+						"type":    "http",
+						"enabled": "false",
+					}),
+					// Changing an alert updates it in place rather than recreating the check.
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["digitalocean_uptime_check_set.foobar"].Primary.Attributes["check_ids."+prefix+"-api"]
+						if id != apiCheckID {
+							return fmt.Errorf("expected check %s to be kept, got %s", apiCheckID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanUptimeCheckSetDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_uptime_check_set" {
+			continue
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "check_ids.") || key == "check_ids.%" {
+				continue
+			}
+
+			_, _, err := client.UptimeChecks.Get(context.Background(), id)
+			if err == nil {
+				return fmt.Errorf("Uptime Check %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanUptimeCheckSetExists(resource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set for resource: %s", resource)
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "check_ids.") || key == "check_ids.%" {
+				continue
+			}
+
+			if _, _, err := client.UptimeChecks.Get(context.Background(), id); err != nil {
+				return fmt.Errorf("Error retrieving uptime check %s: %s", key, err)
+			}
+		}
+
+		return nil
+	}
+}
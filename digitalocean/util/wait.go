@@ -2,6 +2,9 @@ package util
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net/http"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -10,11 +13,17 @@ import (
 
 // WaitForAction waits for the action to finish using the resource.StateChangeConf.
 func WaitForAction(client *godo.Client, action *godo.Action) error {
+	return WaitForActionContext(context.Background(), client, action, 60*time.Minute)
+}
+
+// WaitForActionContext waits up to the timeout for the action to finish using
+// the resource.StateChangeConf. An action that errors is returned as an error.
+func WaitForActionContext(ctx context.Context, client *godo.Client, action *godo.Action, timeout time.Duration) error {
 	var (
 		pending   = "in-progress"
 		target    = "completed"
 		refreshfn = func() (result interface{}, state string, err error) {
-			a, _, err := client.Actions.Get(context.Background(), action.ID)
+			a, _, err := client.Actions.Get(ctx, action.ID)
 			if err != nil {
 				return nil, "", err
 			}
@@ -33,13 +42,127 @@ func WaitForAction(client *godo.Client, action *godo.Action) error {
 		Target:  []string{target},
 
 		Delay:      10 * time.Second,
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 3 * time.Second,
 
 		// This is a hack around DO API strangeness.
 		// https://github.com/hashicorp/terraform/issues/481
 		//
 		NotFoundChecks: 60,
-	}).WaitForState()
+	}).WaitForStateContext(ctx)
 	return err
 }
+
+// dropletActionPollInterval is the interval at which the actions of a Droplet
+// are checked while waiting for a pending action to finish.
+var dropletActionPollInterval = 5 * time.Second
+
+// FindPendingDropletAction returns the most recently started action of the
+// Droplet which is still in progress, or nil if there is none. Only the most
+// recent actions, which are listed first, are checked.
+func FindPendingDropletAction(ctx context.Context, client *godo.Client, id int) (*godo.Action, error) {
+	actions, _, err := client.Droplets.Actions(ctx, id, &godo.ListOptions{Page: 1, PerPage: 50})
+	if err != nil {
+		return nil, err
+	}
+
+	var pending *godo.Action
+	for i := range actions {
+		action := &actions[i]
+		if action.Status != "in-progress" {
+			continue
+		}
+		if pending == nil || (action.StartedAt != nil && pending.StartedAt != nil && action.StartedAt.After(pending.StartedAt.Time)) {
+			pending = action
+		}
+	}
+
+	return pending, nil
+}
+
+// WaitForNoPendingDropletAction waits up to the timeout for the actions of
+// the Droplet that are in progress to finish, as the API refuses to start an
+// action while another is pending. Errored actions are not returned as an
+// error, as they no longer block new actions. Nothing is waited for if the
+// token is not allowed to read the actions of the Droplet.
+func WaitForNoPendingDropletAction(ctx context.Context, client *godo.Client, id int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var pending *godo.Action
+	timeoutErr := func() error {
+		return fmt.Errorf("timeout after %s waiting for pending %s action (%d) of droplet (%d) to finish", timeout, pending.Type, pending.ID, id)
+	}
+
+	for {
+		action, err := FindPendingDropletAction(ctx, client, id)
+		if err != nil {
+			if pending != nil && ctx.Err() != nil {
+				return timeoutErr()
+			}
+			if IsDigitalOceanError(err, http.StatusForbidden, "") {
+				log.Printf("[WARN] Unable to read the actions of droplet (%d), not waiting for pending actions: %s", id, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving actions of droplet (%d): %s", id, err)
+		}
+		if action == nil {
+			return nil
+		}
+		pending = action
+
+		log.Printf("[DEBUG] Waiting for pending %s action (%d) of droplet (%d) to finish", action.Type, action.ID, id)
+
+		select {
+		case <-ctx.Done():
+			return timeoutErr()
+		case <-time.After(dropletActionPollInterval):
+		}
+	}
+}
+
+// statusPollInterval is the interval at which the status of a resource is
+// checked while waiting for it to reach a status.
+var statusPollInterval = 10 * time.Second
+
+// WaitForStatus waits up to the timeout for the status returned by refresh to
+// be the target status, and returns the last status read. Reaching one of the
+// failed statuses, from which the target status is not expected to be reached,
+// is returned as an error.
+func WaitForStatus(ctx context.Context, refresh func(context.Context) (string, error), target string, failed []string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var status string
+	timeoutErr := func() error {
+		return fmt.Errorf("timeout after %s waiting for status %q, last status was %q", timeout, target, status)
+	}
+
+	for {
+		current, err := refresh(ctx)
+		if err != nil {
+			if status != "" && ctx.Err() != nil {
+				return status, timeoutErr()
+			}
+			return status, err
+		}
+		status = current
+
+		if status == target {
+			return status, nil
+		}
+		for _, f := range failed {
+			if status == f {
+				return status, fmt.Errorf("status %q reached while waiting for status %q", status, target)
+			}
+		}
+
+		log.Printf("[DEBUG] Waiting for status %q, current status is %q", target, status)
+
+		select {
+		case <-ctx.Done():
+			return status, timeoutErr()
+		case <-time.After(statusPollInterval):
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// newDropletActionsTestClient returns a client for a fake API listing the
+// actions of Droplet 1. Each request is answered with the next of the
+// responses, and the last one once they are exhausted.
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func newDropletActionsTestClient(t *testing.T, responses ...string) (*godo.Client, *int) {
+	requests := 0
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/droplets/1/actions" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		i := requests
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"actions":[%s],"links":{}}`, responses[i])
+	})).GodoClient()
+
+	return client, &requests
+}
+
+func TestFindPendingDropletAction(t *testing.T) {
+	client, _ := newDropletActionsTestClient(t, `
+		{"id":3,"status":"completed","type":"power_on","started_at":"2024-06-01T10:20:00Z"},
+		{"id":2,"status":"in-progress","type":"resize","started_at":"2024-06-01T10:10:00Z"},
+		{"id":1,"status":"in-progress","type":"power_off","started_at":"2024-06-01T10:00:00Z"}`)
+
+	action, err := FindPendingDropletAction(context.Background(), client, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if action == nil || action.ID != 2 {
+		t.Errorf("expected the most recently started pending action, got %#v", action)
+	}
+}
+
+func TestWaitForNoPendingDropletAction(t *testing.T) {
+	interval := dropletActionPollInterval
+	dropletActionPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { dropletActionPollInterval = interval })
+
+	// A resize queued behind a power off, the resize then erroring.
+	client, requests := newDropletActionsTestClient(t,
+		`{"id":2,"status":"in-progress","type":"resize"},{"id":1,"status":"in-progress","type":"power_off"}`,
+		`{"id":2,"status":"in-progress","type":"resize"},{"id":1,"status":"completed","type":"power_off"}`,
+		`{"id":2,"status":"errored","type":"resize"},{"id":1,"status":"completed","type":"power_off"}`,
+	)
+
+	if err := WaitForNoPendingDropletAction(context.Background(), client, 1, time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *requests != 3 {
+		t.Errorf("expected to wait until no action was pending, got %d requests", *requests)
+	}
+}
+
+func TestWaitForNoPendingDropletActionTimeout(t *testing.T) {
+	interval := dropletActionPollInterval
+	dropletActionPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { dropletActionPollInterval = interval })
+
+	client, _ := newDropletActionsTestClient(t, `{"id":2,"status":"in-progress","type":"resize"}`)
+
+	err := WaitForNoPendingDropletAction(context.Background(), client, 1, 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "pending resize action (2)") {
+		t.Fatalf("expected a timeout naming the pending action, got: %v", err)
+	}
+}
+
+func TestWaitForNoPendingDropletActionForbidden(t *testing.T) {
+	client := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"id":"forbidden","message":"You are not authorized to perform this operation"}`)
+	})).GodoClient()
+
+	if err := WaitForNoPendingDropletAction(context.Background(), client, 1, time.Second); err != nil {
+		t.Errorf("expected not to wait when the actions cannot be read, got: %s", err)
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	interval := statusPollInterval
+	statusPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { statusPollInterval = interval })
+
+	cases := []struct {
+		name          string
+		statuses      []string
+		timeout       time.Duration
+		expected      string
+		expectedError string
+	}{
+		{name: "Reached", statuses: []string{"creating", "creating", "online"}, timeout: time.Second, expected: "online"},
+		{name: "Failed", statuses: []string{"creating", "error"}, timeout: time.Second, expected: "error", expectedError: `status "error" reached while waiting for status "online"`},
+		{name: "Timeout", statuses: []string{"creating"}, timeout: 50 * time.Millisecond, expected: "creating", expectedError: `timeout after 50ms waiting for status "online", last status was "creating"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			refresh := func(ctx context.Context) (string, error) {
+				i := requests
+				if i >= len(tc.statuses) {
+					i = len(tc.statuses) - 1
+				}
+				requests++
+				return tc.statuses[i], nil
+			}
+
+			status, err := WaitForStatus(context.Background(), refresh, "online", []string{"error"}, tc.timeout)
+			if status != tc.expected {
+				t.Errorf("expected status %q, got %q", tc.expected, status)
+			}
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedError {
+				t.Errorf("expected error %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
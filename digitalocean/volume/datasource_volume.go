@@ -67,6 +67,11 @@ func DataSourceDigitalOceanVolume() *schema.Resource {
 				Description: "list of droplet ids the volume is attached to",
 			},
 			"tags": tag.TagsDataSourceSchema(),
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the date and time when the volume was created",
+			},
 		},
 	}
 }
@@ -123,6 +128,7 @@ func dataSourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("region", volume.Region.Slug)
 	d.Set("size", int(volume.SizeGigaBytes))
 	d.Set("tags", tag.FlattenTags(volume.Tags))
+	d.Set("created_at", volume.CreatedAt.UTC().String())
 
 	if v := volume.Description; v != "" {
 		d.Set("description", v)
@@ -46,6 +46,7 @@ data "digitalocean_volume" "foobar" {
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_volume.foobar", "tags.#", "2"),
 					resource.TestMatchResourceAttr("data.digitalocean_volume.foobar", "urn", expectedURNRegEx),
+					resource.TestCheckResourceAttrSet("data.digitalocean_volume.foobar", "created_at"),
 				),
 			},
 		},
@@ -108,6 +108,12 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 			},
 
 			"tags": tag.TagsSchema(),
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the date and time when the volume was created",
+			},
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -216,6 +222,7 @@ func resourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("size", int(volume.SizeGigaBytes))
 	d.Set("urn", volume.URN())
 	d.Set("tags", tag.FlattenTags(volume.Tags))
+	d.Set("created_at", volume.CreatedAt.UTC().String())
 
 	if v := volume.Description; v != "" {
 		d.Set("description", v)
@@ -42,6 +42,7 @@ func TestAccDigitalOceanVolume_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_volume.foobar", "tags.#", "2"),
 					resource.TestMatchResourceAttr("digitalocean_volume.foobar", "urn", expectedURNRegEx),
+					resource.TestCheckResourceAttrSet("digitalocean_volume.foobar", "created_at"),
 				),
 			},
 		},
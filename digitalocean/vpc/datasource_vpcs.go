@@ -0,0 +1,17 @@
+package vpc
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanVPCs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        vpcSchema(),
+		ResultAttributeName: "vpcs",
+		GetRecords:          getDigitalOceanVPCs,
+		FlattenRecord:       flattenDigitalOceanVPC,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,88 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanVPCs_Basic(t *testing.T) {
+	vpcName1 := acceptance.RandomTestName("vpc1")
+	vpcName2 := acceptance.RandomTestName("vpc2")
+	peeringName := acceptance.RandomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_vpc" "vpc1" {
+  name   = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_vpc" "vpc2" {
+  name   = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_vpc_peering" "foobar" {
+  name = "%s"
+  vpc_ids = [
+    digitalocean_vpc.vpc1.id,
+    digitalocean_vpc.vpc2.id
+  ]
+}
+`, vpcName1, vpcName2, peeringName)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_vpcs" "result" {
+  filter {
+    key    = "name"
+    values = ["%s", "%s"]
+  }
+  filter {
+    key    = "region"
+    values = ["nyc3"]
+  }
+  filter {
+    key    = "default"
+    values = ["false"]
+  }
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+}
+`, vpcName1, vpcName2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.0.name", vpcName1),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.1.name", vpcName2),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.0.region", "nyc3"),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.0.default", "false"),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.0.peerings.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_vpcs.result", "vpcs.0.peerings.0.id", "digitalocean_vpc_peering.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_vpcs.result", "vpcs.0.peerings.0.name", peeringName),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_vpcs.result", "vpcs.0.peerings.0.peer_vpc_id", "digitalocean_vpc.vpc2", "id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_vpcs.result", "vpcs.0.peerings.0.status"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_vpcs.result", "vpcs.1.peerings.0.peer_vpc_id", "digitalocean_vpc.vpc1", "id"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
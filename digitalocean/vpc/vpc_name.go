@@ -0,0 +1,147 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The vpc_name argument is an alternative to the argument holding the ID of
+// the VPC of a resource, e.g. vpc_uuid. The name is resolved to an ID when the
+// resource is created and only the resolved ID is tracked, so renaming the VPC
+// does not change the resource.
+
+// VPCNameSchema returns the schema of the vpc_name argument of a resource
+// whose VPC ID is held by uuidKey.
+func VPCNameSchema(uuidKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.NoZeroValues,
+		ConflictsWith: []string{uuidKey},
+		Description:   fmt.Sprintf("The name of the VPC, resolved to the ID stored in %s", uuidKey),
+	}
+}
+
+// ResolveVPCName returns the ID of the VPC with the given name in the region,
+// or in any region if region is empty. An error is returned if there is not
+// exactly one such VPC.
+func ResolveVPCName(ctx context.Context, meta interface{}, region, name string) (string, error) {
+	combined := meta.(*config.CombinedConfig)
+
+	key := region + "/" + name
+	if id, ok := combined.VPCIDs().Load(key); ok {
+		return id.(string), nil
+	}
+
+	vpcs, err := listVPCs(combined.GodoClient())
+	if err != nil {
+		return "", err
+	}
+
+	vpc, err := findVPCByName(filterVPCsByRegion(vpcs, region), name)
+	if err != nil {
+		if region != "" {
+			return "", fmt.Errorf("%s in region %s", err, region)
+		}
+		return "", err
+	}
+
+	combined.VPCIDs().Store(key, vpc.ID)
+
+	return vpc.ID, nil
+}
+
+func filterVPCsByRegion(vpcs []*godo.VPC, region string) []*godo.VPC {
+	if region == "" {
+		return vpcs
+	}
+
+	results := make([]*godo.VPC, 0)
+	for _, v := range vpcs {
+		if v.RegionSlug == region {
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
+
+// ExpandVPCID returns the ID of the VPC of a resource being created, either
+// from uuidKey or by resolving vpc_name. An empty ID is returned if neither
+// is set.
+func ExpandVPCID(ctx context.Context, d *schema.ResourceData, meta interface{}, uuidKey string) (string, error) {
+	if id, ok := d.GetOk(uuidKey); ok {
+		return id.(string), nil
+	}
+
+	name, ok := d.GetOk("vpc_name")
+	if !ok {
+		return "", nil
+	}
+
+	return ResolveVPCName(ctx, meta, d.Get("region").(string), name.(string))
+}
+
+// CustomizeDiffVPCName resolves a changed vpc_name of an existing resource.
+// A new resource is only required if the name resolves to a different VPC
+// than the one in uuidKey. A name which can not be resolved yet, e.g. as the
+// VPC is renamed in the same apply, is checked by CheckVPCNameChange instead.
+func CustomizeDiffVPCName(uuidKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" || !diff.HasChange("vpc_name") ||
+			!diff.NewValueKnown("vpc_name") || !diff.NewValueKnown("region") {
+			return nil
+		}
+
+		name := diff.Get("vpc_name").(string)
+		if name == "" {
+			return nil
+		}
+
+		id, err := ResolveVPCName(ctx, meta, diff.Get("region").(string), name)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to resolve vpc_name %q while planning, it is resolved when applying: %s", name, err)
+			return nil
+		}
+
+		if id == diff.Get(uuidKey).(string) {
+			return nil
+		}
+
+		if err := diff.SetNew(uuidKey, id); err != nil {
+			return err
+		}
+		return diff.ForceNew(uuidKey)
+	}
+}
+
+// CheckVPCNameChange returns an error if vpc_name was changed to the name of
+// a different VPC than the one in uuidKey, which the plan could not detect as
+// the name was not yet known.
+func CheckVPCNameChange(ctx context.Context, d *schema.ResourceData, meta interface{}, uuidKey string) error {
+	if !d.HasChange("vpc_name") {
+		return nil
+	}
+
+	name := d.Get("vpc_name").(string)
+	if name == "" {
+		return nil
+	}
+
+	id, err := ResolveVPCName(ctx, meta, d.Get("region").(string), name)
+	if err != nil {
+		return err
+	}
+
+	if id != d.Get(uuidKey).(string) {
+		return fmt.Errorf("vpc_name %q is the name of a different VPC (%s) than the one of the resource (%s), which requires a new resource", name, id, d.Get(uuidKey))
+	}
+
+	return nil
+}
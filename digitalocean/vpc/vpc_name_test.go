@@ -0,0 +1,214 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+// newVPCNameTestMeta returns the meta of a provider using a fake API with two
+// VPCs named "web", in different regions, and one named "db". The returned
+// counter is incremented on each list request.
+func newVPCNameTestMeta(t *testing.T) (*config.CombinedConfig, *int) {
+	lists := 0
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/vpcs" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		lists++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"vpcs":[
+			{"id":"web-nyc3","name":"web","region":"nyc3"},
+			{"id":"web-ams3","name":"web","region":"ams3"},
+			{"id":"db-nyc3","name":"db","region":"nyc3"}
+		],"links":{},"meta":{"total":3}}`)
+	}))
+
+	return meta, &lists
+}
+
+func TestResolveVPCName(t *testing.T) {
+	cases := []struct {
+		name             string
+		region           string
+		vpcName          string
+		expected         string
+		expectedErrorMsg string
+	}{
+		{name: "Region", region: "ams3", vpcName: "web", expected: "web-ams3"},
+		{name: "AnyRegion", region: "", vpcName: "db", expected: "db-nyc3"},
+		{name: "Ambiguous", region: "", vpcName: "web", expectedErrorMsg: "too many VPCs found with name web"},
+		{name: "NotFound", region: "ams3", vpcName: "db", expectedErrorMsg: "no VPCs found with name db in region ams3"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta, _ := newVPCNameTestMeta(t)
+
+			id, err := ResolveVPCName(context.Background(), meta, c.region, c.vpcName)
+			if c.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+					t.Fatalf("expected error containing %q, got %v", c.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, id)
+			}
+		})
+	}
+}
+
+func TestResolveVPCNameCache(t *testing.T) {
+	meta, lists := newVPCNameTestMeta(t)
+
+	for i := 0; i < 3; i++ {
+		if _, err := ResolveVPCName(context.Background(), meta, "nyc3", "web"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if *lists != 1 {
+		t.Errorf("expected the VPCs to be listed once, listed %d times", *lists)
+	}
+
+	// Failed resolutions are not cached, as the VPC may be created later on.
+	for i := 0; i < 2; i++ {
+		if _, err := ResolveVPCName(context.Background(), meta, "nyc3", "missing"); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if *lists != 3 {
+		t.Errorf("expected the VPCs to be listed 3 times, listed %d times", *lists)
+	}
+}
+
+func TestExpandVPCID(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"region":   {Type: schema.TypeString, Optional: true},
+		"vpc_uuid": {Type: schema.TypeString, Optional: true, Computed: true},
+		"vpc_name": VPCNameSchema("vpc_uuid"),
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{name: "ID", raw: map[string]interface{}{"region": "nyc3", "vpc_uuid": "b1a3b1b0"}, expected: "b1a3b1b0"},
+		{name: "Name", raw: map[string]interface{}{"region": "nyc3", "vpc_name": "web"}, expected: "web-nyc3"},
+		{name: "Neither", raw: map[string]interface{}{"region": "nyc3"}, expected: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta, _ := newVPCNameTestMeta(t)
+			d := schema.TestResourceDataRaw(t, resourceSchema, c.raw)
+
+			id, err := ExpandVPCID(context.Background(), d, meta, "vpc_uuid")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, id)
+			}
+		})
+	}
+}
+
+func TestCheckVPCNameChange(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"region":   {Type: schema.TypeString, Optional: true},
+		"vpc_uuid": {Type: schema.TypeString, Optional: true, Computed: true},
+		"vpc_name": VPCNameSchema("vpc_uuid"),
+	}
+
+	meta, _ := newVPCNameTestMeta(t)
+
+	// The VPC was renamed to "web".
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"region": "nyc3", "vpc_name": "web"})
+	d.Set("vpc_uuid", "web-nyc3")
+	if err := CheckVPCNameChange(context.Background(), d, meta, "vpc_uuid"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	// "db" is another VPC.
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"region": "nyc3", "vpc_name": "db"})
+	d.Set("vpc_uuid", "web-nyc3")
+	err := CheckVPCNameChange(context.Background(), d, meta, "vpc_uuid")
+	if err == nil || !strings.Contains(err.Error(), "requires a new resource") {
+		t.Errorf("expected an error requiring a new resource, got %v", err)
+	}
+}
+
+func TestCustomizeDiffVPCName(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region":   {Type: schema.TypeString, Optional: true},
+			"vpc_uuid": {Type: schema.TypeString, Optional: true, Computed: true, ForceNew: true},
+			"vpc_name": VPCNameSchema("vpc_uuid"),
+		},
+		CustomizeDiff: CustomizeDiffVPCName("vpc_uuid"),
+	}
+
+	cases := []struct {
+		name        string
+		vpcName     string
+		requiresNew bool
+	}{
+		// The VPC was renamed from "old-web" to "web".
+		{name: "Renamed", vpcName: "web", requiresNew: false},
+		{name: "OtherVPC", vpcName: "db", requiresNew: true},
+		// The VPC is renamed to "new-web" in the same apply.
+		{name: "NotYetRenamed", vpcName: "new-web", requiresNew: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta, _ := newVPCNameTestMeta(t)
+			state := &terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"region":   "nyc3",
+					"vpc_uuid": "web-nyc3",
+					"vpc_name": "old-web",
+				},
+			}
+			cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"region":   "nyc3",
+				"vpc_name": c.vpcName,
+			})
+
+			diff, err := r.Diff(context.Background(), state, cfg, meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff.RequiresNew() != c.requiresNew {
+				t.Errorf("expected requires new to be %t, got diff: %#v", c.requiresNew, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vpcRecord pairs a VPC with the peerings it is a member of so that both are
+// fetched once per read rather than once per VPC.
+type vpcRecord struct {
+	VPC      godo.VPC
+	Peerings []godo.VPCPeering
+}
+
+func vpcSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the VPC",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "The uniform resource name (URN) for the VPC",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the VPC",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "The slug of the region the VPC is in",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "A description of the VPC",
+		},
+		"ip_range": {
+			Type:        schema.TypeString,
+			Description: "The range of IP addresses for the VPC in CIDR notation",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Description: "Whether or not the VPC is the default one for the region",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time of when the VPC was created",
+		},
+		"peerings": {
+			Type:        schema.TypeList,
+			Description: "The VPC peerings the VPC is a member of",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the VPC peering",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the VPC peering",
+					},
+					"peer_vpc_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the VPC on the other side of the peering",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the VPC peering",
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDigitalOceanVPCs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	vpcs, err := listVPCs(client)
+	if err != nil {
+		return nil, err
+	}
+
+	peerings, err := listVPCPeerings(client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order the results by ID so that they are stable between runs when no
+	// sort is configured.
+	sort.Slice(vpcs, func(i, j int) bool { return vpcs[i].ID < vpcs[j].ID })
+	sort.Slice(peerings, func(i, j int) bool { return peerings[i].ID < peerings[j].ID })
+
+	var vpcList []interface{}
+	for _, vpc := range vpcs {
+		record := vpcRecord{VPC: *vpc}
+		for _, peering := range peerings {
+			for _, id := range peering.VPCIDs {
+				if id == vpc.ID {
+					record.Peerings = append(record.Peerings, *peering)
+					break
+				}
+			}
+		}
+
+		vpcList = append(vpcList, record)
+	}
+
+	return vpcList, nil
+}
+
+func listVPCPeerings(client *godo.Client) ([]*godo.VPCPeering, error) {
+	peeringList := []*godo.VPCPeering{}
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		peerings, resp, err := client.VPCs.ListVPCPeerings(context.Background(), opts)
+
+		if err != nil {
+			return peeringList, fmt.Errorf("Error retrieving VPC Peerings: %s", err)
+		}
+
+		peeringList = append(peeringList, peerings...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return peeringList, fmt.Errorf("Error retrieving VPC Peerings: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return peeringList, nil
+}
+
+func flattenDigitalOceanVPC(rawVPC, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	record := rawVPC.(vpcRecord)
+	vpc := record.VPC
+
+	peerings := make([]interface{}, 0, len(record.Peerings))
+	for _, peering := range record.Peerings {
+		var peerID string
+		for _, id := range peering.VPCIDs {
+			if id != vpc.ID {
+				peerID = id
+			}
+		}
+
+		peerings = append(peerings, map[string]interface{}{
+			"id":          peering.ID,
+			"name":        peering.Name,
+			"peer_vpc_id": peerID,
+			"status":      peering.Status,
+		})
+	}
+
+	flattenedVPC := map[string]interface{}{
+		"id":          vpc.ID,
+		"urn":         vpc.URN,
+		"name":        vpc.Name,
+		"region":      vpc.RegionSlug,
+		"description": vpc.Description,
+		"ip_range":    vpc.IPRange,
+		"default":     vpc.Default,
+		"created_at":  vpc.CreatedAt.UTC().String(),
+		"peerings":    peerings,
+	}
+
+	return flattenedVPC, nil
+}
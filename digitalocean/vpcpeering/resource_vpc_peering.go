@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -41,6 +42,8 @@ func ResourceDigitalOceanVPCPeering() *schema.Resource {
 				Type:        schema.TypeSet,
 				Required:    true,
 				ForceNew:    true,
+				MinItems:    2,
+				MaxItems:    2,
 				Description: "The list of VPCs to be peered",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -63,7 +66,33 @@ func ResourceDigitalOceanVPCPeering() *schema.Resource {
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
+
+		CustomizeDiff: vpcPeeringDiffCheck,
+	}
+}
+
+// vpcPeeringDiffCheck rejects peering a VPC with itself, which the set of
+// vpc_ids otherwise silently reduces to a single VPC.
+func vpcPeeringDiffCheck(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("vpc_ids") {
+		return nil
+	}
+
+	if vpcIDs := d.Get("vpc_ids").(*schema.Set); vpcIDs.Len() == 1 {
+		return fmt.Errorf("a VPC can not be peered with itself: %s", vpcIDs.List()[0])
 	}
+
+	return nil
+}
+
+// vpcPeeringCreateError returns the error of a failed create, explaining why
+// VPCs whose IP ranges overlap can not be peered.
+func vpcPeeringCreateError(err error, vpcIDs []string) error {
+	if errResp, ok := err.(*godo.ErrorResponse); ok && strings.Contains(strings.ToLower(errResp.Message), "overlap") {
+		return fmt.Errorf("error creating VPC Peering: the IP ranges of VPCs %s overlap, and only VPCs whose IP ranges do not overlap can be peered: %s", strings.Join(vpcIDs, " and "), errResp.Message)
+	}
+
+	return fmt.Errorf("error creating VPC Peering: %s", err)
 }
 
 func resourceDigitalOceanVPCPeeringCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -87,7 +116,7 @@ func resourceDigitalOceanVPCPeeringCreate(ctx context.Context, d *schema.Resourc
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		vpcPeering, _, err := client.VPCs.CreateVPCPeering(context.Background(), vpcPeeringRequest)
 		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error creating VPC Peering: %s", err))
+			return retry.NonRetryableError(vpcPeeringCreateError(err, vpcIDsString))
 		}
 
 		d.SetId(vpcPeering.ID)
@@ -98,7 +127,7 @@ func resourceDigitalOceanVPCPeeringCreate(ctx context.Context, d *schema.Resourc
 			Pending:    []string{"PROVISIONING"},
 			Target:     []string{"ACTIVE"},
 			Refresh:    vpcPeeringStateRefreshFunc(client, d.Id()),
-			Timeout:    2 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutCreate),
 			MinTimeout: 5 * time.Second,
 		}
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -154,7 +183,7 @@ func resourceDigitalOceanVPCPeeringDelete(ctx context.Context, d *schema.Resourc
 			Pending:    []string{"DELETING"},
 			Target:     []string{http.StatusText(http.StatusNotFound)},
 			Refresh:    vpcPeeringStateRefreshFunc(client, d.Id()),
-			Timeout:    2 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutDelete),
 			MinTimeout: 5 * time.Second,
 		}
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
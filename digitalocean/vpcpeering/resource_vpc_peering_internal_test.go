@@ -0,0 +1,70 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTestMeta returns the configuration of a provider talking to a fake API
+// served by handler until the end of the test.
+func newTestMeta(t *testing.T, handler http.Handler) *config.CombinedConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	meta, err := (&config.Config{Token: "foo", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return meta
+}
+
+func TestDigitalOceanVPCPeeringValidateVPCIDs(t *testing.T) {
+	r := ResourceDigitalOceanVPCPeering()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "peering",
+		"vpc_ids": []interface{}{"vpc-1", "vpc-1"},
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), "a VPC can not be peered with itself: vpc-1") {
+		t.Errorf("expected peering a VPC with itself to be rejected, got: %v", err)
+	}
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "peering",
+		"vpc_ids": []interface{}{"vpc-1", "vpc-2", "vpc-3"},
+	}))
+	if !diags.HasError() {
+		t.Error("expected more than two VPCs to be rejected")
+	}
+}
+
+func TestDigitalOceanVPCPeeringCreateOverlappingIPRanges(t *testing.T) {
+	meta := newTestMeta(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"id":"unprocessable_entity","message":"VPCs with overlapping IP ranges cannot be peered"}`)
+	}))
+
+	r := ResourceDigitalOceanVPCPeering()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":    "peering",
+		"vpc_ids": []interface{}{"vpc-1", "vpc-2"},
+	})
+
+	diags := r.CreateContext(context.Background(), d, meta)
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "only VPCs whose IP ranges do not overlap can be peered") {
+		t.Errorf("expected the overlapping IP ranges to be explained, got: %s", summary)
+	}
+}
@@ -16,6 +16,15 @@ type commonFilter struct {
 	matchBy string
 }
 
+// Filter is a filter as configured, before its values are converted to the
+// type of the attribute being filtered on.
+type Filter struct {
+	Key     string
+	Values  []string
+	All     bool
+	MatchBy string
+}
+
 func filterSchema(allowedKeys []string) *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeSet,
@@ -40,7 +49,7 @@ func filterSchema(allowedKeys []string) *schema.Schema {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Default:      "exact",
-					ValidateFunc: validation.StringInSlice([]string{"exact", "re", "substring"}, false),
+					ValidateFunc: validation.StringInSlice([]string{"exact", "re", "substring", "gt", "gte", "lt", "lte"}, false),
 				},
 			},
 		},
@@ -56,9 +65,9 @@ func expandFilters(recordSchema map[string]*schema.Schema, rawFilters []interfac
 		f := rawFilter.(map[string]interface{})
 
 		key := f["key"].(string)
-		s, ok := recordSchema[key]
-		if !ok {
-			return nil, fmt.Errorf("field '%s' does not exist in record schema", key)
+		s, err := schemaForKey(recordSchema, key)
+		if err != nil {
+			return nil, err
 		}
 
 		matchBy := "exact"
@@ -66,6 +75,10 @@ func expandFilters(recordSchema map[string]*schema.Schema, rawFilters []interfac
 			matchBy = v
 		}
 
+		if isComparison(matchBy) && !isNumericType(s) {
+			return nil, fmt.Errorf("match_by %q can only be used with numeric fields, '%s' is not numeric", matchBy, key)
+		}
+
 		expandedFilterValues, err := expandFilterValues(f["values"].([]interface{}), s, matchBy)
 		if err != nil {
 			return nil, err
@@ -89,6 +102,60 @@ func expandFilters(recordSchema map[string]*schema.Schema, rawFilters []interfac
 	return expandedFilters, nil
 }
 
+func expandRawFilters(rawFilters []interface{}) []Filter {
+	filters := make([]Filter, len(rawFilters))
+
+	for i, rawFilter := range rawFilters {
+		f := rawFilter.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, v := range f["values"].([]interface{}) {
+			value, _ := v.(string)
+			values = append(values, value)
+		}
+
+		matchBy := "exact"
+		if v, ok := f["match_by"].(string); ok {
+			matchBy = v
+		}
+
+		all := false
+		if v, ok := f["all"].(bool); ok {
+			all = v
+		}
+
+		filters[i] = Filter{
+			Key:     f["key"].(string),
+			Values:  values,
+			All:     all,
+			MatchBy: matchBy,
+		}
+	}
+
+	return filters
+}
+
+// isComparison reports whether a filter compares numbers rather than
+// matching values.
+func isComparison(matchBy string) bool {
+	switch matchBy {
+	case "gt", "gte", "lt", "lte":
+		return true
+	}
+
+	return false
+}
+
+// isNumericType reports whether a field, or the elements of a list or set
+// field, are numbers.
+func isNumericType(s *schema.Schema) bool {
+	if elem, ok := s.Elem.(*schema.Schema); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+		s = elem
+	}
+
+	return s.Type == schema.TypeInt || s.Type == schema.TypeFloat
+}
+
 func isPrimitiveType(fieldType schema.ValueType) bool {
 	switch fieldType {
 	case schema.TypeString,
@@ -123,7 +190,7 @@ func expandPrimitiveFilterValue(
 			}
 			expandedValue = re
 		default:
-			panic("unreachable")
+			return nil, fmt.Errorf("match_by %q can not be used with string values", matchBy)
 		}
 
 	case schema.TypeBool:
@@ -200,11 +267,22 @@ func applyFilters(recordSchema map[string]*schema.Schema, records []map[string]i
 		// Handle multiple filters by applying them in order
 		var filteredRecords []map[string]interface{}
 
+		// The key has been validated when the filter was expanded.
+		s, _ := schemaForKey(recordSchema, f.key)
+
 		filterFunc := func(record map[string]interface{}) bool {
 			result := f.all
 
+			if s == nil {
+				return false
+			}
+			values := valuesForKey(record, f.key)
+
 			for _, filterValue := range f.values {
-				thisValueMatches := valueMatches(recordSchema[f.key], record[f.key], filterValue, f.matchBy)
+				thisValueMatches := false
+				for _, value := range values {
+					thisValueMatches = thisValueMatches || valueMatches(s, value, filterValue, f.matchBy)
+				}
 				if f.all {
 					result = result && thisValueMatches
 				} else {
@@ -2,6 +2,7 @@ package datalist
 
 import (
 	"regexp"
+	"sort"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -276,6 +277,76 @@ func TestApplyFilters(t *testing.T) {
 			},
 			[]string{"s-4vcpu-8gb", "m-1vcpu-8gb"},
 		},
+		{
+			"ByMemoryGreaterThan",
+			commonFilter{
+				"memory",
+				[]interface{}{2048},
+				false,
+				"gt",
+			},
+			[]string{"s-4vcpu-8gb", "m-1vcpu-8gb"},
+		},
+		{
+			"ByMemoryGreaterThanOrEqual",
+			commonFilter{
+				"memory",
+				[]interface{}{2048},
+				false,
+				"gte",
+			},
+			[]string{"s-2vcpu-2gb", "s-4vcpu-8gb", "m-1vcpu-8gb"},
+		},
+		{
+			"ByDiskLessThan",
+			commonFilter{
+				"disk",
+				[]interface{}{60},
+				false,
+				"lt",
+			},
+			[]string{"s-1vcpu-1gb", "m-1vcpu-8gb"},
+		},
+		{
+			"ByDiskLessThanOrEqual",
+			commonFilter{
+				"disk",
+				[]interface{}{60},
+				false,
+				"lte",
+			},
+			[]string{"s-1vcpu-1gb", "s-2vcpu-2gb", "m-1vcpu-8gb"},
+		},
+		{
+			"ByPriceHourlyLessThanOrEqualApproximately",
+			commonFilter{
+				"price_hourly",
+				[]interface{}{0.00744},
+				false,
+				"lte",
+			},
+			[]string{"s-1vcpu-1gb"},
+		},
+		{
+			"ByPriceMonthlyGreaterThanAnyValue",
+			commonFilter{
+				"price_monthly",
+				[]interface{}{40.0, 10.0},
+				false,
+				"gt",
+			},
+			[]string{"s-2vcpu-2gb", "s-4vcpu-8gb", "m-1vcpu-8gb"},
+		},
+		{
+			"ByPriceMonthlyGreaterThanAllValues",
+			commonFilter{
+				"price_monthly",
+				[]interface{}{40.0, 10.0},
+				true,
+				"gt",
+			},
+			[]string{"m-1vcpu-8gb"},
+		},
 		{
 			"ByRegionSetWithSubstring",
 			commonFilter{
@@ -299,3 +370,239 @@ func TestApplyFilters(t *testing.T) {
 		})
 	}
 }
+
+func gpuSizesTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"slug": {
+			Type: schema.TypeString,
+		},
+		"gpu_info": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"count": {
+						Type: schema.TypeInt,
+					},
+					"model": {
+						Type: schema.TypeString,
+					},
+					"vram": {
+						Type: schema.TypeList,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"amount": {
+									Type: schema.TypeInt,
+								},
+								"unit": {
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"networks": {
+			Type: schema.TypeSet,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vpc_uuid": {
+						Type: schema.TypeString,
+					},
+					"ports": {
+						Type: schema.TypeList,
+						Elem: &schema.Schema{Type: schema.TypeInt},
+					},
+				},
+			},
+		},
+		"labels": {
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func gpuSizesTestData() []map[string]interface{} {
+	networkHash := func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["vpc_uuid"])
+	}
+
+	return []map[string]interface{}{
+		{
+			"slug":     "s-1vcpu-1gb",
+			"gpu_info": []interface{}{},
+			"networks": schema.NewSet(networkHash, []interface{}{
+				map[string]interface{}{"vpc_uuid": "vpc-a", "ports": []interface{}{22}},
+			}),
+		},
+		{
+			// Flattened records may hold nested blocks as []map[string]interface{}.
+			"slug": "gpu-h100x1-80gb",
+			"gpu_info": []map[string]interface{}{
+				{
+					"count": 1,
+					"model": "nvidia_h100",
+					"vram":  []interface{}{map[string]interface{}{"amount": 80, "unit": "gib"}},
+				},
+			},
+			"networks": schema.NewSet(networkHash, []interface{}{
+				map[string]interface{}{"vpc_uuid": "vpc-a", "ports": []interface{}{22}},
+				map[string]interface{}{"vpc_uuid": "vpc-b", "ports": []interface{}{80, 443}},
+			}),
+		},
+		{
+			"slug": "gpu-l40sx1-48gb",
+			"gpu_info": []interface{}{
+				map[string]interface{}{
+					"count": 1,
+					"model": "nvidia_l40s",
+					"vram":  []interface{}{map[string]interface{}{"amount": 48, "unit": "gib"}},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyFiltersNested(t *testing.T) {
+	testCases := []struct {
+		name         string
+		filter       commonFilter
+		expectations []string
+	}{
+		{
+			"ByNestedString",
+			commonFilter{"gpu_info.model", []interface{}{"nvidia_h100"}, false, "exact"},
+			[]string{"gpu-h100x1-80gb"},
+		},
+		{
+			"ByNestedStringWithRegularExpression",
+			commonFilter{"gpu_info.model", []interface{}{regexp.MustCompile("^nvidia_")}, false, "re"},
+			[]string{"gpu-h100x1-80gb", "gpu-l40sx1-48gb"},
+		},
+		{
+			"ByDeeplyNestedComparison",
+			commonFilter{"gpu_info.vram.amount", []interface{}{48}, false, "gt"},
+			[]string{"gpu-h100x1-80gb"},
+		},
+		{
+			"ByDeeplyNestedComparisonOrEqual",
+			commonFilter{"gpu_info.vram.amount", []interface{}{48}, false, "gte"},
+			[]string{"gpu-h100x1-80gb", "gpu-l40sx1-48gb"},
+		},
+		{
+			"ByNestedSetContaining",
+			commonFilter{"networks.vpc_uuid", []interface{}{"vpc-b"}, false, "exact"},
+			[]string{"gpu-h100x1-80gb"},
+		},
+		{
+			"ByNestedSetContainingAll",
+			commonFilter{"networks.vpc_uuid", []interface{}{"vpc-a", "vpc-b"}, true, "exact"},
+			[]string{"gpu-h100x1-80gb"},
+		},
+		{
+			"ByNestedSetContainingAny",
+			commonFilter{"networks.vpc_uuid", []interface{}{"vpc-a", "vpc-b"}, false, "exact"},
+			[]string{"s-1vcpu-1gb", "gpu-h100x1-80gb"},
+		},
+		{
+			"ByNestedListOfNumbers",
+			commonFilter{"networks.ports", []interface{}{443}, false, "exact"},
+			[]string{"gpu-h100x1-80gb"},
+		},
+		{
+			"ByNestedListOfNumbersComparison",
+			commonFilter{"networks.ports", []interface{}{100}, false, "lt"},
+			[]string{"s-1vcpu-1gb", "gpu-h100x1-80gb"},
+		},
+		{
+			"NoMatch",
+			commonFilter{"gpu_info.count", []interface{}{8}, false, "exact"},
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sizes := applyFilters(gpuSizesTestSchema(), gpuSizesTestData(), []commonFilter{testCase.filter})
+			var slugs []string
+			for _, size := range sizes {
+				slugs = append(slugs, size["slug"].(string))
+			}
+			assert.Equal(t, testCase.expectations, slugs)
+		})
+	}
+}
+
+func TestExpandFiltersValidation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		filter        map[string]interface{}
+		expectedError string
+	}{
+		{
+			"Comparison",
+			map[string]interface{}{"key": "gpu_info.vram.amount", "values": []interface{}{"40"}, "match_by": "gte"},
+			"",
+		},
+		{
+			"ComparisonOnListOfNumbers",
+			map[string]interface{}{"key": "networks.ports", "values": []interface{}{"1024"}, "match_by": "lt"},
+			"",
+		},
+		{
+			"ComparisonOnString",
+			map[string]interface{}{"key": "gpu_info.model", "values": []interface{}{"nvidia"}, "match_by": "gt"},
+			"match_by \"gt\" can only be used with numeric fields, 'gpu_info.model' is not numeric",
+		},
+		{
+			"ComparisonWithNonNumericValue",
+			map[string]interface{}{"key": "gpu_info.count", "values": []interface{}{"many"}, "match_by": "gt"},
+			"unable to parse value as integer: many",
+		},
+		{
+			"UnknownNestedField",
+			map[string]interface{}{"key": "gpu_info.cores", "values": []interface{}{"1"}},
+			"field 'gpu_info.cores' does not exist in record schema",
+		},
+		{
+			"PathIntoPrimitive",
+			map[string]interface{}{"key": "slug.length", "values": []interface{}{"1"}},
+			"field 'slug.length' does not exist in record schema",
+		},
+		{
+			"NestedBlock",
+			map[string]interface{}{"key": "gpu_info", "values": []interface{}{"1"}},
+			"cannot filter on aggregate type with non-Schema element type",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filters, err := expandFilters(gpuSizesTestSchema(), []interface{}{testCase.filter})
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Len(t, filters, 1)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestComputeFilterKeys(t *testing.T) {
+	keys := computeFilterKeys(gpuSizesTestSchema())
+	sort.Strings(keys)
+
+	assert.Equal(t, []string{
+		"gpu_info.count",
+		"gpu_info.model",
+		"gpu_info.vram.amount",
+		"gpu_info.vram.unit",
+		"networks.ports",
+		"networks.vpc_uuid",
+		"slug",
+	}, keys)
+}
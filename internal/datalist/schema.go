@@ -28,6 +28,13 @@ type ResourceConfig struct {
 	// function.
 	GetRecords func(meta interface{}, extra map[string]interface{}) ([]interface{}, error)
 
+	// Optionally used instead of GetRecords to allow filtering to be pushed
+	// down to the API. It is given the configured filters, and a function
+	// reporting whether a record matches them so that records can be discarded
+	// as each page is retrieved rather than once all have been. The filters are
+	// still applied to the records that are returned.
+	GetFilteredRecords func(meta interface{}, extra map[string]interface{}, filters []Filter, matches func(record interface{}) (bool, error)) ([]interface{}, error)
+
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
 	ExtraQuerySchema map[string]*schema.Schema
 }
@@ -85,7 +92,31 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			extra[key] = d.Get(key)
 		}
 
-		records, err := config.GetRecords(meta, extra)
+		var filters []commonFilter
+		var rawFilters []Filter
+		if v, ok := d.GetOk("filter"); ok {
+			var err error
+			filters, err = expandFilters(config.RecordSchema, v.(*schema.Set).List())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			rawFilters = expandRawFilters(v.(*schema.Set).List())
+		}
+
+		var records []interface{}
+		var err error
+		if config.GetFilteredRecords != nil {
+			matches := func(record interface{}) (bool, error) {
+				flattenedRecord, err := config.FlattenRecord(record, meta, extra)
+				if err != nil {
+					return false, err
+				}
+				return len(applyFilters(config.RecordSchema, []map[string]interface{}{flattenedRecord}, filters)) == 1, nil
+			}
+			records, err = config.GetFilteredRecords(meta, extra, rawFilters, matches)
+		} else {
+			records, err = config.GetRecords(meta, extra)
+		}
 		if err != nil {
 			return diag.Errorf("Unable to load records: %s", err)
 		}
@@ -99,11 +130,7 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			flattenedRecords[i] = flattenedRecord
 		}
 
-		if v, ok := d.GetOk("filter"); ok {
-			filters, err := expandFilters(config.RecordSchema, v.(*schema.Set).List())
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		if len(filters) > 0 {
 			flattenedRecords = applyFilters(config.RecordSchema, flattenedRecords, filters)
 		}
 
@@ -127,14 +154,25 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 	}
 }
 
-// Compute the set of filter keys for the resource.
+// Compute the set of filter keys for the resource. Fields of nested blocks
+// are filtered on with a dotted path, e.g. `gpu_info.vram.amount`, rather
+// than by the block itself.
 func computeFilterKeys(recordSchema map[string]*schema.Schema) []string {
 	var filterKeys []string
 
 	for key, schemaForKey := range recordSchema {
-		if schemaForKey.Type != schema.TypeMap {
-			filterKeys = append(filterKeys, key)
+		if schemaForKey.Type == schema.TypeMap {
+			continue
 		}
+
+		if elem, ok := schemaForKey.Elem.(*schema.Resource); ok {
+			for _, nestedKey := range computeFilterKeys(elem.Schema) {
+				filterKeys = append(filterKeys, key+"."+nestedKey)
+			}
+			continue
+		}
+
+		filterKeys = append(filterKeys, key)
 	}
 
 	return filterKeys
@@ -167,5 +205,9 @@ func validateResourceConfig(config *ResourceConfig) error {
 		return fmt.Errorf("ResultAttributeName must be specified")
 	}
 
+	if config.GetRecords == nil && config.GetFilteredRecords == nil {
+		return fmt.Errorf("GetRecords or GetFilteredRecords must be specified")
+	}
+
 	return nil
 }
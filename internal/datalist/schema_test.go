@@ -0,0 +1,71 @@
+package datalist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataListResourceReadFilteredRecords(t *testing.T) {
+	records := []interface{}{"s-1vcpu-1gb", "s-2vcpu-2gb", "s-4vcpu-8gb"}
+
+	var gotFilters []Filter
+	var matched []interface{}
+	config := &ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"slug": {
+				Type: schema.TypeString,
+			},
+		},
+		ResultAttributeName: "sizes",
+		FlattenRecord: func(record, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"slug": record.(string)}, nil
+		},
+		GetRecords: func(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+			t.Fatal("GetRecords should not be called when GetFilteredRecords is set")
+			return nil, nil
+		},
+		GetFilteredRecords: func(meta interface{}, extra map[string]interface{}, filters []Filter, matches func(record interface{}) (bool, error)) ([]interface{}, error) {
+			gotFilters = filters
+			for _, record := range records {
+				ok, err := matches(record)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					matched = append(matched, record)
+				}
+			}
+
+			// Return a record that does not match to check that the filters
+			// are still applied.
+			return append(matched, "s-2vcpu-2gb"), nil
+		},
+	}
+
+	r := NewResource(config)
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{
+				"key":    "slug",
+				"values": []interface{}{"s-1vcpu-1gb", "s-4vcpu-8gb"},
+			},
+		},
+	})
+
+	diags := r.ReadContext(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	assert.Equal(t, []Filter{{Key: "slug", Values: []string{"s-1vcpu-1gb", "s-4vcpu-8gb"}, All: false, MatchBy: "exact"}}, gotFilters)
+	assert.Equal(t, []interface{}{"s-1vcpu-1gb", "s-4vcpu-8gb"}, matched)
+
+	var slugs []string
+	for _, size := range d.Get("sizes").([]interface{}) {
+		slugs = append(slugs, size.(map[string]interface{})["slug"].(string))
+	}
+	assert.Equal(t, []string{"s-1vcpu-1gb", "s-4vcpu-8gb"}, slugs)
+}
@@ -55,7 +55,9 @@ func expandSorts(rawSorts []interface{}) []commonSort {
 }
 
 func applySorts(recordSchema map[string]*schema.Schema, records []map[string]interface{}, sorts []commonSort) []map[string]interface{} {
-	sort.Slice(records, func(_i, _j int) bool {
+	// Use a stable sort so that records which compare equal keep the order
+	// they were returned in, which keeps the results stable between runs.
+	sort.SliceStable(records, func(_i, _j int) bool {
 		for _, s := range sorts {
 			// Handle multiple sorts by applying them in order
 			i := _i
@@ -74,7 +76,7 @@ func applySorts(recordSchema map[string]*schema.Schema, records []map[string]int
 			}
 		}
 
-		return true
+		return false
 	})
 
 	return records
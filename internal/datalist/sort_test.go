@@ -173,3 +173,21 @@ func TestApplySortsMultiple(t *testing.T) {
 	}
 
 }
+
+func TestApplySortsStable(t *testing.T) {
+	// s-1vcpu-1gb and s-4vcpu-8gb are both available, so they should keep
+	// their original relative order in either direction.
+	sizes := applySorts(sizesTestSchema(), sizesTestDataForSorts(), []commonSort{{"available", "asc"}})
+	if sizes[0]["slug"] != "s-2vcpu-2gb" ||
+		sizes[1]["slug"] != "s-1vcpu-1gb" ||
+		sizes[2]["slug"] != "s-4vcpu-8gb" {
+		t.Fatalf("Expecting sizes with the same availability to keep their original order when sorted ascendingly")
+	}
+
+	sizes = applySorts(sizesTestSchema(), sizesTestDataForSorts(), []commonSort{{"available", "desc"}})
+	if sizes[0]["slug"] != "s-1vcpu-1gb" ||
+		sizes[1]["slug"] != "s-4vcpu-8gb" ||
+		sizes[2]["slug"] != "s-2vcpu-2gb" {
+		t.Fatalf("Expecting sizes with the same availability to keep their original order when sorted descendingly")
+	}
+}
@@ -1,6 +1,7 @@
 package datalist
 
 import (
+	"fmt"
 	"math"
 	"regexp"
 	"strings"
@@ -28,9 +29,15 @@ func valueMatches(s *schema.Schema, value interface{}, filterValue interface{},
 		return filterValue.(bool) == value.(bool)
 
 	case schema.TypeInt:
+		if isComparison(matchBy) {
+			return comparisonMatches(compareValues(s, value, filterValue), matchBy)
+		}
 		return filterValue.(int) == value.(int)
 
 	case schema.TypeFloat:
+		if isComparison(matchBy) {
+			return comparisonMatches(compareValues(s, value, filterValue), matchBy)
+		}
 		return floatApproxEquals(filterValue.(float64), value.(float64))
 
 	case schema.TypeList:
@@ -56,6 +63,93 @@ func valueMatches(s *schema.Schema, value interface{}, filterValue interface{},
 	return false
 }
 
+// comparisonMatches reports whether the result of comparing a value with a
+// filter value satisfies the comparison.
+func comparisonMatches(cmp int, matchBy string) bool {
+	switch matchBy {
+	case "gt":
+		return cmp > 0
+	case "gte":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	case "lte":
+		return cmp <= 0
+	}
+
+	return false
+}
+
+// schemaForKey returns the schema of a filter key. Keys may be a dotted path
+// to a field of a nested block, e.g. `gpu_info.vram.amount`.
+func schemaForKey(recordSchema map[string]*schema.Schema, key string) (*schema.Schema, error) {
+	parts := strings.Split(key, ".")
+
+	current := recordSchema
+	for i, part := range parts {
+		s, ok := current[part]
+		if !ok {
+			return nil, fmt.Errorf("field '%s' does not exist in record schema", key)
+		}
+
+		if i == len(parts)-1 {
+			return s, nil
+		}
+
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok || (s.Type != schema.TypeList && s.Type != schema.TypeSet) {
+			return nil, fmt.Errorf("field '%s' does not exist in record schema", key)
+		}
+		current = elem.Schema
+	}
+
+	return nil, fmt.Errorf("field '%s' does not exist in record schema", key)
+}
+
+// valuesForKey returns the values of a filter key in a record. A dotted path
+// into a list or set of nested blocks has a value for each of the blocks, so
+// a record matches if any of them do.
+func valuesForKey(record map[string]interface{}, key string) []interface{} {
+	values := []interface{}{record}
+
+	for _, part := range strings.Split(key, ".") {
+		var next []interface{}
+		for _, v := range values {
+			for _, m := range nestedBlocks(v) {
+				if value, ok := m[part]; ok && value != nil {
+					next = append(next, value)
+				}
+			}
+		}
+		values = next
+	}
+
+	return values
+}
+
+// nestedBlocks returns the blocks of a flattened nested field, which may be a
+// single block, or a list or set of them.
+func nestedBlocks(v interface{}) []map[string]interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{v}
+	case []map[string]interface{}:
+		return v
+	case []interface{}:
+		var blocks []map[string]interface{}
+		for _, e := range v {
+			if m, ok := e.(map[string]interface{}); ok {
+				blocks = append(blocks, m)
+			}
+		}
+		return blocks
+	case *schema.Set:
+		return nestedBlocks(v.List())
+	}
+
+	return nil
+}
+
 func compareValues(s *schema.Schema, value1 interface{}, value2 interface{}) int {
 	switch s.Type {
 	case schema.TypeString:
@@ -0,0 +1,168 @@
+// Package urn formats and parses DigitalOcean uniform resource names (URNs).
+//
+// A URN has the form "do:<type>:<id>", e.g. "do:droplet:12345". The format of
+// the ID depends on the type of the resource.
+package urn
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const prefix = "do"
+
+// The types of resources identified by a URN.
+const (
+	App          = "app"
+	Database     = "dbaas"
+	Domain       = "domain"
+	Droplet      = "droplet"
+	Firewall     = "firewall"
+	FloatingIP   = "floatingip"
+	Kubernetes   = "kubernetes"
+	LoadBalancer = "loadbalancer"
+	ReservedIP   = "reservedip"
+	ReservedIPV6 = "reservedipv6"
+	Space        = "space"
+	VPC          = "vpc"
+	Volume       = "volume"
+	Project      = "project"
+)
+
+var (
+	uuidRegexp   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	domainRegexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	bucketRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+)
+
+type urnType struct {
+	// validateID returns an error if id is not a valid ID for the type.
+	validateID func(id string) error
+	// assignable is true if resources of the type can be assigned to a
+	// project.
+	assignable bool
+}
+
+var types = map[string]urnType{
+	App:          {validateID: validateUUID, assignable: true},
+	Database:     {validateID: validateUUID, assignable: true},
+	Domain:       {validateID: validateDomain, assignable: true},
+	Droplet:      {validateID: validateInt, assignable: true},
+	Firewall:     {validateID: validateUUID},
+	FloatingIP:   {validateID: validateIPv4, assignable: true},
+	Kubernetes:   {validateID: validateUUID, assignable: true},
+	LoadBalancer: {validateID: validateUUID, assignable: true},
+	ReservedIP:   {validateID: validateIPv4, assignable: true},
+	ReservedIPV6: {validateID: validateIPv6, assignable: true},
+	Space:        {validateID: validateBucket, assignable: true},
+	VPC:          {validateID: validateUUID},
+	Volume:       {validateID: validateUUID, assignable: true},
+	Project:      {validateID: validateUUID},
+}
+
+// Format returns the URN of the resource of the given type and ID.
+func Format(resourceType string, id string) string {
+	return fmt.Sprintf("%s:%s:%s", prefix, resourceType, id)
+}
+
+// Parse returns the type and ID of the resource identified by the URN. An
+// error is returned if the URN is malformed, its type is unknown, or its ID is
+// not valid for its type.
+func Parse(s string) (string, string, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || parts[0] != prefix {
+		return "", "", fmt.Errorf("%q is not a valid URN, expected the format %s", s, Format("<type>", "<id>"))
+	}
+
+	resourceType, id := parts[1], parts[2]
+	t, ok := types[resourceType]
+	if !ok {
+		return "", "", fmt.Errorf("%q has an unknown resource type %q", s, resourceType)
+	}
+
+	if err := t.validateID(id); err != nil {
+		return "", "", fmt.Errorf("%q has an invalid %s ID: %s", s, resourceType, err)
+	}
+
+	return resourceType, id, nil
+}
+
+// AssignableTypes returns the sorted resource types that can be assigned to a
+// project.
+func AssignableTypes() []string {
+	var assignable []string
+	for name, t := range types {
+		if t.assignable {
+			assignable = append(assignable, name)
+		}
+	}
+	sort.Strings(assignable)
+	return assignable
+}
+
+// ValidateAssignable is a schema.SchemaValidateFunc which checks that the value
+// is the URN of a resource that can be assigned to a project.
+func ValidateAssignable(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	resourceType, _, err := Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+		return
+	}
+
+	if !types[resourceType].assignable {
+		errors = append(errors, fmt.Errorf("%q: resources of type %q can not be assigned to a project, expected one of %s", k, resourceType, strings.Join(AssignableTypes(), ", ")))
+	}
+	return
+}
+
+func validateUUID(id string) error {
+	if !uuidRegexp.MatchString(id) {
+		return fmt.Errorf("expected a UUID, got %q", id)
+	}
+	return nil
+}
+
+func validateInt(id string) error {
+	if n, err := strconv.Atoi(id); err != nil || n <= 0 {
+		return fmt.Errorf("expected a positive integer, got %q", id)
+	}
+	return nil
+}
+
+func validateIPv4(id string) error {
+	if ip := net.ParseIP(id); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("expected an IPv4 address, got %q", id)
+	}
+	return nil
+}
+
+func validateIPv6(id string) error {
+	if ip := net.ParseIP(id); ip == nil || ip.To4() != nil {
+		return fmt.Errorf("expected an IPv6 address, got %q", id)
+	}
+	return nil
+}
+
+func validateDomain(id string) error {
+	if len(id) > 253 || !domainRegexp.MatchString(id) {
+		return fmt.Errorf("expected a domain name, got %q", id)
+	}
+	return nil
+}
+
+func validateBucket(id string) error {
+	if !bucketRegexp.MatchString(id) {
+		return fmt.Errorf("expected a bucket name, got %q", id)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package urn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestFormatParse(t *testing.T) {
+	cases := []struct {
+		resourceType string
+		id           string
+		// godoURN is the URN godo returns for the same resource, if any.
+		godoURN string
+	}{
+		{App, "c2a93513-8d9b-4223-9d61-5e7272c81cf5", godo.App{ID: "c2a93513-8d9b-4223-9d61-5e7272c81cf5"}.URN()},
+		{Database, "9cc10173-e9ea-4176-9dbc-a4cee4c4ff30", godo.Database{ID: "9cc10173-e9ea-4176-9dbc-a4cee4c4ff30"}.URN()},
+		{Domain, "example.com", godo.Domain{Name: "example.com"}.URN()},
+		{Domain, "sub.example.co.uk", ""},
+		{Droplet, "3164444", godo.Droplet{ID: 3164444}.URN()},
+		{Firewall, "bb4b2611-3d72-467b-8602-280330ecd65c", godo.Firewall{ID: "bb4b2611-3d72-467b-8602-280330ecd65c"}.URN()},
+		{FloatingIP, "45.55.96.47", godo.FloatingIP{IP: "45.55.96.47"}.URN()},
+		{Kubernetes, "bd5f5959-5e1e-4205-a714-a914373942af", godo.KubernetesCluster{ID: "bd5f5959-5e1e-4205-a714-a914373942af"}.URN()},
+		{LoadBalancer, "4de7ac8b-495b-4884-9a69-1050c6793cd6", godo.LoadBalancer{ID: "4de7ac8b-495b-4884-9a69-1050c6793cd6"}.URN()},
+		{Project, "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679", ""},
+		{ReservedIP, "45.55.96.47", godo.ReservedIP{IP: "45.55.96.47"}.URN()},
+		{ReservedIPV6, "2409:40d0:f7:1017:74b4:3a96:105e:4c6e", godo.ReservedIPV6{IP: "2409:40d0:f7:1017:74b4:3a96:105e:4c6e"}.URN()},
+		{Space, "my-bucket.assets", ""},
+		{VPC, "5a4981aa-9653-4bd1-bef5-d6bff52042e4", ""},
+		{Volume, "506f78a4-e098-11e5-ad9f-000f53306ae1", godo.Volume{ID: "506f78a4-e098-11e5-ad9f-000f53306ae1"}.URN()},
+	}
+
+	tested := map[string]bool{}
+	for _, tc := range cases {
+		tested[tc.resourceType] = true
+
+		formatted := Format(tc.resourceType, tc.id)
+		if expected := "do:" + tc.resourceType + ":" + tc.id; formatted != expected {
+			t.Errorf("expected %q, got %q", expected, formatted)
+		}
+		if tc.godoURN != "" && formatted != tc.godoURN {
+			t.Errorf("expected %q to match the URN returned by godo, %q", formatted, tc.godoURN)
+		}
+
+		resourceType, id, err := Parse(formatted)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", formatted, err)
+			continue
+		}
+		if resourceType != tc.resourceType || id != tc.id {
+			t.Errorf("expected %q to be parsed as (%q, %q), got (%q, %q)", formatted, tc.resourceType, tc.id, resourceType, id)
+		}
+	}
+
+	for resourceType := range types {
+		if !tested[resourceType] {
+			t.Errorf("resource type %q is not tested", resourceType)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := []struct {
+		urn      string
+		expected string
+	}{
+		{"", "is not a valid URN"},
+		{"droplet:3164444", "is not a valid URN"},
+		{"aws:droplet:3164444", "is not a valid URN"},
+		{"do:spaces:my-bucket", "unknown resource type"},
+		{"do:droplet:", "invalid droplet ID"},
+		{"do:droplet:abc", "invalid droplet ID"},
+		{"do:droplet:-1", "invalid droplet ID"},
+		{"do:volume:3164444", "invalid volume ID"},
+		{"do:app:not-a-uuid", "invalid app ID"},
+		{"do:dbaas:9cc10173-e9ea-4176-9dbc", "invalid dbaas ID"},
+		{"do:kubernetes:bd5f5959-5e1e-4205-a714-a914373942afx", "invalid kubernetes ID"},
+		{"do:loadbalancer:", "invalid loadbalancer ID"},
+		{"do:firewall:foo", "invalid firewall ID"},
+		{"do:vpc:foo", "invalid vpc ID"},
+		{"do:project:foo", "invalid project ID"},
+		{"do:floatingip:2409:40d0:f7:1017::1", "invalid floatingip ID"},
+		{"do:reservedip:45.55.96", "invalid reservedip ID"},
+		{"do:reservedipv6:45.55.96.47", "invalid reservedipv6 ID"},
+		{"do:domain:-example.com", "invalid domain ID"},
+		{"do:domain:example..com", "invalid domain ID"},
+		{"do:space:My_Bucket", "invalid space ID"},
+		{"do:space:ab", "invalid space ID"},
+	}
+
+	for _, tc := range cases {
+		_, _, err := Parse(tc.urn)
+		if err == nil {
+			t.Errorf("expected an error parsing %q", tc.urn)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected the error parsing %q to contain %q, got %q", tc.urn, tc.expected, err)
+		}
+	}
+}
+
+func TestValidateAssignable(t *testing.T) {
+	valid := []string{
+		"do:app:c2a93513-8d9b-4223-9d61-5e7272c81cf5",
+		"do:dbaas:9cc10173-e9ea-4176-9dbc-a4cee4c4ff30",
+		"do:domain:example.com",
+		"do:droplet:3164444",
+		"do:floatingip:45.55.96.47",
+		"do:kubernetes:bd5f5959-5e1e-4205-a714-a914373942af",
+		"do:loadbalancer:4de7ac8b-495b-4884-9a69-1050c6793cd6",
+		"do:reservedip:45.55.96.47",
+		"do:reservedipv6:2409:40d0:f7:1017:74b4:3a96:105e:4c6e",
+		"do:space:my-bucket",
+		"do:volume:506f78a4-e098-11e5-ad9f-000f53306ae1",
+	}
+	for _, v := range valid {
+		if _, errs := ValidateAssignable(v, "resources"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	invalid := map[string]string{
+		"do:firewall:bb4b2611-3d72-467b-8602-280330ecd65c": "can not be assigned to a project",
+		"do:vpc:5a4981aa-9653-4bd1-bef5-d6bff52042e4":      "can not be assigned to a project",
+		"do:project:4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679":  "can not be assigned to a project",
+		"do:droplet:foo":    "invalid droplet ID",
+		"3164444":           "is not a valid URN",
+		"do:spaces:example": "unknown resource type",
+	}
+	for v, expected := range invalid {
+		_, errs := ValidateAssignable(v, "resources")
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), expected) {
+			t.Errorf("expected a single error containing %q for %q, got %v", expected, v, errs)
+		}
+	}
+
+	if _, errs := ValidateAssignable(3164444, "resources"); len(errs) != 1 {
+		t.Errorf("expected an error for a non-string value, got %v", errs)
+	}
+}
+
+func TestAssignableTypes(t *testing.T) {
+	expected := "app, dbaas, domain, droplet, floatingip, kubernetes, loadbalancer, reservedip, reservedipv6, space, volume"
+	if actual := strings.Join(AssignableTypes(), ", "); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}